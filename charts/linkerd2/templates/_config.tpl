@@ -8,7 +8,8 @@
     "trustAnchorsPem": "{{required "Please provide the identity trust anchors" .Values.identity.trustAnchorsPEM | trim | replace "\n" "\\n"}}",
     "issuanceLifeTime": "{{.Values.identity.issuer.issuanceLifeTime}}",
     "clockSkewAllowance": "{{.Values.identity.issuer.clockSkewAllowance}}",
-    "scheme": "{{.Values.identity.issuer.scheme}}"
+    "scheme": "{{.Values.identity.issuer.scheme}}",
+    "spiffeTrustDomain": "{{.Values.identity.spiffeTrustDomain}}"
   },
   "autoInjectContext": null,
   "omitWebhookSideEffects": {{.Values.omitWebhookSideEffects}},
@@ -0,0 +1,182 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	pb "github.com/linkerd/linkerd2/controller/gen/public"
+	idctl "github.com/linkerd/linkerd2/controller/identity"
+	"github.com/linkerd/linkerd2/pkg/healthcheck"
+	"github.com/linkerd/linkerd2/pkg/identity"
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+type revokeOptions struct {
+	namespace string
+}
+
+func newRevokeOptions() *revokeOptions {
+	return &revokeOptions{
+		namespace: "default",
+	}
+}
+
+func newCmdIdentity() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "identity [flags] (SUBCOMMAND)",
+		Short: "Manage the identities issued to Linkerd proxies",
+		Long: `Manage the identities issued to Linkerd proxies.
+
+  Revoked identities are stored in the linkerd-identity-denylist ConfigMap in
+  the control plane namespace. The identity service refuses to issue
+  certificates for them; certificates which have already been issued remain
+  valid until they expire.`,
+	}
+
+	cmd.AddCommand(newCmdIdentityRevoke(true))
+	cmd.AddCommand(newCmdIdentityRevoke(false))
+	cmd.AddCommand(newCmdIdentityDenylist())
+
+	return cmd
+}
+
+func newCmdIdentityRevoke(revoke bool) *cobra.Command {
+	options := newRevokeOptions()
+
+	cmd := &cobra.Command{
+		Use:   "revoke [flags] (SERVICEACCOUNT)...",
+		Short: "Stop issuing certificates to service accounts",
+		Long: `Stop issuing certificates to service accounts.
+
+  The identities of the given service accounts are added to the identity
+  denylist.`,
+		Example: `  # Revoke the identity of the web service account in the emojivoto namespace.
+  linkerd identity revoke -n emojivoto web`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			k8sAPI, err := k8s.NewAPI(kubeconfigPath, kubeContext, impersonate, 0)
+			if err != nil {
+				return err
+			}
+
+			return updateIdentityDenylist(k8sAPI, controlPlaneNamespace, options.namespace, args, revoke, os.Stdout)
+		},
+	}
+	if !revoke {
+		cmd.Use = "unrevoke [flags] (SERVICEACCOUNT)..."
+		cmd.Short = "Resume issuing certificates to service accounts"
+		cmd.Long = `Resume issuing certificates to service accounts.
+
+  The identities of the given service accounts are removed from the identity
+  denylist.`
+		cmd.Example = `  # Resume issuing certificates to the web service account in the emojivoto namespace.
+  linkerd identity unrevoke -n emojivoto web`
+	}
+
+	cmd.PersistentFlags().StringVarP(&options.namespace, "namespace", "n", options.namespace, "Namespace of the service accounts")
+
+	return cmd
+}
+
+func newCmdIdentityDenylist() *cobra.Command {
+	return &cobra.Command{
+		Use:   "denylist",
+		Short: "List the identities which have been revoked",
+		Long: `List the identities which have been revoked.
+
+  The list is read from the Linkerd public API.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			rsp, err := checkPublicAPIClientOrExit().IdentityDenylist(context.Background(), &pb.Empty{})
+			if err != nil {
+				return err
+			}
+
+			for _, id := range rsp.GetIdentities() {
+				fmt.Fprintln(os.Stdout, id)
+			}
+			return nil
+		},
+	}
+}
+
+// updateIdentityDenylist adds the identities of the given service accounts to
+// the identity denylist, or removes them from it if revoke is false.
+func updateIdentityDenylist(k kubernetes.Interface, controlPlaneNamespace, namespace string, serviceAccounts []string, revoke bool, w io.Writer) error {
+	_, configs, err := healthcheck.FetchLinkerdConfigMap(k, controlPlaneNamespace)
+	if err != nil {
+		return fmt.Errorf("failed to read Linkerd configuration: %s", err)
+	}
+	idctx := configs.GetGlobal().GetIdentityContext()
+	if idctx == nil {
+		return fmt.Errorf("identity is disabled in the control plane configuration")
+	}
+	dom, err := idctl.NewTrustDomain(controlPlaneNamespace, idctx.GetTrustDomain())
+	if err != nil {
+		return err
+	}
+
+	ids := make([]string, len(serviceAccounts))
+	for i, sa := range serviceAccounts {
+		ids[i], err = dom.Identity("serviceaccount", sa, namespace)
+		if err != nil {
+			return err
+		}
+	}
+
+	cms := k.CoreV1().ConfigMaps(controlPlaneNamespace)
+	cm, err := cms.Get(k8s.IdentityDenylistConfigMapName, metav1.GetOptions{})
+	exists := err == nil
+	if err != nil {
+		if !kerrors.IsNotFound(err) {
+			return err
+		}
+		cm = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      k8s.IdentityDenylistConfigMapName,
+				Namespace: controlPlaneNamespace,
+				Labels: map[string]string{
+					k8s.ControllerNSLabel: controlPlaneNamespace,
+				},
+			},
+		}
+	}
+
+	denied := identity.ParseDenylist(cm.Data[k8s.IdentityDenylistKey])
+	for _, id := range ids {
+		if revoke {
+			denied[id] = struct{}{}
+		} else {
+			delete(denied, id)
+		}
+	}
+	if cm.Data == nil {
+		cm.Data = map[string]string{}
+	}
+	cm.Data[k8s.IdentityDenylistKey] = identity.FormatDenylist(denied)
+
+	if exists {
+		_, err = cms.Update(cm)
+	} else {
+		_, err = cms.Create(cm)
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		if revoke {
+			fmt.Fprintf(w, "Revoked identity %s\n", id)
+		} else {
+			fmt.Fprintf(w, "Unrevoked identity %s\n", id)
+		}
+	}
+	return nil
+}
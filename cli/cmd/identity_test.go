@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const linkerdConfig = `
+kind: ConfigMap
+apiVersion: v1
+metadata:
+  name: linkerd-config
+  namespace: linkerd
+data:
+  global: |
+    {"linkerdNamespace":"linkerd","identityContext":{"trustDomain":"cluster.local","trustAnchorsPem":"fake-trust-anchors-pem"}}
+  proxy: |
+    {}
+  install: |
+    {}`
+
+func TestUpdateIdentityDenylist(t *testing.T) {
+	testCases := []struct {
+		k8sConfigs       []string
+		serviceAccounts  []string
+		revoke           bool
+		expectedDenylist string
+		expectedOutput   string
+	}{
+		{
+			[]string{linkerdConfig},
+			[]string{"web", "emoji"},
+			true,
+			"emoji.emojivoto.serviceaccount.identity.linkerd.cluster.local\n" +
+				"web.emojivoto.serviceaccount.identity.linkerd.cluster.local\n",
+			"Revoked identity web.emojivoto.serviceaccount.identity.linkerd.cluster.local\n" +
+				"Revoked identity emoji.emojivoto.serviceaccount.identity.linkerd.cluster.local\n",
+		},
+		{
+			[]string{linkerdConfig, `
+kind: ConfigMap
+apiVersion: v1
+metadata:
+  name: linkerd-identity-denylist
+  namespace: linkerd
+data:
+  identities: |
+    emoji.emojivoto.serviceaccount.identity.linkerd.cluster.local
+    web.emojivoto.serviceaccount.identity.linkerd.cluster.local`,
+			},
+			[]string{"web"},
+			false,
+			"emoji.emojivoto.serviceaccount.identity.linkerd.cluster.local\n",
+			"Unrevoked identity web.emojivoto.serviceaccount.identity.linkerd.cluster.local\n",
+		},
+	}
+
+	for i, tc := range testCases {
+		tc := tc // pin
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			k8sAPI, err := k8s.NewFakeAPI(tc.k8sConfigs...)
+			if err != nil {
+				t.Fatalf("NewFakeAPI returned an error: %s", err)
+			}
+
+			var buf bytes.Buffer
+			err = updateIdentityDenylist(k8sAPI, "linkerd", "emojivoto", tc.serviceAccounts, tc.revoke, &buf)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			if buf.String() != tc.expectedOutput {
+				t.Fatalf("Expected output \"%s\", got \"%s\"", tc.expectedOutput, buf.String())
+			}
+
+			cm, err := k8sAPI.CoreV1().ConfigMaps("linkerd").Get(k8s.IdentityDenylistConfigMapName, metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if actual := cm.Data[k8s.IdentityDenylistKey]; actual != tc.expectedDenylist {
+				t.Fatalf("Expected denylist \"%s\", got \"%s\"", tc.expectedDenylist, actual)
+			}
+		})
+	}
+}
@@ -51,6 +51,7 @@ type (
 		omitWebhookSideEffects      bool
 		restrictDashboardPrivileges bool
 		controlPlaneTracing         bool
+		serviceMirror               bool
 		identityOptions             *installIdentityOptions
 		*proxyConfigOptions
 
@@ -61,11 +62,13 @@ type (
 	}
 
 	installIdentityOptions struct {
-		replicas    uint
-		trustDomain string
+		replicas          uint
+		trustDomain       string
+		spiffeTrustDomain string
 
 		issuanceLifetime   time.Duration
 		clockSkewAllowance time.Duration
+		issuerRenewBefore  time.Duration
 
 		trustPEMFile, crtPEMFile, keyPEMFile string
 		identityExternalIssuer               bool
@@ -118,6 +121,7 @@ var (
 		"templates/proxy-injector-rbac.yaml",
 		"templates/sp-validator-rbac.yaml",
 		"templates/tap-rbac.yaml",
+		"templates/service-mirror-rbac.yaml",
 		"templates/psp.yaml",
 	}
 
@@ -138,6 +142,7 @@ var (
 		"templates/proxy-injector.yaml",
 		"templates/sp-validator.yaml",
 		"templates/tap.yaml",
+		"templates/service-mirror.yaml",
 	}
 )
 
@@ -177,6 +182,7 @@ func newInstallOptionsWithDefaults() (*installOptions, error) {
 		omitWebhookSideEffects:      defaults.OmitWebhookSideEffects,
 		restrictDashboardPrivileges: defaults.RestrictDashboardPrivileges,
 		controlPlaneTracing:         defaults.ControlPlaneTracing,
+		serviceMirror:               defaults.EnableServiceMirror,
 		proxyConfigOptions: &proxyConfigOptions{
 			proxyVersion:           version.Version,
 			ignoreCluster:          false,
@@ -454,6 +460,10 @@ func (options *installOptions) recordableFlagSet() *pflag.FlagSet {
 		&options.identityOptions.clockSkewAllowance, "identity-clock-skew-allowance", options.identityOptions.clockSkewAllowance,
 		"The amount of time to allow for clock skew within a Linkerd cluster",
 	)
+	flags.DurationVar(
+		&options.identityOptions.issuerRenewBefore, "identity-issuer-renew-before", options.identityOptions.issuerRenewBefore,
+		fmt.Sprintf("Have the Identity controller renew the issuer certificate this long before it expires, using the trust anchor credentials stored in the %s Secret (disabled by default). The Secret is created when Linkerd generates the trust anchor; otherwise create it with the crt.pem and key.pem keys before installing", k8s.IdentityTrustAnchorSecretName),
+	)
 	flags.BoolVar(
 		&options.omitWebhookSideEffects, "omit-webhook-side-effects", options.omitWebhookSideEffects,
 		"Omit the sideEffects flag in the webhook manifests, This flag must be provided during install or upgrade for Kubernetes versions pre 1.12",
@@ -462,6 +472,10 @@ func (options *installOptions) recordableFlagSet() *pflag.FlagSet {
 		&options.controlPlaneTracing, "control-plane-tracing", options.controlPlaneTracing,
 		"Enables Control Plane Tracing with the defaults",
 	)
+	flags.BoolVar(
+		&options.serviceMirror, "service-mirror", options.serviceMirror,
+		"Installs the service mirror controller, mirroring the Services of the remote clusters whose credentials are stored in Secrets of the control plane namespace (default false)",
+	)
 	flags.StringVar(
 		&options.identityOptions.crtPEMFile, "identity-issuer-certificate-file", options.identityOptions.crtPEMFile,
 		"A path to a PEM-encoded file containing the Linkerd Identity issuer certificate (generated by default)",
@@ -511,6 +525,10 @@ func (options *installOptions) installOnlyFlagSet() *pflag.FlagSet {
 		&options.identityOptions.trustDomain, "identity-trust-domain", options.identityOptions.trustDomain,
 		"Configures the name suffix used for identities.",
 	)
+	flags.StringVar(
+		&options.identityOptions.spiffeTrustDomain, "identity-spiffe-trust-domain", options.identityOptions.spiffeTrustDomain,
+		"Adds a SPIFFE ID in the given trust domain to the certificates issued to proxies (default: no SPIFFE ID)",
+	)
 	flags.BoolVar(
 		&options.identityOptions.identityExternalIssuer, "identity-external-issuer", options.identityOptions.identityExternalIssuer,
 		"Whether to use an external identity issuer (default false)",
@@ -648,6 +666,7 @@ func (options *installOptions) buildValuesWithoutIdentity(configs *pb.All) (*l5d
 	installValues.ControlPlaneTracing = options.controlPlaneTracing
 	installValues.EnableH2Upgrade = !options.disableH2Upgrade
 	installValues.EnablePodAntiAffinity = options.highAvailability
+	installValues.EnableServiceMirror = options.serviceMirror
 	installValues.HighAvailability = options.highAvailability
 	installValues.ImagePullPolicy = options.imagePullPolicy
 	installValues.GrafanaImage = fmt.Sprintf("%s/grafana", options.dockerRegistry)
@@ -928,6 +947,12 @@ func (idopts *installIdentityOptions) validate() error {
 		}
 	}
 
+	if idopts.spiffeTrustDomain != "" {
+		if errs := validation.IsDNS1123Subdomain(idopts.spiffeTrustDomain); len(errs) > 0 {
+			return fmt.Errorf("invalid SPIFFE trust domain '%s': %s", idopts.spiffeTrustDomain, errs[0])
+		}
+	}
+
 	if idopts.identityExternalIssuer {
 
 		if idopts.crtPEMFile != "" {
@@ -942,6 +967,10 @@ func (idopts *installIdentityOptions) validate() error {
 			return errors.New("--identity-trust-anchors-file must not be specified if --identity-external-issuer=true")
 		}
 
+		if idopts.issuerRenewBefore != 0 {
+			return errors.New("--identity-issuer-renew-before must not be specified if --identity-external-issuer=true")
+		}
+
 	} else {
 		if idopts.trustPEMFile != "" || idopts.crtPEMFile != "" || idopts.keyPEMFile != "" {
 			if idopts.trustPEMFile == "" {
@@ -984,25 +1013,47 @@ func (idopts *installIdentityOptions) issuerName() string {
 	return fmt.Sprintf("identity.%s.%s", controlPlaneNamespace, idopts.trustDomain)
 }
 
+// renewBefore returns the value of the issuer's renewBefore Helm variable,
+// which is empty when renewal is disabled.
+func (idopts *installIdentityOptions) renewBefore() string {
+	if idopts.issuerRenewBefore == 0 {
+		return ""
+	}
+	return idopts.issuerRenewBefore.String()
+}
+
 func (idopts *installIdentityOptions) genValues() (*l5dcharts.Identity, error) {
 	root, err := tls.GenerateRootCAWithDefaults(idopts.issuerName())
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate root certificate for identity: %s", err)
 	}
 
+	// The generated root is both the trust anchor and the issuer, so its
+	// credentials are all the identity controller needs to renew the issuer.
+	var trustAnchor *l5dcharts.TLS
+	if idopts.issuerRenewBefore != 0 {
+		trustAnchor = &l5dcharts.TLS{
+			KeyPEM: root.Cred.EncodePrivateKeyPEM(),
+			CrtPEM: root.Cred.Crt.EncodeCertificatePEM(),
+		}
+	}
+
 	return &l5dcharts.Identity{
-		TrustDomain:     idopts.trustDomain,
-		TrustAnchorsPEM: root.Cred.Crt.EncodeCertificatePEM(),
+		TrustDomain:       idopts.trustDomain,
+		SpiffeTrustDomain: idopts.spiffeTrustDomain,
+		TrustAnchorsPEM:   root.Cred.Crt.EncodeCertificatePEM(),
 		Issuer: &l5dcharts.Issuer{
 			Scheme:              consts.IdentityIssuerSchemeLinkerd,
 			ClockSkewAllowance:  idopts.clockSkewAllowance.String(),
 			IssuanceLifetime:    idopts.issuanceLifetime.String(),
 			CrtExpiry:           root.Cred.Crt.Certificate.NotAfter,
 			CrtExpiryAnnotation: k8s.IdentityIssuerExpiryAnnotation,
+			RenewBefore:         idopts.renewBefore(),
 			TLS: &l5dcharts.TLS{
 				KeyPEM: root.Cred.EncodePrivateKeyPEM(),
 				CrtPEM: root.Cred.Crt.EncodeCertificatePEM(),
 			},
+			TrustAnchor: trustAnchor,
 		},
 	}, nil
 }
@@ -1024,8 +1075,9 @@ func (idopts *installIdentityOptions) readExternallyManaged() (*l5dcharts.Identi
 	}
 
 	return &l5dcharts.Identity{
-		TrustDomain:     idopts.trustDomain,
-		TrustAnchorsPEM: externalIssuerData.TrustAnchors,
+		TrustDomain:       idopts.trustDomain,
+		SpiffeTrustDomain: idopts.spiffeTrustDomain,
+		TrustAnchorsPEM:   externalIssuerData.TrustAnchors,
 		Issuer: &l5dcharts.Issuer{
 			Scheme:             string(corev1.SecretTypeTLS),
 			ClockSkewAllowance: idopts.clockSkewAllowance.String(),
@@ -1051,14 +1103,16 @@ func (idopts *installIdentityOptions) readValues() (*l5dcharts.Identity, error)
 	}
 
 	return &l5dcharts.Identity{
-		TrustDomain:     idopts.trustDomain,
-		TrustAnchorsPEM: issuerData.TrustAnchors,
+		TrustDomain:       idopts.trustDomain,
+		SpiffeTrustDomain: idopts.spiffeTrustDomain,
+		TrustAnchorsPEM:   issuerData.TrustAnchors,
 		Issuer: &l5dcharts.Issuer{
 			Scheme:              consts.IdentityIssuerSchemeLinkerd,
 			ClockSkewAllowance:  idopts.clockSkewAllowance.String(),
 			IssuanceLifetime:    idopts.issuanceLifetime.String(),
 			CrtExpiry:           creds.Crt.Certificate.NotAfter,
 			CrtExpiryAnnotation: k8s.IdentityIssuerExpiryAnnotation,
+			RenewBefore:         idopts.renewBefore(),
 			TLS: &l5dcharts.TLS{
 				KeyPEM: creds.EncodePrivateKeyPEM(),
 				CrtPEM: creds.EncodeCertificatePEM(),
@@ -1088,5 +1142,6 @@ func toIdentityContext(idvals *l5dcharts.Identity) *pb.IdentityContext {
 		IssuanceLifetime:   ptypes.DurationProto(il),
 		ClockSkewAllowance: ptypes.DurationProto(csa),
 		Scheme:             idvals.Issuer.Scheme,
+		SpiffeTrustDomain:  idvals.SpiffeTrustDomain,
 	}
 }
@@ -122,6 +122,12 @@ func TestRender(t *testing.T) {
 		Dashboard: &charts.Dashboard{
 			Replicas: 1,
 		},
+		PublicAPI: &charts.PublicAPI{
+			PrometheusURL:            "http://thanos-query.monitoring.svc.cluster.local:9090",
+			PrometheusHeaders:        map[string]string{"X-Scope-OrgID": "tenant-1"},
+			PrometheusSecret:         "thanos-credentials",
+			PrometheusBearerTokenKey: "token",
+		},
 	}
 
 	haOptions, err := testInstallOptions()
@@ -162,6 +168,16 @@ func TestRender(t *testing.T) {
 	noInitContainerValues, _, _ := noInitContainerOptions.validateAndBuild("", nil)
 	addFakeTLSSecrets(noInitContainerValues)
 
+	serviceMirrorOptions, err := testInstallOptions()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v\n", err)
+	}
+
+	serviceMirrorOptions.recordedFlags = []*config.Install_Flag{{Name: "service-mirror", Value: "true"}}
+	serviceMirrorOptions.serviceMirror = true
+	serviceMirrorValues, _, _ := serviceMirrorOptions.validateAndBuild("", nil)
+	addFakeTLSSecrets(serviceMirrorValues)
+
 	testCases := []struct {
 		values         *charts.Values
 		goldenFileName string
@@ -173,6 +189,7 @@ func TestRender(t *testing.T) {
 		{haValues, "install_ha_output.golden"},
 		{haWithOverridesValues, "install_ha_with_overrides_output.golden"},
 		{noInitContainerValues, "install_no_init_container.golden"},
+		{serviceMirrorValues, "install_service_mirror.golden"},
 	}
 
 	for i, tc := range testCases {
@@ -306,10 +323,11 @@ func TestValidate(t *testing.T) {
 			expectedError string
 		}{
 			{"valid", ""},
+			{"valid-rsa", ""},
 			{"expired", "failed to verify issuer certs stored on disk: not valid anymore. Expired on 1990-01-01T01:01:11Z"},
 			{"not-valid-yet", "failed to verify issuer certs stored on disk: not valid before: 2100-01-01T01:00:51Z"},
 			{"wrong-domain", "failed to verify issuer certs stored on disk: x509: certificate is valid for wrong.linkerd.cluster.local, not identity.linkerd.cluster.local"},
-			{"wrong-algo", "failed to verify issuer certs stored on disk: must use P-256 curve for public key, instead P-521 was used"},
+			{"wrong-algo", "failed to verify issuer certs stored on disk: must use P-256 or P-384 curve for public key, instead P-521 was used"},
 		}
 		for _, tc := range testCases {
 
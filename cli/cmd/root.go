@@ -3,14 +3,20 @@ package cmd
 import (
 	"bytes"
 	"fmt"
+	"math"
 	"os"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/linkerd/linkerd2/controller/api/util"
+	pb "github.com/linkerd/linkerd2/controller/gen/public"
+
 	"github.com/spf13/pflag"
 
 	"github.com/fatih/color"
+	"github.com/prometheus/common/model"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	k8sResource "k8s.io/apimachinery/pkg/api/resource"
@@ -103,6 +109,7 @@ func init() {
 	RootCmd.AddCommand(newCmdEdges())
 	RootCmd.AddCommand(newCmdEndpoints())
 	RootCmd.AddCommand(newCmdGet())
+	RootCmd.AddCommand(newCmdIdentity())
 	RootCmd.AddCommand(newCmdInject())
 	RootCmd.AddCommand(newCmdInstall())
 	RootCmd.AddCommand(newCmdInstallCNIPlugin())
@@ -111,6 +118,7 @@ func init() {
 	RootCmd.AddCommand(newCmdMetrics())
 	RootCmd.AddCommand(newCmdProfile())
 	RootCmd.AddCommand(newCmdRoutes())
+	RootCmd.AddCommand(newCmdSLO())
 	RootCmd.AddCommand(newCmdStat())
 	RootCmd.AddCommand(newCmdTap())
 	RootCmd.AddCommand(newCmdTop())
@@ -120,9 +128,14 @@ func init() {
 }
 
 type statOptionsBase struct {
-	namespace    string
-	timeWindow   string
-	outputFormat string
+	namespace        string
+	timeWindow       string
+	outputFormat     string
+	quantilesFlag    string
+	latencyHistogram bool
+
+	// the latency quantiles parsed from quantilesFlag
+	latencyQuantiles []float64
 }
 
 func newStatOptionsBase() *statOptionsBase {
@@ -133,6 +146,35 @@ func newStatOptionsBase() *statOptionsBase {
 	}
 }
 
+// addLatencyFlags adds the flags requesting extra latency quantiles and the
+// latency histogram.
+func (o *statOptionsBase) addLatencyFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVar(&o.quantilesFlag, "latency-quantiles", o.quantilesFlag, "Comma-separated latency quantiles to show on top of p50, p95 and p99 (for example: \"0.75,0.999\" or \"p75,p99.9\")")
+	cmd.PersistentFlags().BoolVar(&o.latencyHistogram, "latency-histogram", o.latencyHistogram, "Include the latency bucket counts in the json output")
+}
+
+// parseLatencyQuantiles parses the --latency-quantiles flag.
+func (o *statOptionsBase) parseLatencyQuantiles() error {
+	quantiles, err := util.ParseLatencyQuantiles(o.quantilesFlag)
+	if err != nil {
+		return err
+	}
+	o.latencyQuantiles = quantiles
+	return nil
+}
+
+// extraLatencyQuantiles returns the requested latency quantiles which aren't
+// already shown as p50, p95 or p99.
+func (o *statOptionsBase) extraLatencyQuantiles() []float64 {
+	var extra []float64
+	for _, q := range o.latencyQuantiles {
+		if q != 0.5 && q != 0.95 && q != 0.99 {
+			extra = append(extra, q)
+		}
+	}
+	return extra
+}
+
 func (o *statOptionsBase) validateOutputFormat() error {
 	switch o.outputFormat {
 	case tableOutput, jsonOutput, wideOutput:
@@ -160,12 +202,73 @@ func renderStats(buffer bytes.Buffer, options *statOptionsBase) string {
 func getRequestRate(success, failure uint64, timeWindow string) float64 {
 	windowLength, err := time.ParseDuration(timeWindow)
 	if err != nil {
-		log.Error(err.Error())
-		return 0.0
+		// windows of time ranges are Prometheus durations, such as "1d"
+		promWindow, promErr := model.ParseDuration(timeWindow)
+		if promErr != nil {
+			log.Error(err.Error())
+			return 0.0
+		}
+		windowLength = time.Duration(promWindow)
 	}
 	return float64(success+failure) / windowLength.Seconds()
 }
 
+// latencyQuantileName names a latency quantile as a percentile, for example
+// "p99.9" for 0.999.
+func latencyQuantileName(quantile float64) string {
+	return "p" + strconv.FormatFloat(math.Round(quantile*1e9)/1e7, 'f', -1, 64)
+}
+
+// latencyQuantileValue returns the latency at quantile in stats, if present.
+func latencyQuantileValue(stats *pb.BasicStats, quantile float64) (uint64, bool) {
+	for _, q := range stats.GetLatencyQuantiles() {
+		if q.GetQuantile() == quantile {
+			return q.GetLatencyMs(), true
+		}
+	}
+	return 0, false
+}
+
+// jsonLatencyBucket is a latency bucket in the json output, with its upper
+// bound as a string as it may be "+Inf".
+type jsonLatencyBucket struct {
+	Le    string `json:"le"`
+	Count uint64 `json:"count"`
+}
+
+// setLatencyStats sets the latencies of r at the requested quantiles beyond
+// p50, p95 and p99, and the latency fields of the json output.
+func (o *statOptionsBase) setLatencyStats(r *rowStats, stats *pb.BasicStats) {
+	for _, q := range o.extraLatencyQuantiles() {
+		latency, _ := latencyQuantileValue(stats, q)
+		r.latencies = append(r.latencies, latency)
+	}
+
+	for _, q := range stats.GetLatencyQuantiles() {
+		if r.latencyQuantiles == nil {
+			r.latencyQuantiles = make(map[string]uint64)
+		}
+		r.latencyQuantiles[latencyQuantileName(q.GetQuantile())] = q.GetLatencyMs()
+	}
+
+	for _, bucket := range stats.GetLatencyHistogram() {
+		r.latencyHistogram = append(r.latencyHistogram, &jsonLatencyBucket{
+			Le:    strconv.FormatFloat(bucket.GetLeMs(), 'f', -1, 64),
+			Count: bucket.GetCount(),
+		})
+	}
+}
+
+// latencyHeaders returns the table headers of the requested quantiles beyond
+// p50, p95 and p99.
+func (o *statOptionsBase) latencyHeaders() []string {
+	var headers []string
+	for _, q := range o.extraLatencyQuantiles() {
+		headers = append(headers, "LATENCY_"+strings.ToUpper(latencyQuantileName(q)))
+	}
+	return headers
+}
+
 // getSuccessRate calculates success rate from Public API BasicStats.
 func getSuccessRate(success, failure uint64) float64 {
 	if success+failure == 0 {
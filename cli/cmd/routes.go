@@ -76,6 +76,7 @@ This command will only display traffic which is sent to a service that has a Ser
 	cmd.PersistentFlags().StringVarP(&options.timeWindow, "time-window", "t", options.timeWindow, "Stat window (for example: \"10s\", \"1m\", \"10m\", \"1h\")")
 	cmd.PersistentFlags().StringVar(&options.toResource, "to", options.toResource, "If present, shows outbound stats to the specified resource")
 	cmd.PersistentFlags().StringVar(&options.toNamespace, "to-namespace", options.toNamespace, "Sets the namespace used to lookup the \"--to\" resource; by default the current \"--namespace\" is used")
+	options.addLatencyFlags(cmd)
 	cmd.PersistentFlags().StringVarP(&options.outputFormat, "output", "o", options.outputFormat, fmt.Sprintf("Output format; one of: \"%s\", \"%s\", or \"%s\"", tableOutput, wideOutput, jsonOutput))
 
 	return cmd
@@ -113,7 +114,7 @@ func writeRouteStatsToBuffer(resp *pb.TopRoutesResponse, w *tabwriter.Writer, op
 		for _, r := range resourceTable.GetRows() {
 			if r.Stats != nil {
 				route := r.GetRoute()
-				table = append(table, &routeRowStats{
+				row := &routeRowStats{
 					rowStats: rowStats{
 						route:       route,
 						dst:         r.GetAuthority(),
@@ -126,7 +127,9 @@ func writeRouteStatsToBuffer(resp *pb.TopRoutesResponse, w *tabwriter.Writer, op
 					actualRequestRate: getRequestRate(r.Stats.GetActualSuccessCount(), r.Stats.GetActualFailureCount(), r.TimeWindow),
 					actualSuccessRate: getSuccessRate(r.Stats.GetActualSuccessCount(), r.Stats.GetActualFailureCount()),
 					hasRequestData:    statHasRequestData(r.Stats),
-				})
+				}
+				options.setLatencyStats(&row.rowStats, r.Stats)
+				table = append(table, row)
 			}
 		}
 
@@ -188,8 +191,10 @@ func printRouteTable(stats []*routeRowStats, w *tabwriter.Writer, options *route
 	headers = append(headers, []string{
 		"LATENCY_P50",
 		"LATENCY_P95",
-		"LATENCY_P99\t", // trailing \t is required to format last column
+		"LATENCY_P99",
 	}...)
+	headers = append(headers, options.latencyHeaders()...)
+	headers[len(headers)-1] = headers[len(headers)-1] + "\t" // trailing \t is required to format last column
 
 	fmt.Fprintln(w, strings.Join(headers, "\t"))
 
@@ -199,10 +204,11 @@ func printRouteTable(stats []*routeRowStats, w *tabwriter.Writer, options *route
 		// actual success rate, actual rps
 		templateString = templateString + "%.2f%%\t%.1frps\t"
 	}
-	// p50, p95, p99
-	templateString = templateString + "%dms\t%dms\t%dms\t\n"
+	// p50, p95, p99, and the extra quantiles
+	extraLatencies := len(options.extraLatencyQuantiles())
+	templateString = templateString + "%dms\t%dms\t%dms\t" + strings.Repeat("%dms\t", extraLatencies) + "\n"
 
-	emptyTemplateString := routeTemplate + "\t%s\t-\t-\t-\t-\t-\t\n"
+	emptyTemplateString := routeTemplate + "\t%s\t-\t-\t-\t-\t-\t" + strings.Repeat("-\t", extraLatencies) + "\n"
 	for _, row := range stats {
 
 		values := []interface{}{
@@ -227,6 +233,9 @@ func printRouteTable(stats []*routeRowStats, w *tabwriter.Writer, options *route
 				row.latencyP95,
 				row.latencyP99,
 			}...)
+			for _, latency := range row.latencies {
+				values = append(values, latency)
+			}
 
 			fmt.Fprintf(w, templateString, values...)
 		} else {
@@ -249,6 +258,9 @@ type JSONRouteStats struct {
 	LatencyMSp50     *uint64  `json:"latency_ms_p50"`
 	LatencyMSp95     *uint64  `json:"latency_ms_p95"`
 	LatencyMSp99     *uint64  `json:"latency_ms_p99"`
+	// the requested latency quantiles, keyed by percentile (for example "p99.9")
+	LatencyMSQuantiles map[string]uint64    `json:"latency_ms_quantiles,omitempty"`
+	LatencyHistogram   []*jsonLatencyBucket `json:"latency_histogram,omitempty"`
 }
 
 func printRouteJSON(tables map[string][]*routeRowStats, w *tabwriter.Writer, options *routesOptions) {
@@ -274,6 +286,8 @@ func printRouteJSON(tables map[string][]*routeRowStats, w *tabwriter.Writer, opt
 			entry.LatencyMSp50 = &row.latencyP50
 			entry.LatencyMSp95 = &row.latencyP95
 			entry.LatencyMSp99 = &row.latencyP99
+			entry.LatencyMSQuantiles = row.latencyQuantiles
+			entry.LatencyHistogram = row.latencyHistogram
 
 			entries[resource] = append(entries[resource], entry)
 		}
@@ -306,6 +320,11 @@ func buildTopRoutesRequest(resource string, options *routesOptions) (*pb.TopRout
 		return nil, err
 	}
 
+	err = options.parseLatencyQuantiles()
+	if err != nil {
+		return nil, err
+	}
+
 	target, err := util.BuildResource(options.namespace, resource)
 	if err != nil {
 		return nil, err
@@ -313,10 +332,12 @@ func buildTopRoutesRequest(resource string, options *routesOptions) (*pb.TopRout
 
 	requestParams := util.TopRoutesRequestParams{
 		StatsBaseRequestParams: util.StatsBaseRequestParams{
-			TimeWindow:   options.timeWindow,
-			ResourceName: target.Name,
-			ResourceType: target.Type,
-			Namespace:    options.namespace,
+			TimeWindow:       options.timeWindow,
+			ResourceName:     target.Name,
+			ResourceType:     target.Type,
+			Namespace:        options.namespace,
+			LatencyQuantiles: options.latencyQuantiles,
+			LatencyHistogram: options.latencyHistogram,
 		},
 	}
 
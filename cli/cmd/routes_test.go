@@ -1,16 +1,19 @@
 package cmd
 
 import (
+	"math"
 	"testing"
 
 	"github.com/linkerd/linkerd2/controller/api/public"
+	pb "github.com/linkerd/linkerd2/controller/gen/public"
 )
 
 type routesParamsExp struct {
-	options *routesOptions
-	routes  []string
-	counts  []uint64
-	file    string
+	options   *routesOptions
+	routes    []string
+	counts    []uint64
+	latencies bool // if the response has latency quantiles and histograms
+	file      string
 }
 
 func TestRoutes(t *testing.T) {
@@ -33,12 +36,49 @@ func TestRoutes(t *testing.T) {
 			file:    "routes_one_output_json.golden",
 		}, t)
 	})
+
+	latencyOptions := newRoutesOptions()
+	latencyOptions.quantilesFlag = "p75,0.999"
+	latencyOptions.latencyHistogram = true
+	t.Run("Returns route stats with latency quantiles", func(t *testing.T) {
+		testRoutesCall(routesParamsExp{
+			routes:    []string{"/a", "/b"},
+			counts:    []uint64{90, 60, 30},
+			options:   latencyOptions,
+			latencies: true,
+			file:      "routes_quantiles_output.golden",
+		}, t)
+	})
+
+	t.Run("Returns route stats with latency quantiles (json)", func(t *testing.T) {
+		latencyOptions.outputFormat = jsonOutput
+		testRoutesCall(routesParamsExp{
+			routes:    []string{"/a", "/b"},
+			counts:    []uint64{90, 60, 30},
+			options:   latencyOptions,
+			latencies: true,
+			file:      "routes_quantiles_output_json.golden",
+		}, t)
+	})
 }
 
 func testRoutesCall(exp routesParamsExp, t *testing.T) {
 	mockClient := &public.MockAPIClient{}
 
 	response := public.GenTopRoutesResponse(exp.routes, exp.counts, exp.options.toResource != "", "foobar")
+	if exp.latencies {
+		for _, row := range response.GetOk().GetRoutes()[0].GetRows() {
+			row.Stats.LatencyQuantiles = []*pb.LatencyQuantile{
+				{Quantile: 0.75, LatencyMs: 150},
+				{Quantile: 0.999, LatencyMs: 900},
+			}
+			row.Stats.LatencyHistogram = []*pb.LatencyBucket{
+				{LeMs: 10, Count: 5},
+				{LeMs: 100, Count: 20},
+				{LeMs: math.Inf(1), Count: 30},
+			}
+		}
+	}
 
 	mockClient.TopRoutesResponseToReturn = &response
 
@@ -0,0 +1,362 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	sp "github.com/linkerd/linkerd2/controller/gen/apis/serviceprofile/v1alpha2"
+	pb "github.com/linkerd/linkerd2/controller/gen/public"
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	"github.com/linkerd/linkerd2/pkg/profiles"
+	"github.com/linkerd/linkerd2/pkg/slo"
+	"github.com/spf13/cobra"
+	yamlDecoder "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/yaml"
+)
+
+type sloOptions struct {
+	namespace     string
+	allNamespaces bool
+	objective     string
+	outputFormat  string
+}
+
+func newSLOOptions() *sloOptions {
+	return &sloOptions{
+		namespace:    "default",
+		outputFormat: tableOutput,
+	}
+}
+
+func (o *sloOptions) validate() error {
+	switch o.outputFormat {
+	case tableOutput, wideOutput, jsonOutput:
+		return nil
+	default:
+		return fmt.Errorf("--output supports %s, %s and %s", tableOutput, wideOutput, jsonOutput)
+	}
+}
+
+func newCmdSLO() *cobra.Command {
+	options := newSLOOptions()
+
+	cmd := &cobra.Command{
+		Use:   "slo [flags] [SERVICE]",
+		Short: "Display the error budgets of service level objectives",
+		Long: `Display the error budgets of service level objectives.
+
+  Service level objectives are declared in the objectives of ServiceProfiles,
+  and SERVICE is the name of the ServiceProfile declaring them. Each objective
+  targets the success rate or the latency of the service's responses, or of
+  the responses of one of its routes, over a window such as 30d.
+
+  The error budget left is the ratio of the failures the objective tolerates
+  over its window which have yet to happen. Burn rates measure how many times
+  faster than sustainable the budget was spent over shorter windows, and
+  alerts fire when the burn rates over both of their windows exceed their
+  threshold.`,
+		Example: `  # Display the error budgets of all objectives in the booksapp namespace.
+  linkerd slo -n booksapp
+
+  # Display the burn rates of the availability objective of the books service.
+  linkerd slo -n booksapp books.booksapp.svc.cluster.local --objective availability -o wide`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := options.validate(); err != nil {
+				return err
+			}
+
+			req := &pb.SLOStatusRequest{
+				Namespace: options.namespace,
+				Objective: options.objective,
+			}
+			if options.allNamespaces {
+				if len(args) > 0 {
+					return errors.New("a SERVICE can't be selected across all namespaces")
+				}
+				req.Namespace = ""
+			}
+			if len(args) > 0 {
+				req.Service = args[0]
+			}
+
+			rsp, err := checkPublicAPIClientOrExit().SLOStatus(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			if e := rsp.GetError(); e != "" {
+				return errors.New(e)
+			}
+
+			return renderSLOStatuses(os.Stdout, rsp.GetOk().GetStatuses(), options)
+		},
+	}
+
+	cmd.PersistentFlags().StringVarP(&options.namespace, "namespace", "n", options.namespace, "Namespace of the ServiceProfiles")
+	cmd.PersistentFlags().BoolVarP(&options.allNamespaces, "all-namespaces", "A", options.allNamespaces, "If present, displays the objectives of ServiceProfiles in all namespaces, ignoring the \"--namespace\" flag")
+	cmd.PersistentFlags().StringVar(&options.objective, "objective", options.objective, "Only display the objectives with this name")
+	cmd.PersistentFlags().StringVarP(&options.outputFormat, "output", "o", options.outputFormat, "Output format; one of: \"table\", \"wide\" or \"json\"")
+
+	cmd.AddCommand(newCmdSLORules())
+
+	return cmd
+}
+
+func newCmdSLORules() *cobra.Command {
+	var filename string
+
+	cmd := &cobra.Command{
+		Use:   "rules [flags]",
+		Short: "Output Prometheus alerting rules for service level objectives",
+		Long: `Output Prometheus alerting rules for service level objectives.
+
+  The rules are generated from the objectives of the ServiceProfiles read from
+  a file, a directory, a URL or stdin. For each objective, they alert when its
+  error budget burns too fast over both a long and a short window, following
+  the multiwindow, multi-burn-rate alerts of the Site Reliability Workbook:
+  the fastest burns page, the slower ones open tickets.`,
+		Example: `  # Generate the alerting rules for the ServiceProfiles of the booksapp namespace.
+  kubectl get sp -n booksapp -o yaml | linkerd slo rules -f -
+
+  # Generate the alerting rules for the ServiceProfiles in a directory.
+  linkerd slo rules -f ./profiles > slo-rules.yml`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if filename == "" {
+				return errors.New("please specify the ServiceProfiles to read with --filename")
+			}
+
+			in, err := read(filename)
+			if err != nil {
+				return err
+			}
+
+			return renderSLORules(in, os.Stdout)
+		},
+	}
+
+	cmd.Flags().StringVarP(&filename, "filename", "f", filename, "A file, directory, URL or \"-\" for stdin to read the ServiceProfiles from")
+
+	return cmd
+}
+
+// renderSLORules writes the alerting rules of the objectives of the
+// ServiceProfiles read from in, which may be separate YAML documents or Lists.
+func renderSLORules(in []io.Reader, w io.Writer) error {
+	indicators := []slo.Indicator{}
+	for _, r := range in {
+		reader := yamlDecoder.NewYAMLReader(bufio.NewReaderSize(r, 4096))
+		for {
+			bytes, err := reader.Read()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return err
+			}
+
+			docs, err := serviceProfileDocuments(bytes)
+			if err != nil {
+				return err
+			}
+			for _, doc := range docs {
+				if err := profiles.Validate(doc); err != nil {
+					return err
+				}
+
+				var profile sp.ServiceProfile
+				if err := yaml.Unmarshal(doc, &profile); err != nil {
+					return err
+				}
+				pi, err := slo.Indicators(&profile)
+				if err != nil {
+					return err
+				}
+				indicators = append(indicators, pi...)
+			}
+		}
+	}
+
+	if len(indicators) == 0 {
+		return errors.New("no objectives found in the ServiceProfiles")
+	}
+
+	out, err := yaml.Marshal(slo.AlertingRules(indicators))
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(out)
+	return err
+}
+
+// serviceProfileDocuments returns the ServiceProfiles in a YAML document,
+// which may be a List of them, leaving out other kinds of resources.
+func serviceProfileDocuments(bytes []byte) ([][]byte, error) {
+	var meta struct {
+		Kind  string            `json:"kind"`
+		Items []json.RawMessage `json:"items"`
+	}
+	if err := yaml.Unmarshal(bytes, &meta); err != nil {
+		return nil, err
+	}
+
+	switch meta.Kind {
+	case k8s.ServiceProfileKind:
+		return [][]byte{bytes}, nil
+	case "List":
+		docs := [][]byte{}
+		for _, item := range meta.Items {
+			items, err := serviceProfileDocuments(item)
+			if err != nil {
+				return nil, err
+			}
+			docs = append(docs, items...)
+		}
+		return docs, nil
+	default:
+		return nil, nil
+	}
+}
+
+type jsonSLOStatus struct {
+	Namespace            string              `json:"namespace"`
+	Service              string              `json:"service"`
+	Objective            string              `json:"objective"`
+	Route                string              `json:"route,omitempty"`
+	Indicator            string              `json:"indicator"`
+	LatencyThresholdMs   float64             `json:"latency_threshold_ms,omitempty"`
+	Window               string              `json:"window"`
+	Target               float64             `json:"target"`
+	Actual               *float64            `json:"actual"`
+	ErrorBudgetRemaining *float64            `json:"error_budget_remaining"`
+	BurnRates            map[string]*float64 `json:"burn_rates"`
+	FiringAlerts         []string            `json:"firing_alerts"`
+}
+
+func renderSLOStatuses(w io.Writer, statuses []*pb.SLOStatus, options *sloOptions) error {
+	if options.outputFormat == jsonOutput {
+		return printSLOStatusesJSON(w, statuses)
+	}
+
+	if len(statuses) == 0 {
+		_, err := fmt.Fprintln(w, "No service level objectives found.")
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, padding, ' ', 0)
+	headers := []string{}
+	if options.allNamespaces {
+		headers = append(headers, namespaceHeader)
+	}
+	headers = append(headers, "SERVICE", "OBJECTIVE", "ROUTE", "INDICATOR", "WINDOW", "TARGET", "ACTUAL", "BUDGET_LEFT", "ALERTS")
+	if options.outputFormat == wideOutput {
+		headers = append(headers, "BURN_RATES")
+	}
+	fmt.Fprintln(tw, strings.Join(headers, "\t"))
+
+	for _, s := range statuses {
+		columns := []string{}
+		if options.allNamespaces {
+			columns = append(columns, s.GetNamespace())
+		}
+		actual, budget := "-", "-"
+		if s.GetHasData() {
+			actual = formatPercent(s.GetActual())
+			budget = formatPercent(s.GetErrorBudgetRemaining())
+		}
+		columns = append(columns,
+			s.GetService(),
+			s.GetObjective(),
+			orDash(s.GetRoute()),
+			sloIndicatorName(s),
+			s.GetWindow(),
+			formatPercent(s.GetTarget()),
+			actual,
+			budget,
+			orDash(strings.Join(firingAlerts(s), ",")),
+		)
+		if options.outputFormat == wideOutput {
+			burnRates := []string{}
+			for _, br := range s.GetBurnRates() {
+				rate := "-"
+				if br.GetHasData() {
+					rate = fmt.Sprintf("%.2f", br.GetRate())
+				}
+				burnRates = append(burnRates, br.GetWindow()+":"+rate)
+			}
+			columns = append(columns, strings.Join(burnRates, " "))
+		}
+		fmt.Fprintln(tw, strings.Join(columns, "\t"))
+	}
+
+	return tw.Flush()
+}
+
+func printSLOStatusesJSON(w io.Writer, statuses []*pb.SLOStatus) error {
+	// avoid nil initialization so that if there are no statuses it gets marshalled as an empty array vs null
+	entries := []*jsonSLOStatus{}
+	for _, s := range statuses {
+		entry := &jsonSLOStatus{
+			Namespace:          s.GetNamespace(),
+			Service:            s.GetService(),
+			Objective:          s.GetObjective(),
+			Route:              s.GetRoute(),
+			Indicator:          s.GetIndicator(),
+			LatencyThresholdMs: s.GetLatencyThresholdMs(),
+			Window:             s.GetWindow(),
+			Target:             s.GetTarget(),
+			BurnRates:          make(map[string]*float64),
+			FiringAlerts:       firingAlerts(s),
+		}
+		if s.GetHasData() {
+			actual, budget := s.GetActual(), s.GetErrorBudgetRemaining()
+			entry.Actual, entry.ErrorBudgetRemaining = &actual, &budget
+		}
+		for _, br := range s.GetBurnRates() {
+			var rate *float64
+			if br.GetHasData() {
+				r := br.GetRate()
+				rate = &r
+			}
+			entry.BurnRates[br.GetWindow()] = rate
+		}
+		entries = append(entries, entry)
+	}
+
+	out, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "%s\n", out)
+	return err
+}
+
+func sloIndicatorName(s *pb.SLOStatus) string {
+	if s.GetIndicator() == slo.Latency {
+		return fmt.Sprintf("latency<%gms", s.GetLatencyThresholdMs())
+	}
+	return s.GetIndicator()
+}
+
+// firingAlerts names the firing alerts of an objective by their severity and
+// windows, e.g. page(1h/5m).
+func firingAlerts(s *pb.SLOStatus) []string {
+	alerts := []string{}
+	for _, alert := range s.GetAlerts() {
+		if alert.GetFiring() {
+			alerts = append(alerts, fmt.Sprintf("%s(%s/%s)", alert.GetSeverity(), alert.GetLongWindow(), alert.GetShortWindow()))
+		}
+	}
+	return alerts
+}
+
+func formatPercent(ratio float64) string {
+	return fmt.Sprintf("%.2f%%", ratio*100)
+}
@@ -0,0 +1,154 @@
+package cmd
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	pb "github.com/linkerd/linkerd2/controller/gen/public"
+)
+
+func TestRenderSLOStatuses(t *testing.T) {
+	statuses := []*pb.SLOStatus{
+		{
+			Namespace:            "booksapp",
+			Service:              "books.booksapp.svc.cluster.local",
+			Objective:            "availability",
+			Window:               "30d",
+			Indicator:            "success_rate",
+			Target:               0.999,
+			HasData:              true,
+			Actual:               0.9995,
+			ErrorBudgetRemaining: 0.5,
+			BurnRates: []*pb.SLOStatus_BurnRate{
+				{Window: "5m", HasData: true, Rate: 20},
+				{Window: "1h", HasData: true, Rate: 16},
+			},
+			Alerts: []*pb.SLOStatus_BurnRateAlert{
+				{LongWindow: "1h", ShortWindow: "5m", Threshold: 14.4, Severity: "page", Firing: true},
+			},
+		},
+		{
+			Namespace:          "booksapp",
+			Service:            "books.booksapp.svc.cluster.local",
+			Objective:          "latency",
+			Route:              "GET /books",
+			Window:             "7d",
+			Indicator:          "latency",
+			Target:             0.99,
+			LatencyThresholdMs: 300,
+			BurnRates: []*pb.SLOStatus_BurnRate{
+				{Window: "5m"},
+			},
+		},
+	}
+
+	testCases := []struct {
+		options  *sloOptions
+		expected string
+	}{
+		{
+			options: &sloOptions{outputFormat: tableOutput},
+			expected: `SERVICE                            OBJECTIVE      ROUTE        INDICATOR       WINDOW   TARGET   ACTUAL   BUDGET_LEFT   ALERTS
+books.booksapp.svc.cluster.local   availability   -            success_rate    30d      99.90%   99.95%   50.00%        page(1h/5m)
+books.booksapp.svc.cluster.local   latency        GET /books   latency<300ms   7d       99.00%   -        -             -
+`,
+		},
+		{
+			options: &sloOptions{outputFormat: wideOutput, allNamespaces: true},
+			expected: `NAMESPACE   SERVICE                            OBJECTIVE      ROUTE        INDICATOR       WINDOW   TARGET   ACTUAL   BUDGET_LEFT   ALERTS        BURN_RATES
+booksapp    books.booksapp.svc.cluster.local   availability   -            success_rate    30d      99.90%   99.95%   50.00%        page(1h/5m)   5m:20.00 1h:16.00
+booksapp    books.booksapp.svc.cluster.local   latency        GET /books   latency<300ms   7d       99.00%   -        -             -             5m:-
+`,
+		},
+		{
+			options: &sloOptions{outputFormat: jsonOutput},
+			expected: `[
+  {
+    "namespace": "booksapp",
+    "service": "books.booksapp.svc.cluster.local",
+    "objective": "availability",
+    "indicator": "success_rate",
+    "window": "30d",
+    "target": 0.999,
+    "actual": 0.9995,
+    "error_budget_remaining": 0.5,
+    "burn_rates": {
+      "1h": 16,
+      "5m": 20
+    },
+    "firing_alerts": [
+      "page(1h/5m)"
+    ]
+  },
+  {
+    "namespace": "booksapp",
+    "service": "books.booksapp.svc.cluster.local",
+    "objective": "latency",
+    "route": "GET /books",
+    "indicator": "latency",
+    "latency_threshold_ms": 300,
+    "window": "7d",
+    "target": 0.99,
+    "actual": null,
+    "error_budget_remaining": null,
+    "burn_rates": {
+      "5m": null
+    },
+    "firing_alerts": []
+  }
+]
+`,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.options.outputFormat, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := renderSLOStatuses(&buf, statuses, tc.options)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if buf.String() != tc.expected {
+				t.Fatalf("Expected:\n%s\ngot:\n%s", tc.expected, buf.String())
+			}
+		})
+	}
+}
+
+func TestRenderSLORules(t *testing.T) {
+	t.Run("Renders the rules of the objectives", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := renderSLORules([]io.Reader{strings.NewReader(readTestdata(t, "slo_rules_input.yml"))}, &buf)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		diffTestdata(t, "slo_rules_output.golden", buf.String())
+	})
+
+	t.Run("Rejects invalid objectives", func(t *testing.T) {
+		profile := `apiVersion: linkerd.io/v1alpha2
+kind: ServiceProfile
+metadata:
+  name: books.booksapp.svc.cluster.local
+  namespace: booksapp
+spec:
+  routes:
+  - name: GET /books
+    condition:
+      method: GET
+  objectives:
+  - name: availability
+    window: 30d
+    successRate: 99.9`
+
+		var buf bytes.Buffer
+		err := renderSLORules([]io.Reader{strings.NewReader(profile)}, &buf)
+		expected := `ServiceProfile "books.booksapp.svc.cluster.local" has an invalid objective: objective "availability" successRate must be between 0 and 1: 99.900000`
+		if err == nil || err.Error() != expected {
+			t.Fatalf("Expected error: %s, got: %v", expected, err)
+		}
+	})
+}
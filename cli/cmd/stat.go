@@ -25,6 +25,14 @@ type statOptions struct {
 	fromNamespace string
 	fromResource  string
 	allNamespaces bool
+	since         string
+	until         string
+	step          time.Duration
+
+	// the time range resolved from since, until and step
+	startTime time.Time
+	endTime   time.Time
+	rangeStep time.Duration
 }
 
 type indexedResults struct {
@@ -133,10 +141,24 @@ If no resource name is specified, displays stats about all resources of the spec
   linkerd stat namespaces --from ns/default
 
   # Get all inbound stats to the test namespace.
-  linkerd stat ns/test`,
+  linkerd stat ns/test
+
+  # Get the stats of all deployments in the test namespace over the last hour, as sparklines.
+  linkerd stat deploy -n test --since 1h
+
+  # Get the stats of the web deployment every minute between two times, as JSON.
+  linkerd stat deploy/web --since 2019-11-05T10:00:00Z --until 2019-11-05T11:00:00Z --step 1m -o json`,
 		Args:      cobra.MinimumNArgs(1),
 		ValidArgs: util.ValidTargets,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := options.resolveTimeRange(time.Now()); err != nil {
+				return err
+			}
+			if options.since != "" && !cmd.Flags().Changed("time-window") {
+				// the window of each point defaults to the step
+				options.timeWindow = ""
+			}
+
 			reqs, err := buildStatSummaryRequests(args, options)
 			if err != nil {
 				return fmt.Errorf("error creating metrics request while making stats request: %v", err)
@@ -166,7 +188,12 @@ If no resource name is specified, displays stats about all resources of the spec
 				}
 			}
 
-			output := renderStatStats(totalRows, options)
+			var output string
+			if options.since != "" {
+				output = renderStatTimeSeries(totalRows, options)
+			} else {
+				output = renderStatStats(totalRows, options)
+			}
 			_, err = fmt.Print(output)
 
 			return err
@@ -181,6 +208,10 @@ If no resource name is specified, displays stats about all resources of the spec
 	cmd.PersistentFlags().StringVar(&options.fromNamespace, "from-namespace", options.fromNamespace, "Sets the namespace used from lookup the \"--from\" resource; by default the current \"--namespace\" is used")
 	cmd.PersistentFlags().BoolVarP(&options.allNamespaces, "all-namespaces", "A", options.allNamespaces, "If present, returns stats across all namespaces, ignoring the \"--namespace\" flag")
 	cmd.PersistentFlags().StringVarP(&options.outputFormat, "output", "o", options.outputFormat, "Output format; one of: \"table\" or \"json\" or \"wide\"")
+	options.addLatencyFlags(cmd)
+	cmd.PersistentFlags().StringVar(&options.since, "since", options.since, "If present, returns the stats over time since this time, either in RFC3339 format or as a duration ago (for example: \"1h\")")
+	cmd.PersistentFlags().StringVar(&options.until, "until", options.until, "End of the time range requested by \"--since\", either in RFC3339 format or as a duration ago; defaults to now")
+	cmd.PersistentFlags().DurationVar(&options.step, "step", options.step, "Interval between the points of the time range requested by \"--since\"; defaults to the range divided in 30 steps, of at least 15s")
 
 	return cmd
 }
@@ -229,6 +260,12 @@ type rowStats struct {
 	tcpOpenConnections uint64
 	tcpReadBytes       float64
 	tcpWriteBytes      float64
+
+	// latencies at the requested quantiles beyond p50, p95 and p99
+	latencies []uint64
+	// the latency quantiles and histogram of the json output
+	latencyQuantiles map[string]uint64
+	latencyHistogram []*jsonLatencyBucket
 }
 
 type row struct {
@@ -320,6 +357,7 @@ func writeStatsToBuffer(rows []*pb.StatTable_PodGroup_Row, w *tabwriter.Writer,
 				tcpReadBytes:       getByteRate(r.GetTcpStats().GetReadBytesTotal(), r.TimeWindow),
 				tcpWriteBytes:      getByteRate(r.GetTcpStats().GetWriteBytesTotal(), r.TimeWindow),
 			}
+			options.setLatencyStats(statTables[resourceKey][key].rowStats, r.Stats)
 		}
 		if r.TsStats != nil {
 			leaf := r.TsStats.Leaf
@@ -425,6 +463,7 @@ func printSingleStatTable(stats map[string]*row, resourceTypeLabel, resourceType
 		"LATENCY_P95",
 		"LATENCY_P99",
 	}...)
+	headers = append(headers, options.latencyHeaders()...)
 
 	if resourceType != k8s.TrafficSplit {
 		headers = append(headers, "TCP_CONN")
@@ -445,16 +484,17 @@ func printSingleStatTable(stats map[string]*row, resourceTypeLabel, resourceType
 	for _, key := range sortedKeys {
 		namespace, name := namespaceName(resourceTypeLabel, key)
 		values := make([]interface{}, 0)
-		templateString := "%s\t%s\t%.2f%%\t%.1frps\t%dms\t%dms\t%dms\t"
-		templateStringEmpty := "%s\t%s\t-\t-\t-\t-\t-\t-\t"
+		extraLatencies := len(options.extraLatencyQuantiles())
+		templateString := "%s\t%s\t%.2f%%\t%.1frps\t%dms\t%dms\t%dms\t" + strings.Repeat("%dms\t", extraLatencies)
+		templateStringEmpty := "%s\t%s\t-\t-\t-\t-\t-\t-\t" + strings.Repeat("-\t", extraLatencies)
 		if resourceType == k8s.Pod {
 			templateString = "%s\t" + templateString
 			templateStringEmpty = "%s\t" + templateStringEmpty
 		}
 
 		if resourceType == k8s.TrafficSplit {
-			templateString = "%s\t%s\t%s\t%s\t%.2f%%\t%.1frps\t%dms\t%dms\t%dms\t"
-			templateStringEmpty = "%s\t%s\t%s\t%s\t-\t-\t-\t-\t-\t"
+			templateString = "%s\t%s\t%s\t%s\t%.2f%%\t%.1frps\t%dms\t%dms\t%dms\t" + strings.Repeat("%dms\t", extraLatencies)
+			templateStringEmpty = "%s\t%s\t%s\t%s\t-\t-\t-\t-\t-\t" + strings.Repeat("-\t", extraLatencies)
 		}
 
 		if !showTCPConns(resourceType) {
@@ -523,6 +563,9 @@ func printSingleStatTable(stats map[string]*row, resourceTypeLabel, resourceType
 				stats[key].latencyP95,
 				stats[key].latencyP99,
 			}...)
+			for _, latency := range stats[key].latencies {
+				values = append(values, latency)
+			}
 
 			if showTCPConns(resourceType) {
 				values = append(values, stats[key].tcpOpenConnections)
@@ -552,21 +595,24 @@ func namespaceName(resourceType string, key string) (string, string) {
 
 // Using pointers where the value is NA and the corresponding json is null
 type jsonStats struct {
-	Namespace      string   `json:"namespace"`
-	Kind           string   `json:"kind"`
-	Name           string   `json:"name"`
-	Meshed         string   `json:"meshed,omitempty"`
-	Success        *float64 `json:"success"`
-	Rps            *float64 `json:"rps"`
-	LatencyMSp50   *uint64  `json:"latency_ms_p50"`
-	LatencyMSp95   *uint64  `json:"latency_ms_p95"`
-	LatencyMSp99   *uint64  `json:"latency_ms_p99"`
-	TCPConnections *uint64  `json:"tcp_open_connections,omitempty"`
-	TCPReadBytes   *float64 `json:"tcp_read_bytes_rate,omitempty"`
-	TCPWriteBytes  *float64 `json:"tcp_write_bytes_rate,omitempty"`
-	Apex           string   `json:"apex,omitempty"`
-	Leaf           string   `json:"leaf,omitempty"`
-	Weight         string   `json:"weight,omitempty"`
+	Namespace    string   `json:"namespace"`
+	Kind         string   `json:"kind"`
+	Name         string   `json:"name"`
+	Meshed       string   `json:"meshed,omitempty"`
+	Success      *float64 `json:"success"`
+	Rps          *float64 `json:"rps"`
+	LatencyMSp50 *uint64  `json:"latency_ms_p50"`
+	LatencyMSp95 *uint64  `json:"latency_ms_p95"`
+	LatencyMSp99 *uint64  `json:"latency_ms_p99"`
+	// the requested latency quantiles, keyed by percentile (for example "p99.9")
+	LatencyMSQuantiles map[string]uint64    `json:"latency_ms_quantiles,omitempty"`
+	LatencyHistogram   []*jsonLatencyBucket `json:"latency_histogram,omitempty"`
+	TCPConnections     *uint64              `json:"tcp_open_connections,omitempty"`
+	TCPReadBytes       *float64             `json:"tcp_read_bytes_rate,omitempty"`
+	TCPWriteBytes      *float64             `json:"tcp_write_bytes_rate,omitempty"`
+	Apex               string               `json:"apex,omitempty"`
+	Leaf               string               `json:"leaf,omitempty"`
+	Weight             string               `json:"weight,omitempty"`
 }
 
 func printStatJSON(statTables map[string]map[string]*row, w *tabwriter.Writer) {
@@ -591,6 +637,8 @@ func printStatJSON(statTables map[string]map[string]*row, w *tabwriter.Writer) {
 					entry.LatencyMSp50 = &stats[key].latencyP50
 					entry.LatencyMSp95 = &stats[key].latencyP95
 					entry.LatencyMSp99 = &stats[key].latencyP99
+					entry.LatencyMSQuantiles = stats[key].latencyQuantiles
+					entry.LatencyHistogram = stats[key].latencyHistogram
 
 					if showTCPConns(resourceType) {
 						entry.TCPConnections = &stats[key].tcpOpenConnections
@@ -645,6 +693,10 @@ func buildStatSummaryRequests(resources []string, options *statOptions) ([]*pb.S
 		}
 	}
 
+	if err := options.parseLatencyQuantiles(); err != nil {
+		return nil, err
+	}
+
 	requests := make([]*pb.StatSummaryRequest, 0)
 	for _, target := range targets {
 		err = options.validate(target.Type)
@@ -654,11 +706,13 @@ func buildStatSummaryRequests(resources []string, options *statOptions) ([]*pb.S
 
 		requestParams := util.StatsSummaryRequestParams{
 			StatsBaseRequestParams: util.StatsBaseRequestParams{
-				TimeWindow:    options.timeWindow,
-				ResourceName:  target.Name,
-				ResourceType:  target.Type,
-				Namespace:     options.namespace,
-				AllNamespaces: options.allNamespaces,
+				TimeWindow:       options.timeWindow,
+				ResourceName:     target.Name,
+				ResourceType:     target.Type,
+				Namespace:        options.namespace,
+				AllNamespaces:    options.allNamespaces,
+				LatencyQuantiles: options.latencyQuantiles,
+				LatencyHistogram: options.latencyHistogram,
 			},
 			ToName:        toRes.Name,
 			ToType:        toRes.Type,
@@ -667,6 +721,9 @@ func buildStatSummaryRequests(resources []string, options *statOptions) ([]*pb.S
 			FromType:      fromRes.Type,
 			FromNamespace: options.fromNamespace,
 			TCPStats:      true,
+			StartTime:     options.startTime,
+			EndTime:       options.endTime,
+			Step:          options.rangeStep,
 		}
 
 		req, err := util.BuildStatSummaryRequest(requestParams)
@@ -0,0 +1,322 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	pb "github.com/linkerd/linkerd2/controller/gen/public"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// defaultRangePoints is the number of points the step of a time range
+	// defaults to, so that sparklines fit in a terminal.
+	defaultRangePoints = 30
+	minRangeStep       = 15 * time.Second
+)
+
+// sparkTicks are the characters sparklines are drawn with, from the lowest
+// value to the highest.
+var sparkTicks = []rune("▁▂▃▄▅▆▇█")
+
+// timeSeries holds the stats of a resource at every step of a time range,
+// with nil values for the steps missing stats.
+type timeSeries struct {
+	namespace string
+	kind      string
+	name      string
+	leaf      string
+	times     []time.Time
+	success   []*float64
+	rps       []*float64
+	p50       []*uint64
+	p95       []*uint64
+	p99       []*uint64
+}
+
+// resolveTimeRange sets the time range requested by --since, --until and
+// --step, relative to now.
+func (o *statOptions) resolveTimeRange(now time.Time) error {
+	if o.since == "" {
+		if o.until != "" || o.step != 0 {
+			return fmt.Errorf("--until and --step require --since")
+		}
+		return nil
+	}
+
+	start, err := parseTimeFlag("since", o.since, now)
+	if err != nil {
+		return err
+	}
+	end := now
+	if o.until != "" {
+		end, err = parseTimeFlag("until", o.until, now)
+		if err != nil {
+			return err
+		}
+	}
+	if !end.After(start) {
+		return fmt.Errorf("--since needs to be before --until")
+	}
+
+	step := o.step
+	if step == 0 {
+		step = roundStep(end.Sub(start) / defaultRangePoints)
+	}
+
+	o.startTime, o.endTime, o.rangeStep = start, end, step
+	return nil
+}
+
+// roundStep rounds a default step to a whole number of minutes or seconds,
+// so that it reads like a step a user would pick and is expressed with a
+// single unit in the Prometheus queries, e.g. "3m" rather than "180s".
+func roundStep(step time.Duration) time.Duration {
+	if step < minRangeStep {
+		return minRangeStep
+	}
+	if step >= time.Minute {
+		return step.Round(time.Minute)
+	}
+	return step.Round(time.Second)
+}
+
+// parseTimeFlag parses a time given either in RFC3339 format or as a
+// duration before now.
+func parseTimeFlag(flag, value string, now time.Time) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d < 0 {
+		return time.Time{}, fmt.Errorf("--%s needs to be an RFC3339 time or a positive duration, got %q", flag, value)
+	}
+	return now.Add(-d), nil
+}
+
+func renderStatTimeSeries(rows []*pb.StatTable_PodGroup_Row, options *statOptions) string {
+	series := buildTimeSeries(rows, options.startTime, options.endTime, options.rangeStep)
+
+	var buffer bytes.Buffer
+	if options.outputFormat == jsonOutput {
+		printTimeSeriesJSON(series, &buffer)
+		return buffer.String()
+	}
+
+	if len(series) == 0 {
+		return "No traffic found.\n"
+	}
+
+	hasLeaves := false
+	for _, s := range series {
+		hasLeaves = hasLeaves || s.leaf != ""
+	}
+
+	w := tabwriter.NewWriter(&buffer, 0, 0, padding, ' ', 0)
+	headers := []string{}
+	if options.allNamespaces {
+		headers = append(headers, namespaceHeader)
+	}
+	headers = append(headers, nameHeader)
+	if hasLeaves {
+		headers = append(headers, leafHeader)
+	}
+	headers = append(headers, "SUCCESS", "RPS", "LATENCY_P50", "LATENCY_P95", "LATENCY_P99")
+	fmt.Fprintln(w, strings.Join(headers, "\t"))
+
+	for _, s := range series {
+		values := []string{}
+		if options.allNamespaces {
+			values = append(values, s.namespace)
+		}
+		values = append(values, getNamePrefix(s.kind)+s.name)
+		if hasLeaves {
+			values = append(values, orDash(s.leaf))
+		}
+		values = append(values,
+			sparkline(s.success, 1)+" "+formatLast(s.success, func(v float64) string { return fmt.Sprintf("%.2f%%", v*100) }),
+			sparkline(s.rps, 0)+" "+formatLast(s.rps, func(v float64) string { return fmt.Sprintf("%.1frps", v) }),
+			latencySparkline(s.p50),
+			latencySparkline(s.p95),
+			latencySparkline(s.p99),
+		)
+		fmt.Fprintln(w, strings.Join(values, "\t"))
+	}
+	w.Flush()
+
+	return buffer.String()
+}
+
+// buildTimeSeries aligns the points of each row on the steps of the time
+// range, sorting the series by resource.
+func buildTimeSeries(rows []*pb.StatTable_PodGroup_Row, start, end time.Time, step time.Duration) []*timeSeries {
+	var times []time.Time
+	for t := start; !t.After(end); t = t.Add(step) {
+		times = append(times, t)
+	}
+
+	series := []*timeSeries{}
+	for _, r := range rows {
+		s := &timeSeries{
+			namespace: r.GetResource().GetNamespace(),
+			kind:      r.GetResource().GetType(),
+			name:      r.GetResource().GetName(),
+			leaf:      r.GetTsStats().GetLeaf(),
+			times:     times,
+			success:   make([]*float64, len(times)),
+			rps:       make([]*float64, len(times)),
+			p50:       make([]*uint64, len(times)),
+			p95:       make([]*uint64, len(times)),
+			p99:       make([]*uint64, len(times)),
+		}
+
+		for _, point := range r.GetTimeSeries() {
+			t, err := ptypes.Timestamp(point.GetTime())
+			if err != nil {
+				continue
+			}
+			i := int(math.Round(float64(t.Sub(start)) / float64(step)))
+			if i < 0 || i >= len(times) {
+				continue
+			}
+
+			stats := point.GetStats()
+			rps := getRequestRate(stats.GetSuccessCount(), stats.GetFailureCount(), r.TimeWindow)
+			s.rps[i] = &rps
+			if statHasRequestData(stats) {
+				success := getSuccessRate(stats.GetSuccessCount(), stats.GetFailureCount())
+				p50, p95, p99 := stats.GetLatencyMsP50(), stats.GetLatencyMsP95(), stats.GetLatencyMsP99()
+				s.success[i], s.p50[i], s.p95[i], s.p99[i] = &success, &p50, &p95, &p99
+			}
+		}
+		series = append(series, s)
+	}
+
+	sort.Slice(series, func(i, j int) bool {
+		a, b := series[i], series[j]
+		if a.kind != b.kind {
+			return a.kind < b.kind
+		}
+		if a.namespace != b.namespace {
+			return a.namespace < b.namespace
+		}
+		if a.name != b.name {
+			return a.name < b.name
+		}
+		return a.leaf < b.leaf
+	})
+	return series
+}
+
+// sparkline draws values scaled from 0 to max, or to the largest value if max
+// is 0. Missing values are drawn as spaces.
+func sparkline(values []*float64, max float64) string {
+	if max == 0 {
+		for _, v := range values {
+			if v != nil && *v > max {
+				max = *v
+			}
+		}
+	}
+
+	var line strings.Builder
+	for _, v := range values {
+		switch {
+		case v == nil:
+			line.WriteRune(' ')
+		case max == 0:
+			line.WriteRune(sparkTicks[0])
+		default:
+			tick := int(math.Round(*v / max * float64(len(sparkTicks)-1)))
+			if tick < 0 {
+				tick = 0
+			} else if tick >= len(sparkTicks) {
+				tick = len(sparkTicks) - 1
+			}
+			line.WriteRune(sparkTicks[tick])
+		}
+	}
+	return line.String()
+}
+
+func latencySparkline(latencies []*uint64) string {
+	values := make([]*float64, len(latencies))
+	for i, l := range latencies {
+		if l != nil {
+			v := float64(*l)
+			values[i] = &v
+		}
+	}
+	return sparkline(values, 0) + " " + formatLast(values, func(v float64) string { return fmt.Sprintf("%.0fms", v) })
+}
+
+// formatLast formats the latest of values, or returns a dash if all are
+// missing.
+func formatLast(values []*float64, format func(float64) string) string {
+	for i := len(values) - 1; i >= 0; i-- {
+		if values[i] != nil {
+			return format(*values[i])
+		}
+	}
+	return "-"
+}
+
+type jsonTimeSeries struct {
+	Namespace  string                 `json:"namespace"`
+	Kind       string                 `json:"kind"`
+	Name       string                 `json:"name"`
+	Leaf       string                 `json:"leaf,omitempty"`
+	TimeSeries []*jsonTimeSeriesPoint `json:"timeSeries"`
+}
+
+// Using pointers where the value is NA and the corresponding json is null
+type jsonTimeSeriesPoint struct {
+	Time         time.Time `json:"time"`
+	Success      *float64  `json:"success"`
+	Rps          *float64  `json:"rps"`
+	LatencyMSp50 *uint64   `json:"latency_ms_p50"`
+	LatencyMSp95 *uint64   `json:"latency_ms_p95"`
+	LatencyMSp99 *uint64   `json:"latency_ms_p99"`
+}
+
+func printTimeSeriesJSON(series []*timeSeries, buffer *bytes.Buffer) {
+	// avoid nil initialization so that if there are not stats it gets marshalled as an empty array vs null
+	entries := []*jsonTimeSeries{}
+	for _, s := range series {
+		entry := &jsonTimeSeries{
+			Namespace:  s.namespace,
+			Kind:       s.kind,
+			Name:       s.name,
+			Leaf:       s.leaf,
+			TimeSeries: []*jsonTimeSeriesPoint{},
+		}
+		for i, t := range s.times {
+			if s.rps[i] == nil {
+				continue
+			}
+			entry.TimeSeries = append(entry.TimeSeries, &jsonTimeSeriesPoint{
+				Time:         t.UTC(),
+				Success:      s.success[i],
+				Rps:          s.rps[i],
+				LatencyMSp50: s.p50[i],
+				LatencyMSp95: s.p95[i],
+				LatencyMSp99: s.p99[i],
+			})
+		}
+		entries = append(entries, entry)
+	}
+
+	b, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		log.Error(err.Error())
+		return
+	}
+	fmt.Fprintf(buffer, "%s\n", b)
+}
@@ -0,0 +1,161 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	pb "github.com/linkerd/linkerd2/controller/gen/public"
+	"github.com/linkerd/linkerd2/pkg/k8s"
+)
+
+func TestResolveTimeRange(t *testing.T) {
+	now := time.Date(2019, 11, 5, 11, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		since, until string
+		step         time.Duration
+		start, end   time.Time
+		expectedStep time.Duration
+		err          string
+	}{
+		{since: "1h", start: now.Add(-time.Hour), end: now, expectedStep: 2 * time.Minute},
+		{since: "5m", start: now.Add(-5 * time.Minute), end: now, expectedStep: 15 * time.Second},
+		{since: "100m", start: now.Add(-100 * time.Minute), end: now, expectedStep: 3 * time.Minute},
+		{since: "20m", start: now.Add(-20 * time.Minute), end: now, expectedStep: 40 * time.Second},
+		{since: "2019-11-05T09:00:00Z", until: "30m", step: time.Minute, start: now.Add(-2 * time.Hour), end: now.Add(-30 * time.Minute), expectedStep: time.Minute},
+		{since: "yesterday", err: `--since needs to be an RFC3339 time or a positive duration, got "yesterday"`},
+		{since: "1h", until: "2h", err: "--since needs to be before --until"},
+		{step: time.Minute, err: "--until and --step require --since"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.since, func(t *testing.T) {
+			options := newStatOptions()
+			options.since, options.until, options.step = tc.since, tc.until, tc.step
+			err := options.resolveTimeRange(now)
+			if tc.err != "" {
+				if err == nil || err.Error() != tc.err {
+					t.Fatalf("Expected error %q, got %v", tc.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if !options.startTime.Equal(tc.start) || !options.endTime.Equal(tc.end) || options.rangeStep != tc.expectedStep {
+				t.Fatalf("Expected range %s-%s every %s, got %s-%s every %s",
+					tc.start, tc.end, tc.expectedStep, options.startTime, options.endTime, options.rangeStep)
+			}
+		})
+	}
+}
+
+func TestRenderStatTimeSeries(t *testing.T) {
+	start := time.Date(2019, 11, 5, 10, 0, 0, 0, time.UTC)
+	point := func(offset time.Duration, success, failure, latency uint64) *pb.TimeSeriesPoint {
+		timestamp, _ := ptypes.TimestampProto(start.Add(offset))
+		return &pb.TimeSeriesPoint{
+			Time: timestamp,
+			Stats: &pb.BasicStats{
+				SuccessCount: success,
+				FailureCount: failure,
+				LatencyMsP50: latency,
+				LatencyMsP95: latency * 2,
+				LatencyMsP99: latency * 4,
+			},
+		}
+	}
+	rows := []*pb.StatTable_PodGroup_Row{
+		{
+			Resource:   &pb.Resource{Namespace: "emojivoto", Type: k8s.Deployment, Name: "web"},
+			TimeWindow: "1m",
+			TimeSeries: []*pb.TimeSeriesPoint{
+				point(0, 60, 0, 10),
+				point(time.Minute, 30, 30, 20),
+				point(3*time.Minute, 120, 0, 40),
+			},
+		},
+		{
+			Resource:   &pb.Resource{Namespace: "emojivoto", Type: k8s.Deployment, Name: "emoji"},
+			TimeWindow: "1m",
+			TimeSeries: []*pb.TimeSeriesPoint{
+				point(0, 0, 0, 0),
+			},
+		},
+	}
+
+	options := newStatOptions()
+	options.startTime = start
+	options.endTime = start.Add(3 * time.Minute)
+	options.rangeStep = time.Minute
+
+	t.Run("table", func(t *testing.T) {
+		expected := `NAME           SUCCESS        RPS           LATENCY_P50   LATENCY_P95   LATENCY_P99
+deploy/emoji        -         ▁    0.0rps        -             -             -
+deploy/web     █▅ █ 100.00%   ▅▅ █ 2.0rps   ▃▅ █ 40ms     ▃▅ █ 80ms     ▃▅ █ 160ms
+`
+		if out := renderStatTimeSeries(rows, options); out != expected {
+			t.Fatalf("Expected:\n%s\ngot:\n%s", expected, out)
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		options.outputFormat = jsonOutput
+		defer func() { options.outputFormat = tableOutput }()
+
+		expected := `[
+  {
+    "namespace": "emojivoto",
+    "kind": "deployment",
+    "name": "emoji",
+    "timeSeries": [
+      {
+        "time": "2019-11-05T10:00:00Z",
+        "success": null,
+        "rps": 0,
+        "latency_ms_p50": null,
+        "latency_ms_p95": null,
+        "latency_ms_p99": null
+      }
+    ]
+  },
+  {
+    "namespace": "emojivoto",
+    "kind": "deployment",
+    "name": "web",
+    "timeSeries": [
+      {
+        "time": "2019-11-05T10:00:00Z",
+        "success": 1,
+        "rps": 1,
+        "latency_ms_p50": 10,
+        "latency_ms_p95": 20,
+        "latency_ms_p99": 40
+      },
+      {
+        "time": "2019-11-05T10:01:00Z",
+        "success": 0.5,
+        "rps": 1,
+        "latency_ms_p50": 20,
+        "latency_ms_p95": 40,
+        "latency_ms_p99": 80
+      },
+      {
+        "time": "2019-11-05T10:03:00Z",
+        "success": 1,
+        "rps": 2,
+        "latency_ms_p50": 40,
+        "latency_ms_p95": 80,
+        "latency_ms_p99": 160
+      }
+    ]
+  }
+]
+`
+		if out := renderStatTimeSeries(rows, options); out != expected {
+			t.Fatalf("Expected:\n%s\ngot:\n%s", expected, out)
+		}
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"io"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/golang/protobuf/ptypes/duration"
 	"github.com/linkerd/linkerd2/controller/api/util"
@@ -22,6 +23,10 @@ import (
 
 type renderTapEventFunc func(*pb.TapEvent, string) string
 
+// tapEventSource returns the next tap event, or io.EOF once there are no
+// more events.
+type tapEventSource func() (*pb.TapEvent, error)
+
 type tapOptions struct {
 	namespace   string
 	toResource  string
@@ -31,7 +36,12 @@ type tapOptions struct {
 	method      string
 	authority   string
 	path        string
+	status      string
+	minLatency  time.Duration
+	grpcStatus  string
+	headers     map[string]string
 	output      string
+	record      string
 }
 
 type endpoint struct {
@@ -110,7 +120,12 @@ func newTapOptions() *tapOptions {
 		method:      "",
 		authority:   "",
 		path:        "",
+		status:      "",
+		minLatency:  0,
+		grpcStatus:  "",
+		headers:     map[string]string{},
 		output:      "",
+		record:      "",
 	}
 }
 
@@ -164,7 +179,13 @@ func newCmdTap() *cobra.Command {
   linkerd tap pod/web-dlbvj
 
   # tap the test namespace, filter by request to prod namespace
-  linkerd tap ns/test --to ns/prod`,
+  linkerd tap ns/test --to ns/prod
+
+  # tap the web deployment, only displaying requests that failed with a 5xx
+  linkerd tap deploy/web --status 500-599
+
+  # tap the web deployment, saving the events so they can be replayed later
+  linkerd tap deploy/web --record web.tap`,
 		Args:      cobra.RangeArgs(1, 2),
 		ValidArgs: util.ValidTargets,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -179,6 +200,10 @@ func newCmdTap() *cobra.Command {
 				Authority:   options.authority,
 				Path:        options.path,
 				Extract:     options.output == jsonOutput,
+				Status:      options.status,
+				MinLatency:  options.minLatency,
+				GrpcStatus:  options.grpcStatus,
+				Headers:     options.headers,
 			}
 
 			err := options.validate()
@@ -200,28 +225,88 @@ func newCmdTap() *cobra.Command {
 		},
 	}
 
-	cmd.PersistentFlags().StringVarP(&options.namespace, "namespace", "n", options.namespace,
+	cmd.Flags().StringVarP(&options.namespace, "namespace", "n", options.namespace,
 		"Namespace of the specified resource")
-	cmd.PersistentFlags().StringVar(&options.toResource, "to", options.toResource,
+	cmd.Flags().StringVar(&options.toResource, "to", options.toResource,
 		"Display requests to this resource")
-	cmd.PersistentFlags().StringVar(&options.toNamespace, "to-namespace", options.toNamespace,
+	cmd.Flags().StringVar(&options.toNamespace, "to-namespace", options.toNamespace,
 		"Sets the namespace used to lookup the \"--to\" resource; by default the current \"--namespace\" is used")
-	cmd.PersistentFlags().Float32Var(&options.maxRps, "max-rps", options.maxRps,
+	cmd.Flags().Float32Var(&options.maxRps, "max-rps", options.maxRps,
 		"Maximum requests per second to tap.")
-	cmd.PersistentFlags().StringVar(&options.scheme, "scheme", options.scheme,
+	cmd.Flags().StringVar(&options.scheme, "scheme", options.scheme,
 		"Display requests with this scheme")
-	cmd.PersistentFlags().StringVar(&options.method, "method", options.method,
+	cmd.Flags().StringVar(&options.method, "method", options.method,
 		"Display requests with this HTTP method")
-	cmd.PersistentFlags().StringVar(&options.authority, "authority", options.authority,
+	cmd.Flags().StringVar(&options.authority, "authority", options.authority,
 		"Display requests with this :authority")
-	cmd.PersistentFlags().StringVar(&options.path, "path", options.path,
+	cmd.Flags().StringVar(&options.path, "path", options.path,
 		"Display requests with paths that start with this prefix")
-	cmd.PersistentFlags().StringVarP(&options.output, "output", "o", options.output,
+	cmd.Flags().StringVar(&options.status, "status", options.status,
+		"Display requests whose response has this HTTP status, or one within this range (e.g. \"500-599\")")
+	cmd.Flags().DurationVar(&options.minLatency, "min-latency", options.minLatency,
+		"Display requests whose response took at least this long")
+	cmd.Flags().StringVar(&options.grpcStatus, "grpc-status", options.grpcStatus,
+		"Display requests whose response ended with this gRPC status, either numeric or by name (e.g. \"Unavailable\")")
+	cmd.Flags().StringToStringVar(&options.headers, "header", options.headers,
+		"Display requests with this header value (e.g. \"x-request-id=abc\"); may be repeated")
+	cmd.Flags().StringVarP(&options.output, "output", "o", options.output,
 		fmt.Sprintf("Output format. One of: \"%s\", \"%s\"", wideOutput, jsonOutput))
+	cmd.Flags().StringVar(&options.record, "record", options.record,
+		"Also write the events to this file, so they can be replayed with \"linkerd tap replay\"")
+
+	cmd.AddCommand(newCmdTapReplay())
+	cmd.AddCommand(newCmdTapAudit())
 
 	return cmd
 }
 
+func newCmdTapReplay() *cobra.Command {
+	output := ""
+
+	cmd := &cobra.Command{
+		Use:   "replay [flags] FILE",
+		Short: "Replay a traffic stream recorded with \"linkerd tap --record\"",
+		Long: `Replay a traffic stream recorded with "linkerd tap --record".
+
+  The events are rendered as "linkerd tap" renders live events, so recordings
+  can be reviewed after the fact.`,
+		Example: `  # record the traffic of the web deployment
+  linkerd tap deploy/web --record web.tap
+
+  # review it later
+  linkerd tap replay web.tap -o wide`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options := newTapOptions()
+			options.output = output
+			if err := options.validate(); err != nil {
+				return fmt.Errorf("validation error when executing tap replay command: %v", err)
+			}
+
+			file, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			return replayTapEvents(os.Stdout, file, options)
+		},
+	}
+
+	cmd.Flags().StringVarP(&output, "output", "o", output,
+		fmt.Sprintf("Output format. One of: \"%s\", \"%s\"", wideOutput, jsonOutput))
+
+	return cmd
+}
+
+func replayTapEvents(w io.Writer, recording io.Reader, options *tapOptions) error {
+	replayer, err := tap.NewReplayer(recording)
+	if err != nil {
+		return fmt.Errorf("failed to read recording: %s", err)
+	}
+	return writeTapEventsToBuffer(w, replayedEvents(replayer), replayer.Request, options)
+}
+
 func requestTapByResourceFromAPI(w io.Writer, k8sAPI *k8s.KubernetesAPI, req *pb.TapByResourceRequest, options *tapOptions) error {
 	reader, body, err := tap.Reader(k8sAPI, req, 0)
 	if err != nil {
@@ -229,19 +314,71 @@ func requestTapByResourceFromAPI(w io.Writer, k8sAPI *k8s.KubernetesAPI, req *pb
 	}
 	defer body.Close()
 
-	return writeTapEventsToBuffer(w, reader, req, options)
+	events := streamedEvents(reader)
+	if options.record != "" {
+		file, err := os.Create(options.record)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		recorder, err := tap.NewRecorder(file, req)
+		if err != nil {
+			return err
+		}
+		events = recordedEvents(events, recorder)
+	}
+
+	return writeTapEventsToBuffer(w, events, req, options)
+}
+
+// streamedEvents reads events from the tap API's byte stream.
+func streamedEvents(tapByteStream *bufio.Reader) tapEventSource {
+	return func() (*pb.TapEvent, error) {
+		event := pb.TapEvent{}
+		err := protohttp.FromByteStreamToProtocolBuffers(tapByteStream, &event)
+		if err != nil {
+			return nil, err
+		}
+		return &event, nil
+	}
 }
 
-func writeTapEventsToBuffer(w io.Writer, tapByteStream *bufio.Reader, req *pb.TapByResourceRequest, options *tapOptions) error {
+// recordedEvents passes events through, recording each of them.
+func recordedEvents(events tapEventSource, recorder *tap.Recorder) tapEventSource {
+	return func() (*pb.TapEvent, error) {
+		event, err := events()
+		if err != nil {
+			return nil, err
+		}
+		if err := recorder.Record(event); err != nil {
+			return nil, fmt.Errorf("failed to record tap event: %s", err)
+		}
+		return event, nil
+	}
+}
+
+// replayedEvents reads events from a recording.
+func replayedEvents(replayer *tap.Replayer) tapEventSource {
+	return func() (*pb.TapEvent, error) {
+		rec, err := replayer.Next()
+		if err != nil {
+			return nil, err
+		}
+		return rec.Event, nil
+	}
+}
+
+func writeTapEventsToBuffer(w io.Writer, events tapEventSource, req *pb.TapByResourceRequest, options *tapOptions) error {
 	var err error
 	switch options.output {
 	case "":
-		err = renderTapEvents(tapByteStream, w, renderTapEvent, "")
+		err = renderTapEvents(events, w, renderTapEvent, "")
 	case wideOutput:
 		resource := req.GetTarget().GetResource().GetType()
-		err = renderTapEvents(tapByteStream, w, renderTapEvent, resource)
+		err = renderTapEvents(events, w, renderTapEvent, resource)
 	case jsonOutput:
-		err = renderTapEvents(tapByteStream, w, renderTapEventJSON, "")
+		err = renderTapEvents(events, w, renderTapEventJSON, "")
 	}
 	if err != nil {
 		return err
@@ -250,11 +387,10 @@ func writeTapEventsToBuffer(w io.Writer, tapByteStream *bufio.Reader, req *pb.Ta
 	return nil
 }
 
-func renderTapEvents(tapByteStream *bufio.Reader, w io.Writer, render renderTapEventFunc, resource string) error {
+func renderTapEvents(events tapEventSource, w io.Writer, render renderTapEventFunc, resource string) error {
 	for {
 		log.Debug("Waiting for data...")
-		event := pb.TapEvent{}
-		err := protohttp.FromByteStreamToProtocolBuffers(tapByteStream, &event)
+		event, err := events()
 		if err == io.EOF {
 			break
 		}
@@ -262,7 +398,7 @@ func renderTapEvents(tapByteStream *bufio.Reader, w io.Writer, render renderTapE
 			fmt.Fprintln(os.Stderr, err)
 			break
 		}
-		_, err = fmt.Fprintln(w, render(&event, resource))
+		_, err = fmt.Fprintln(w, render(event, resource))
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,176 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	"github.com/linkerd/linkerd2/pkg/tap"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+// tapAuditLogsMessage reminds users that the listed sessions aren't the
+// complete audit trail.
+const tapAuditLogsMessage = "\nSessions are only listed while the API server keeps their events, or the tap replicas keep them in memory; see the \"tap session\" entries of the linkerd-tap logs for the complete audit trail."
+
+type tapAuditOptions struct {
+	namespace string
+	user      string
+	output    string
+}
+
+func newTapAuditOptions() *tapAuditOptions {
+	return &tapAuditOptions{
+		output: tableOutput,
+	}
+}
+
+func (o *tapAuditOptions) validate() error {
+	switch o.output {
+	case tableOutput, wideOutput, jsonOutput:
+		return nil
+	default:
+		return fmt.Errorf("output format \"%s\" not recognized", o.output)
+	}
+}
+
+func newCmdTapAudit() *cobra.Command {
+	options := newTapAuditOptions()
+
+	cmd := &cobra.Command{
+		Use:   "audit [flags]",
+		Short: "List recent tap sessions",
+		Long: `List recent tap sessions.
+
+  Every tap session is audited by the tap controller, which keeps track of the
+  user who started it, the resource they tapped, the match expression they
+  used, and the number of events they were sent. Attempts denied by RBAC are
+  listed too. Listing the sessions requires the "list" verb on the "sessions"
+  resource of the "tap.linkerd.io" API group.
+
+  When the tap controller records sessions as Kubernetes events (the
+  tap.auditEvents Helm value), the sessions are listed from the TapStarted,
+  TapEnded and TapDenied events, for as long as the API server keeps them,
+  which is one hour by default. Attempts on resources that don't exist leave
+  no event. Otherwise they are collected from the memory of every tap
+  replica, which keeps its most recent sessions until it restarts. Either
+  way, the structured "tap session" entries of the tap controller's logs are
+  the authoritative audit trail.`,
+		Example: `  # list the recent tap sessions in all namespaces
+  linkerd tap audit
+
+  # list the recent tap sessions of alice in the emojivoto namespace
+  linkerd tap audit -n emojivoto --user alice -o wide`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := options.validate(); err != nil {
+				return fmt.Errorf("validation error when executing tap audit command: %v", err)
+			}
+
+			k8sAPI, err := k8s.NewAPI(kubeconfigPath, kubeContext, impersonate, 0)
+			if err != nil {
+				return err
+			}
+
+			sessions, err := tap.Sessions(k8sAPI, 0)
+			if err != nil {
+				return err
+			}
+
+			err = renderTapSessions(os.Stdout, filterTapSessions(sessions, options), options, time.Now())
+			if err != nil {
+				return err
+			}
+			if options.output != jsonOutput {
+				fmt.Fprintln(os.Stderr, tapAuditLogsMessage)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&options.namespace, "namespace", "n", options.namespace,
+		"Only list the sessions tapping resources in this namespace")
+	cmd.Flags().StringVar(&options.user, "user", options.user,
+		"Only list the sessions started by this user")
+	cmd.Flags().StringVarP(&options.output, "output", "o", options.output,
+		fmt.Sprintf("Output format. One of: \"%s\", \"%s\", \"%s\"", tableOutput, wideOutput, jsonOutput))
+
+	return cmd
+}
+
+func filterTapSessions(sessions []tap.Session, options *tapAuditOptions) []tap.Session {
+	filtered := []tap.Session{}
+	for _, session := range sessions {
+		if options.namespace != "" && session.Namespace != options.namespace {
+			continue
+		}
+		if options.user != "" && session.User != options.user {
+			continue
+		}
+		filtered = append(filtered, session)
+	}
+	return filtered
+}
+
+func renderTapSessions(w io.Writer, sessions []tap.Session, options *tapAuditOptions, now time.Time) error {
+	if options.output == jsonOutput {
+		out, err := json.MarshalIndent(sessions, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(w, "%s\n", out)
+		return err
+	}
+
+	if len(sessions) == 0 {
+		_, err := fmt.Fprintln(w, "No tap sessions found.")
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, padding, ' ', 0)
+	headers := []string{"STARTED", "DURATION", "USER", "NAMESPACE", "TARGET", "EVENTS"}
+	if options.output == wideOutput {
+		headers = append(headers, "GROUPS", "MATCH", "ERROR")
+	}
+	fmt.Fprintln(tw, strings.Join(headers, "\t"))
+
+	for _, session := range sessions {
+		sessionDuration := "running"
+		if session.Denied {
+			sessionDuration = "denied"
+		} else if session.EndedAt != nil {
+			sessionDuration = duration.HumanDuration(session.EndedAt.Sub(session.StartedAt))
+		}
+		columns := []string{
+			duration.HumanDuration(now.Sub(session.StartedAt)) + " ago",
+			sessionDuration,
+			session.User,
+			session.Namespace,
+			session.Target(),
+			fmt.Sprintf("%d", session.Events),
+		}
+		if options.output == wideOutput {
+			columns = append(columns,
+				orDash(strings.Join(session.Groups, ",")),
+				orDash(session.Match),
+				orDash(session.Error),
+			)
+		}
+		fmt.Fprintln(tw, strings.Join(columns, "\t"))
+	}
+
+	return tw.Flush()
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
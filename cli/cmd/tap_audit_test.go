@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/linkerd/linkerd2/pkg/tap"
+)
+
+func TestRenderTapSessions(t *testing.T) {
+	now := time.Date(2019, 11, 5, 10, 0, 0, 0, time.UTC)
+	endedAt := now.Add(-5 * time.Minute)
+	denyAt := now.Add(-time.Hour)
+	sessions := []tap.Session{
+		{
+			User:      "alice",
+			Groups:    []string{"devs", "system:authenticated"},
+			Namespace: "emojivoto",
+			Resource:  "deployment",
+			Name:      "web",
+			Match:     `http:<path:"/api" >`,
+			StartedAt: now.Add(-30 * time.Second),
+			Events:    12,
+		},
+		{
+			User:      "bob",
+			Namespace: "linkerd",
+			Resource:  "pod",
+			StartedAt: now.Add(-10 * time.Minute),
+			EndedAt:   &endedAt,
+			Events:    0,
+			Error:     "no pods found",
+		},
+		{
+			User:      "mallory",
+			Namespace: "emojivoto",
+			Resource:  "deployment",
+			Name:      "web",
+			StartedAt: now.Add(-time.Hour),
+			EndedAt:   &denyAt,
+			Denied:    true,
+			Error:     "tap authorization failed",
+		},
+	}
+
+	testCases := []struct {
+		options  *tapAuditOptions
+		expected string
+	}{
+		{
+			options: &tapAuditOptions{output: tableOutput},
+			expected: `STARTED   DURATION   USER      NAMESPACE   TARGET           EVENTS
+30s ago   running    alice     emojivoto   deployment/web   12
+10m ago   5m         bob       linkerd     pod              0
+60m ago   denied     mallory   emojivoto   deployment/web   0
+`,
+		},
+		{
+			options: &tapAuditOptions{output: wideOutput, user: "bob"},
+			expected: `STARTED   DURATION   USER   NAMESPACE   TARGET   EVENTS   GROUPS   MATCH   ERROR
+10m ago   5m         bob    linkerd     pod      0        -        -       no pods found
+`,
+		},
+		{
+			options:  &tapAuditOptions{output: tableOutput, namespace: "default"},
+			expected: "No tap sessions found.\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.options.output, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := renderTapSessions(&buf, filterTapSessions(sessions, tc.options), tc.options, now)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if buf.String() != tc.expected {
+				t.Fatalf("Expected:\n%s\ngot:\n%s", tc.expected, buf.String())
+			}
+		})
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/golang/protobuf/ptypes/duration"
@@ -128,8 +130,15 @@ func busyTest(t *testing.T, output string) {
 	defer ts.Close()
 	kubeAPI.Config.Host = ts.URL
 
+	dir, err := ioutil.TempDir("", "tap")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
 	options := newTapOptions()
 	options.output = output
+	options.record = filepath.Join(dir, "busy.tap")
 
 	writer := bytes.NewBufferString("")
 	err = requestTapByResourceFromAPI(writer, kubeAPI, req, options)
@@ -156,6 +165,23 @@ func busyTest(t *testing.T, output string) {
 	if expectedContent != actual {
 		t.Fatalf("Expected function to render:\n%s\bbut got:\n%s", expectedContent, actual)
 	}
+
+	// Replaying the recording renders the same output.
+	recording, err := os.Open(options.record)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	defer recording.Close()
+
+	writer = bytes.NewBufferString("")
+	err = replayTapEvents(writer, recording, options)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	actual = writer.String()
+	if expectedContent != actual {
+		t.Fatalf("Expected replay to render:\n%s\bbut got:\n%s", expectedContent, actual)
+	}
 }
 
 func TestRequestTapByResourceFromAPI(t *testing.T) {
@@ -1,9 +1,9 @@
 package cmd
 
 import (
-	"bufio"
 	"fmt"
 	"io"
+	"os"
 	"sort"
 	"strconv"
 	"strings"
@@ -15,7 +15,6 @@ import (
 	pb "github.com/linkerd/linkerd2/controller/gen/public"
 	"github.com/linkerd/linkerd2/pkg/addr"
 	"github.com/linkerd/linkerd2/pkg/k8s"
-	"github.com/linkerd/linkerd2/pkg/protohttp"
 	"github.com/linkerd/linkerd2/pkg/tap"
 	runewidth "github.com/mattn/go-runewidth"
 	termbox "github.com/nsf/termbox-go"
@@ -34,6 +33,7 @@ type topOptions struct {
 	path        string
 	hideSources bool
 	routes      bool
+	fromFile    string
 }
 
 type topRequest struct {
@@ -266,6 +266,7 @@ func newTopOptions() *topOptions {
 		path:        "",
 		hideSources: false,
 		routes:      false,
+		fromFile:    "",
 	}
 }
 
@@ -310,8 +311,16 @@ func newCmdTop() *cobra.Command {
   linkerd top deploy/web
 
   # display traffic for the web-dlbvj pod in the default namespace
-  linkerd top pod/web-dlbvj`,
-		Args:      cobra.RangeArgs(1, 2),
+  linkerd top pod/web-dlbvj
+
+  # display traffic recorded with "linkerd tap --record"
+  linkerd top --from-file web.tap`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if options.fromFile != "" {
+				return cobra.NoArgs(cmd, args)
+			}
+			return cobra.RangeArgs(1, 2)(cmd, args)
+		},
 		ValidArgs: util.ValidTargets,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			requestParams := util.TapRequestParams{
@@ -340,6 +349,17 @@ func newCmdTop() *cobra.Command {
 				table.columns[routeColumn].display = true
 			}
 
+			if options.fromFile != "" {
+				// Recordings are replayed as they were captured, so the flags
+				// building the live tap request don't apply.
+				for _, flag := range []string{"namespace", "to", "to-namespace", "max-rps", "scheme", "method", "authority", "path"} {
+					if cmd.Flags().Changed(flag) {
+						return fmt.Errorf("--%s cannot be combined with --from-file", flag)
+					}
+				}
+				return getTrafficFromFile(options.fromFile, table)
+			}
+
 			req, err := util.BuildTapByResourceRequest(requestParams)
 			if err != nil {
 				return err
@@ -372,6 +392,8 @@ func newCmdTop() *cobra.Command {
 		"Display requests with paths that start with this prefix")
 	cmd.PersistentFlags().BoolVar(&options.hideSources, "hide-sources", options.hideSources, "Hide the source column")
 	cmd.PersistentFlags().BoolVar(&options.routes, "routes", options.routes, "Display data per route instead of per path")
+	cmd.PersistentFlags().StringVar(&options.fromFile, "from-file", options.fromFile,
+		"Display the traffic recorded in this file with \"linkerd tap --record\" instead of live traffic")
 
 	return cmd
 }
@@ -383,14 +405,35 @@ func getTrafficByResourceFromAPI(k8sAPI *k8s.KubernetesAPI, req *pb.TapByResourc
 	}
 	defer body.Close()
 
-	err = termbox.Init()
+	return renderTraffic(streamedEvents(reader), table, true)
+}
+
+func getTrafficFromFile(path string, table *topTable) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	replayer, err := tap.NewReplayer(file)
+	if err != nil {
+		return fmt.Errorf("failed to read recording: %s", err)
+	}
+
+	// Keep displaying the table once the recording has been read, until the
+	// user quits.
+	return renderTraffic(replayedEvents(replayer), table, false)
+}
+
+func renderTraffic(events tapEventSource, table *topTable, closeAtEOF bool) error {
+	err := termbox.Init()
 	if err != nil {
 		return err
 	}
 	defer termbox.Close()
 
 	// for event processing:
-	// reader ->
+	// events ->
 	//   recvEvents() ->
 	//     eventCh ->
 	//       processEvents() ->
@@ -408,7 +451,7 @@ func getTrafficByResourceFromAPI(k8sAPI *k8s.KubernetesAPI, req *pb.TapByResourc
 	done := make(chan struct{})
 
 	go pollInput(closing)
-	go recvEvents(reader, eventCh, closing)
+	go recvEvents(events, eventCh, closing, closeAtEOF)
 	go processEvents(eventCh, requestCh, done)
 
 	go func() {
@@ -421,11 +464,13 @@ func getTrafficByResourceFromAPI(k8sAPI *k8s.KubernetesAPI, req *pb.TapByResourc
 	return nil
 }
 
-func recvEvents(tapByteStream *bufio.Reader, eventCh chan<- pb.TapEvent, closing chan<- struct{}) {
+func recvEvents(events tapEventSource, eventCh chan<- pb.TapEvent, closing chan<- struct{}, closeAtEOF bool) {
 	for {
-		event := pb.TapEvent{}
-		err := protohttp.FromByteStreamToProtocolBuffers(tapByteStream, &event)
+		event, err := events()
 		if err != nil {
+			if err == io.EOF && !closeAtEOF {
+				return
+			}
 			if err == io.EOF {
 				fmt.Println("Tap stream terminated")
 			} else if !strings.HasSuffix(err.Error(), "http2: response body closed") {
@@ -436,7 +481,7 @@ func recvEvents(tapByteStream *bufio.Reader, eventCh chan<- pb.TapEvent, closing
 			return
 		}
 
-		eventCh <- event
+		eventCh <- *event
 	}
 }
 
@@ -30,10 +30,14 @@ const (
 	visitMessage           = "Visit this URL for further instructions: https://linkerd.io/upgrade/#nextsteps"
 	failMessage            = "For troubleshooting help, visit: https://linkerd.io/upgrade/#troubleshooting\n"
 	trustRootChangeMessage = "Rotating the trust anchors will affect existing proxies\nSee https://linkerd.io/cert-rotation for more information"
+	trustRootAddMessage    = "The new trust anchors will be trusted by proxies once they are restarted\nRun `linkerd check` to follow the progress of the rotation before updating the issuer certificate"
+	trustRootRemoveMessage = "The removed trust anchors will no longer be trusted by proxies once they are restarted\nRun `linkerd check` to follow the progress of the rotation"
 )
 
 type upgradeOptions struct {
-	manifests string
+	manifests                 string
+	addTrustAnchorsPEMFile    string
+	removeTrustAnchorsPEMFile string
 	*installOptions
 
 	verifyTLS func(tls *charts.TLS, service string) error
@@ -62,6 +66,14 @@ func (options *upgradeOptions) upgradeOnlyFlagSet() *pflag.FlagSet {
 		&options.manifests, "from-manifests", options.manifests,
 		"Read config from a Linkerd install YAML rather than from Kubernetes",
 	)
+	flags.StringVar(
+		&options.addTrustAnchorsPEMFile, "identity-add-trust-anchors-file", options.addTrustAnchorsPEMFile,
+		"A path to a PEM-encoded file containing trust anchors to add to the existing ones, to start a trust anchor rotation",
+	)
+	flags.StringVar(
+		&options.removeTrustAnchorsPEMFile, "identity-remove-trust-anchors-file", options.removeTrustAnchorsPEMFile,
+		"A path to a PEM-encoded file containing trust anchors to remove from the existing ones, to complete a trust anchor rotation",
+	)
 
 	return flags
 }
@@ -192,6 +204,12 @@ func upgradeRunE(options *upgradeOptions, stage string, flags *pflag.FlagSet) er
 	if options.identityOptions.trustPEMFile != "" {
 		fmt.Fprintf(os.Stderr, "\n%s %s\n", warnStatus, trustRootChangeMessage)
 	}
+	if options.addTrustAnchorsPEMFile != "" {
+		fmt.Fprintf(os.Stderr, "\n%s %s\n", warnStatus, trustRootAddMessage)
+	}
+	if options.removeTrustAnchorsPEMFile != "" {
+		fmt.Fprintf(os.Stderr, "\n%s %s\n", warnStatus, trustRootRemoveMessage)
+	}
 
 	fmt.Fprintf(os.Stderr, "\n%s %s\n", okStatus, okMessage)
 	if stage == configStage {
@@ -272,6 +290,30 @@ func (options *upgradeOptions) validateAndBuild(stage string, k kubernetes.Inter
 		}
 	}
 
+	if options.identityOptions.issuerRenewBefore != 0 && configs.GetGlobal().GetIdentityContext().GetScheme() == string(corev1.SecretTypeTLS) {
+		return nil, nil, errors.New("cannot renew issuer certificates if you are using external cert management solution")
+	}
+
+	if options.addTrustAnchorsPEMFile != "" || options.removeTrustAnchorsPEMFile != "" {
+		if options.identityOptions.trustPEMFile != "" {
+			return nil, nil, errors.New("--identity-trust-anchors-file cannot be combined with --identity-add-trust-anchors-file or --identity-remove-trust-anchors-file")
+		}
+		if options.addTrustAnchorsPEMFile != "" && options.removeTrustAnchorsPEMFile != "" {
+			return nil, nil, errors.New("trust anchors must be added and removed in separate upgrades")
+		}
+		if options.identityOptions.crtPEMFile != "" || options.identityOptions.keyPEMFile != "" {
+			return nil, nil, errors.New("the trust root and issuer certificate/key must be upgraded separately")
+		}
+		for _, f := range []string{options.addTrustAnchorsPEMFile, options.removeTrustAnchorsPEMFile} {
+			if f == "" {
+				continue
+			}
+			if err := checkFilesExist([]string{f}); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
 	var identity *charts.Identity
 	idctx := configs.GetGlobal().GetIdentityContext()
 	if idctx.GetTrustDomain() == "" || idctx.GetTrustAnchorsPem() == "" {
@@ -407,7 +449,10 @@ func (options *upgradeOptions) fetchIdentityValues(k kubernetes.Interface, idctx
 		}
 		trustAnchorsPEM = string(trustb)
 	} else {
-		trustAnchorsPEM = idctx.GetTrustAnchorsPem()
+		trustAnchorsPEM, err = options.rotateTrustAnchors(idctx.GetTrustAnchorsPem())
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if options.identityOptions.crtPEMFile != "" && options.identityOptions.keyPEMFile != "" {
@@ -415,27 +460,114 @@ func (options *upgradeOptions) fetchIdentityValues(k kubernetes.Interface, idctx
 	} else {
 		issuerData, err = fetchIssuer(k, trustAnchorsPEM, idctx.Scheme)
 	}
+	if err != nil {
+		if options.removeTrustAnchorsPEMFile != "" {
+			return nil, fmt.Errorf("the issuer certificate does not chain up to the remaining trust anchors: %s", err)
+		}
+		return nil, err
+	}
+
+	// The issuer of external schemes is verified against the trust anchors
+	// stored alongside it, so make sure it also chains up to the rotated
+	// bundle proxies are going to trust.
+	if options.removeTrustAnchorsPEMFile != "" && issuerData.TrustAnchors != trustAnchorsPEM {
+		rotated := issuercerts.IssuerCertData{
+			TrustAnchors: trustAnchorsPEM,
+			IssuerCrt:    issuerData.IssuerCrt,
+			IssuerKey:    issuerData.IssuerKey,
+		}
+		if _, err := rotated.VerifyAndBuildCreds(""); err != nil {
+			return nil, fmt.Errorf("the issuer certificate does not chain up to the remaining trust anchors: %s", err)
+		}
+	}
+
+	trustAnchor, err := options.fetchTrustAnchor(k, issuerData)
 	if err != nil {
 		return nil, err
 	}
 
 	return &charts.Identity{
-		TrustDomain:     idctx.GetTrustDomain(),
-		TrustAnchorsPEM: trustAnchorsPEM,
+		TrustDomain:       idctx.GetTrustDomain(),
+		SpiffeTrustDomain: idctx.GetSpiffeTrustDomain(),
+		TrustAnchorsPEM:   trustAnchorsPEM,
 		Issuer: &charts.Issuer{
 			Scheme:              idctx.Scheme,
 			ClockSkewAllowance:  idctx.GetClockSkewAllowance().String(),
 			IssuanceLifetime:    idctx.GetIssuanceLifetime().String(),
 			CrtExpiry:           *issuerData.Expiry,
 			CrtExpiryAnnotation: k8s.IdentityIssuerExpiryAnnotation,
+			RenewBefore:         options.identityOptions.renewBefore(),
 			TLS: &charts.TLS{
 				KeyPEM: issuerData.IssuerKey,
 				CrtPEM: issuerData.IssuerCrt,
 			},
+			TrustAnchor: trustAnchor,
 		},
 	}, nil
 }
 
+// fetchTrustAnchor returns the trust anchor credentials the identity
+// controller renews the issuer certificate with, when renewal is enabled.
+//
+// A linkerd-identity-trust-anchor Secret rendered by a previous install is
+// carried over so that it doesn't get pruned, while one created by hand is
+// left alone. Otherwise, a self-signed issuer (as generated by `linkerd
+// install`) is its own trust anchor.
+func (options *upgradeOptions) fetchTrustAnchor(k kubernetes.Interface, issuerData *issuercerts.IssuerCertData) (*charts.TLS, error) {
+	if options.identityOptions.issuerRenewBefore == 0 {
+		return nil, nil
+	}
+
+	secret, err := k.CoreV1().Secrets(controlPlaneNamespace).Get(k8s.IdentityTrustAnchorSecretName, metav1.GetOptions{})
+	if err == nil {
+		if secret.Labels[k8s.ControllerNSLabel] == "" {
+			return nil, nil
+		}
+		return &charts.TLS{
+			KeyPEM: string(secret.Data[k8s.IdentityIssuerKeyName]),
+			CrtPEM: string(secret.Data[k8s.IdentityIssuerCrtName]),
+		}, nil
+	}
+	if !kerrors.IsNotFound(err) {
+		return nil, fmt.Errorf("could not fetch existing trust anchor secret: %s", err)
+	}
+
+	crt, err := tls.DecodePEMCrt(issuerData.IssuerCrt)
+	if err != nil {
+		return nil, err
+	}
+	if !crt.Certificate.IsCA || crt.Certificate.CheckSignatureFrom(crt.Certificate) != nil {
+		return nil, nil
+	}
+	return &charts.TLS{
+		KeyPEM: issuerData.IssuerKey,
+		CrtPEM: issuerData.IssuerCrt,
+	}, nil
+}
+
+// rotateTrustAnchors returns the trust anchors bundle resulting from adding or
+// removing the roots given with --identity-add-trust-anchors-file or
+// --identity-remove-trust-anchors-file.
+func (options *upgradeOptions) rotateTrustAnchors(bundle string) (string, error) {
+	if options.addTrustAnchorsPEMFile != "" {
+		added, err := ioutil.ReadFile(options.addTrustAnchorsPEMFile)
+		if err != nil {
+			return "", err
+		}
+		return issuercerts.MergeTrustAnchors(bundle, string(added))
+	}
+
+	if options.removeTrustAnchorsPEMFile != "" {
+		removed, err := ioutil.ReadFile(options.removeTrustAnchorsPEMFile)
+		if err != nil {
+			return "", err
+		}
+		return issuercerts.RemoveTrustAnchors(bundle, string(removed))
+	}
+
+	return bundle, nil
+}
+
 func readIssuer(trustPEM, issuerCrtPath, issuerKeyPath string) (*issuercerts.IssuerCertData, error) {
 	key, crt, err := issuercerts.LoadIssuerCrtAndKeyFromFiles(issuerKeyPath, issuerCrtPath)
 	if err != nil {
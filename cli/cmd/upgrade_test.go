@@ -2,15 +2,22 @@ package cmd
 
 import (
 	"bytes"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io/ioutil"
+	"os"
 	"path/filepath"
 	"reflect"
 	"testing"
+	"time"
 
 	pb "github.com/linkerd/linkerd2/controller/gen/config"
+	charts "github.com/linkerd/linkerd2/pkg/charts/linkerd2"
+	"github.com/linkerd/linkerd2/pkg/issuercerts"
 	"github.com/linkerd/linkerd2/pkg/k8s"
+	"github.com/linkerd/linkerd2/pkg/tls"
 )
 
 const (
@@ -942,3 +949,165 @@ type: Opaque`,
 		t.Errorf("identity config not serialized")
 	}
 }
+
+func TestUpgradeRotateTrustAnchors(t *testing.T) {
+	newRoot := func() *tls.CA {
+		root, err := tls.GenerateRootCAWithDefaults("identity.linkerd.cluster.local")
+		if err != nil {
+			t.Fatalf("Failed to generate root CA: %s", err)
+		}
+		return root
+	}
+	oldRoot := newRoot()
+	newerRoot := newRoot()
+	issuer, err := oldRoot.GenerateCA("identity.linkerd.cluster.local", tls.Validity{}, -1)
+	if err != nil {
+		t.Fatalf("Failed to generate issuer CA: %s", err)
+	}
+	oldPEM := oldRoot.Cred.Crt.EncodeCertificatePEM()
+	newerPEM := newerRoot.Cred.Crt.EncodeCertificatePEM()
+
+	dir, err := ioutil.TempDir("", "trust-anchors")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %s", err)
+	}
+	defer os.RemoveAll(dir)
+	oldFile := filepath.Join(dir, "old.pem")
+	newerFile := filepath.Join(dir, "new.pem")
+	if err := ioutil.WriteFile(oldFile, []byte(oldPEM), 0600); err != nil {
+		t.Fatalf("Failed to write trust anchors: %s", err)
+	}
+	if err := ioutil.WriteFile(newerFile, []byte(newerPEM), 0600); err != nil {
+		t.Fatalf("Failed to write trust anchors: %s", err)
+	}
+
+	k, err := k8s.NewFakeAPI(fmt.Sprintf(`
+kind: Secret
+apiVersion: v1
+metadata:
+  name: linkerd-identity-issuer
+  namespace: linkerd
+data:
+  crt.pem: %s
+  key.pem: %s`,
+		base64.StdEncoding.EncodeToString([]byte(issuer.Cred.Crt.EncodeCertificatePEM())),
+		base64.StdEncoding.EncodeToString([]byte(issuer.Cred.EncodePrivateKeyPEM()))))
+	if err != nil {
+		t.Fatalf("Error mocking k8s client: %s", err)
+	}
+
+	testCases := []struct {
+		description          string
+		trustAnchorsPEM      string
+		addFile, removeFile  string
+		expectedTrustAnchors string
+		expectedErr          bool
+	}{
+		{"add the new trust anchor", oldPEM, newerFile, "", oldPEM + newerPEM, false},
+		{"remove the unused trust anchor", oldPEM + newerPEM + newerPEM, "", newerFile, oldPEM, false},
+		{"remove the trust anchor the issuer chains up to", oldPEM + newerPEM, "", oldFile, "", true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.description, func(t *testing.T) {
+			options, err := testUpgradeOptions()
+			if err != nil {
+				t.Fatalf("failed to create upgrade options: %s", err)
+			}
+			options.addTrustAnchorsPEMFile = tc.addFile
+			options.removeTrustAnchorsPEMFile = tc.removeFile
+
+			identity, err := options.fetchIdentityValues(k, &pb.IdentityContext{
+				TrustDomain:     "cluster.local",
+				TrustAnchorsPem: tc.trustAnchorsPEM,
+				Scheme:          k8s.IdentityIssuerSchemeLinkerd,
+			})
+			if tc.expectedErr {
+				if err == nil {
+					t.Fatalf("Expected an error, got nothing")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if identity.TrustAnchorsPEM != tc.expectedTrustAnchors {
+				t.Fatalf("Expected trust anchors:\n%s\ngot:\n%s", tc.expectedTrustAnchors, identity.TrustAnchorsPEM)
+			}
+		})
+	}
+}
+
+func TestUpgradeFetchTrustAnchor(t *testing.T) {
+	root, err := tls.GenerateRootCAWithDefaults("identity.linkerd.cluster.local")
+	if err != nil {
+		t.Fatalf("Failed to generate root CA: %s", err)
+	}
+	issuer, err := root.GenerateCA("identity.linkerd.cluster.local", tls.Validity{}, -1)
+	if err != nil {
+		t.Fatalf("Failed to generate issuer CA: %s", err)
+	}
+	rootData := &issuercerts.IssuerCertData{
+		IssuerCrt: root.Cred.Crt.EncodeCertificatePEM(),
+		IssuerKey: root.Cred.EncodePrivateKeyPEM(),
+	}
+	issuerData := &issuercerts.IssuerCertData{
+		IssuerCrt: issuer.Cred.Crt.EncodeCertificatePEM(),
+		IssuerKey: issuer.Cred.EncodePrivateKeyPEM(),
+	}
+	rootTLS := &charts.TLS{KeyPEM: rootData.IssuerKey, CrtPEM: rootData.IssuerCrt}
+
+	trustAnchorSecret := func(labels string) string {
+		return fmt.Sprintf(`
+kind: Secret
+apiVersion: v1
+metadata:
+  name: linkerd-identity-trust-anchor
+  namespace: linkerd
+  labels:%s
+data:
+  crt.pem: %s
+  key.pem: %s`,
+			labels,
+			base64.StdEncoding.EncodeToString([]byte(rootData.IssuerCrt)),
+			base64.StdEncoding.EncodeToString([]byte(rootData.IssuerKey)))
+	}
+
+	testCases := []struct {
+		description string
+		renewBefore time.Duration
+		k8sConfigs  []string
+		issuerData  *issuercerts.IssuerCertData
+		expected    *charts.TLS
+	}{
+		{"renewal disabled", 0, []string{trustAnchorSecret("\n    linkerd.io/control-plane-ns: linkerd")}, rootData, nil},
+		{"secret rendered by linkerd", time.Hour, []string{trustAnchorSecret("\n    linkerd.io/control-plane-ns: linkerd")}, issuerData, rootTLS},
+		{"secret created by hand", time.Hour, []string{trustAnchorSecret(" {}")}, issuerData, nil},
+		{"self-signed issuer", time.Hour, nil, rootData, rootTLS},
+		{"intermediate issuer", time.Hour, nil, issuerData, nil},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.description, func(t *testing.T) {
+			k, err := k8s.NewFakeAPI(tc.k8sConfigs...)
+			if err != nil {
+				t.Fatalf("Error mocking k8s client: %s", err)
+			}
+			options, err := testUpgradeOptions()
+			if err != nil {
+				t.Fatalf("failed to create upgrade options: %s", err)
+			}
+			options.identityOptions.issuerRenewBefore = tc.renewBefore
+
+			trustAnchor, err := options.fetchTrustAnchor(k, tc.issuerData)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(trustAnchor, tc.expected) {
+				t.Fatalf("Expected trust anchor %+v, got %+v", tc.expected, trustAnchor)
+			}
+		})
+	}
+}
@@ -15,13 +15,21 @@ const defaultWeight uint32 = 10000
 
 // endpointTranslator satisfies EndpointUpdateListener and translates updates
 // into Destination.Get messages.
+//
+// If a topology is set, the translator keeps track of all the available
+// addresses and of the addresses it has sent, so that it only sends the
+// addresses selected by the topology as endpoints come and go.
 type endpointTranslator struct {
 	controllerNS        string
 	identityTrustDomain string
 	enableH2Upgrade     bool
 	labels              map[string]string
+	topology            *topology
 	stream              pb.Destination_GetServer
 	log                 *logging.Entry
+
+	available watcher.PodSet
+	sent      watcher.PodSet
 }
 
 func newEndpointTranslator(
@@ -29,6 +37,7 @@ func newEndpointTranslator(
 	identityTrustDomain string,
 	enableH2Upgrade bool,
 	service watcher.ServiceID,
+	topology *topology,
 	stream pb.Destination_GetServer,
 	log *logging.Entry,
 ) *endpointTranslator {
@@ -37,14 +46,82 @@ func newEndpointTranslator(
 		"service":   service,
 	})
 
-	labels := map[string]string{
-		"namespace": service.Namespace,
-		"service":   service.Name,
+	// Destinations which are not services, such as pod IPs, carry no
+	// set-level metric labels; each address is labeled with its pod instead.
+	labels := map[string]string{}
+	if service.Name != "" {
+		labels["namespace"] = service.Namespace
+		labels["service"] = service.Name
+	}
+	return &endpointTranslator{
+		controllerNS:        controllerNS,
+		identityTrustDomain: identityTrustDomain,
+		enableH2Upgrade:     enableH2Upgrade,
+		labels:              labels,
+		topology:            topology,
+		stream:              stream,
+		log:                 log,
+		available:           watcher.PodSet{},
+		sent:                watcher.PodSet{},
 	}
-	return &endpointTranslator{controllerNS, identityTrustDomain, enableH2Upgrade, labels, stream, log}
 }
 
 func (et *endpointTranslator) Add(set watcher.PodSet) {
+	if et.topology == nil {
+		et.sendAdd(set)
+		return
+	}
+	for id, address := range set {
+		et.available[id] = address
+	}
+	et.syncTopology()
+}
+
+func (et *endpointTranslator) Remove(set watcher.PodSet) {
+	if et.topology == nil {
+		et.sendRemove(set)
+		return
+	}
+	for id := range set {
+		delete(et.available, id)
+	}
+	et.syncTopology()
+}
+
+// syncTopology sends the difference between the addresses selected by the
+// topology and the addresses which have already been sent.
+func (et *endpointTranslator) syncTopology() {
+	selected := et.topology.filter(et.available)
+
+	add := watcher.PodSet{}
+	for id, address := range selected {
+		if sent, ok := et.sent[id]; !ok || sent != address {
+			add[id] = address
+		}
+	}
+	remove := watcher.PodSet{}
+	for id, address := range et.sent {
+		if _, ok := selected[id]; !ok {
+			remove[id] = address
+		}
+	}
+
+	// Addresses are added before others are removed so that the client is
+	// never left without endpoints when switching between zones.
+	if len(add) > 0 {
+		et.sendAdd(add)
+	}
+	if len(remove) > 0 {
+		et.sendRemove(remove)
+	}
+
+	et.sent = watcher.PodSet{}
+	for id, address := range selected {
+		et.sent[id] = address
+	}
+}
+
+func (et *endpointTranslator) sendAdd(set watcher.PodSet) {
 	addrs := []*pb.WeightedAddr{}
 	for _, address := range set {
 		var (
@@ -60,7 +137,7 @@ func (et *endpointTranslator) Add(set watcher.PodSet) {
 			addr, err = et.toAddr(address)
 			wa = &pb.WeightedAddr{
 				Addr:   addr,
-				Weight: defaultWeight,
+				Weight: et.topology.weight(address),
 			}
 		}
 
@@ -84,7 +161,7 @@ func (et *endpointTranslator) Add(set watcher.PodSet) {
 	}
 }
 
-func (et *endpointTranslator) Remove(set watcher.PodSet) {
+func (et *endpointTranslator) sendRemove(set watcher.PodSet) {
 	addrs := []*net.TcpAddress{}
 	for _, address := range set {
 		tcpAddr, err := et.toAddr(address)
@@ -110,6 +187,9 @@ func (et *endpointTranslator) Remove(set watcher.PodSet) {
 func (et *endpointTranslator) NoEndpoints(exists bool) {
 	et.log.Debugf("NoEndpoints(%+v)", exists)
 
+	et.available = watcher.PodSet{}
+	et.sent = watcher.PodSet{}
+
 	u := &pb.Update{
 		Update: &pb.Update_NoEndpoints{
 			NoEndpoints: &pb.NoEndpoints{
@@ -178,7 +258,7 @@ func (et *endpointTranslator) toWeightedAddr(address watcher.Address) (*pb.Weigh
 
 	return &pb.WeightedAddr{
 		Addr:         tcpAddr,
-		Weight:       defaultWeight,
+		Weight:       et.topology.weight(address),
 		MetricLabels: labels,
 		TlsIdentity:  identity,
 		ProtocolHint: hint,
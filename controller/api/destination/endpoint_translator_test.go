@@ -8,8 +8,9 @@ import (
 	pb "github.com/linkerd/linkerd2-proxy-api/go/destination"
 	"github.com/linkerd/linkerd2-proxy-api/go/net"
 	"github.com/linkerd/linkerd2/controller/api/destination/watcher"
+	"github.com/linkerd/linkerd2/controller/k8s"
 	"github.com/linkerd/linkerd2/pkg/addr"
-	"github.com/linkerd/linkerd2/pkg/k8s"
+	pkgK8s "github.com/linkerd/linkerd2/pkg/k8s"
 	logging "github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -24,11 +25,11 @@ var (
 				Name:      "pod1",
 				Namespace: "ns",
 				Annotations: map[string]string{
-					k8s.IdentityModeAnnotation: k8s.IdentityModeDefault,
+					pkgK8s.IdentityModeAnnotation: pkgK8s.IdentityModeDefault,
 				},
 				Labels: map[string]string{
-					k8s.ControllerNSLabel:    "linkerd",
-					k8s.ProxyDeploymentLabel: "deployment-name",
+					pkgK8s.ControllerNSLabel:    "linkerd",
+					pkgK8s.ProxyDeploymentLabel: "deployment-name",
 				},
 			},
 			Spec: corev1.PodSpec{
@@ -47,11 +48,11 @@ var (
 				Name:      "pod2",
 				Namespace: "ns",
 				Annotations: map[string]string{
-					k8s.IdentityModeAnnotation: "optional",
+					pkgK8s.IdentityModeAnnotation: "optional",
 				},
 				Labels: map[string]string{
-					k8s.ControllerNSLabel:    "linkerd",
-					k8s.ProxyDeploymentLabel: "deployment-name",
+					pkgK8s.ControllerNSLabel:    "linkerd",
+					pkgK8s.ProxyDeploymentLabel: "deployment-name",
 				},
 			},
 		},
@@ -65,11 +66,11 @@ var (
 				Name:      "pod3",
 				Namespace: "ns",
 				Annotations: map[string]string{
-					k8s.IdentityModeAnnotation: k8s.IdentityModeDefault,
+					pkgK8s.IdentityModeAnnotation: pkgK8s.IdentityModeDefault,
 				},
 				Labels: map[string]string{
-					k8s.ControllerNSLabel:    "other-linkerd-namespace",
-					k8s.ProxyDeploymentLabel: "deployment-name",
+					pkgK8s.ControllerNSLabel:    "other-linkerd-namespace",
+					pkgK8s.ProxyDeploymentLabel: "deployment-name",
 				},
 			},
 		},
@@ -83,11 +84,11 @@ var (
 				Name:      "pod4",
 				Namespace: "ns",
 				Annotations: map[string]string{
-					k8s.IdentityModeAnnotation: k8s.IdentityModeDisabled,
+					pkgK8s.IdentityModeAnnotation: pkgK8s.IdentityModeDisabled,
 				},
 				Labels: map[string]string{
-					k8s.ControllerNSLabel:    "linkerd",
-					k8s.ProxyDeploymentLabel: "deployment-name",
+					pkgK8s.ControllerNSLabel:    "linkerd",
+					pkgK8s.ProxyDeploymentLabel: "deployment-name",
 				},
 			},
 		},
@@ -101,6 +102,7 @@ func makeEndpointTranslator(t *testing.T) (*mockDestinationGetServer, *endpointT
 		"trust.domain",
 		false,
 		watcher.ServiceID{Name: "service-name", Namespace: "service-ns"},
+		nil,
 		mockGetServer,
 		logging.WithField("test", t.Name),
 	)
@@ -238,6 +240,124 @@ func TestEndpointTranslator(t *testing.T) {
 	})
 }
 
+func TestEndpointTranslatorTopology(t *testing.T) {
+	k8sAPI, err := k8s.NewFakeAPI(`
+apiVersion: v1
+kind: Node
+metadata:
+  name: node-a
+  labels:
+    topology.kubernetes.io/zone: zone-a`,
+		`
+apiVersion: v1
+kind: Node
+metadata:
+  name: node-b
+  labels:
+    failure-domain.beta.kubernetes.io/zone: zone-b`,
+	)
+	if err != nil {
+		t.Fatalf("NewFakeAPI returned an error: %s", err)
+	}
+	k8sAPI.Sync()
+	nodes := k8sAPI.Node().Lister()
+
+	onNode := func(address watcher.Address, nodeName string) watcher.Address {
+		pod := address.Pod.DeepCopy()
+		pod.Spec.NodeName = nodeName
+		address.Pod = pod
+		return address
+	}
+	localPod := onNode(normalPod, "node-a")
+	remotePod := onNode(tlsOptionalPod, "node-b")
+
+	makeTranslator := func(mode string) (*mockDestinationGetServer, *endpointTranslator) {
+		mockGetServer := &mockDestinationGetServer{updatesReceived: []*pb.Update{}}
+		translator := newEndpointTranslator(
+			"linkerd",
+			"trust.domain",
+			false,
+			watcher.ServiceID{Name: "service-name", Namespace: "service-ns"},
+			newTopology(mode, "zone-a", nodes),
+			mockGetServer,
+			logging.WithField("test", t.Name),
+		)
+		return mockGetServer, translator
+	}
+
+	t.Run("Is disabled for unknown modes and zones", func(t *testing.T) {
+		if newTopology("unknown", "zone-a", nodes) != nil {
+			t.Fatalf("Expected no topology for an unknown mode")
+		}
+		if newTopology(pkgK8s.TopologyModePreferZone, "", nodes) != nil {
+			t.Fatalf("Expected no topology for an unknown zone")
+		}
+	})
+
+	t.Run("Only sends local addresses in prefer-zone mode", func(t *testing.T) {
+		mockGetServer, translator := makeTranslator(pkgK8s.TopologyModePreferZone)
+
+		translator.Add(mkPodSet(localPod, remotePod))
+
+		if len(mockGetServer.updatesReceived) != 1 {
+			t.Fatalf("Expected [1] update, got [%d]: %v", len(mockGetServer.updatesReceived), mockGetServer.updatesReceived)
+		}
+		addrs := mockGetServer.updatesReceived[0].GetAdd().GetAddrs()
+		if len(addrs) != 1 {
+			t.Fatalf("Expected [1] address added, got %v", addrs)
+		}
+		checkAddressAndWeight(t, addrs[0], localPod)
+	})
+
+	t.Run("Falls back to all addresses when the zone has none in prefer-zone mode", func(t *testing.T) {
+		mockGetServer, translator := makeTranslator(pkgK8s.TopologyModePreferZone)
+
+		translator.Add(mkPodSet(localPod, remotePod))
+		translator.Remove(mkPodSet(localPod))
+
+		if len(mockGetServer.updatesReceived) != 3 {
+			t.Fatalf("Expected [3] updates, got [%d]: %v", len(mockGetServer.updatesReceived), mockGetServer.updatesReceived)
+		}
+		added := mockGetServer.updatesReceived[1].GetAdd().GetAddrs()
+		if len(added) != 1 {
+			t.Fatalf("Expected [1] address added, got %v", added)
+		}
+		checkAddressAndWeight(t, added[0], remotePod)
+		removed := mockGetServer.updatesReceived[2].GetRemove().GetAddrs()
+		if len(removed) != 1 {
+			t.Fatalf("Expected [1] address removed, got %v", removed)
+		}
+		checkAddress(t, removed[0], localPod)
+
+		// Once a local address is available again, the remote one is dropped.
+		translator.Add(mkPodSet(localPod))
+
+		if len(mockGetServer.updatesReceived) != 5 {
+			t.Fatalf("Expected [5] updates, got [%d]: %v", len(mockGetServer.updatesReceived), mockGetServer.updatesReceived)
+		}
+		checkAddress(t, mockGetServer.updatesReceived[3].GetAdd().GetAddrs()[0].GetAddr(), localPod)
+		checkAddress(t, mockGetServer.updatesReceived[4].GetRemove().GetAddrs()[0], remotePod)
+	})
+
+	t.Run("Weights local addresses in weighted mode", func(t *testing.T) {
+		mockGetServer, translator := makeTranslator(pkgK8s.TopologyModeWeighted)
+
+		translator.Add(mkPodSet(localPod, remotePod))
+
+		addrs := mockGetServer.updatesReceived[0].GetAdd().GetAddrs()
+		if len(addrs) != 2 {
+			t.Fatalf("Expected [2] addresses added, got %v", addrs)
+		}
+		sort.Slice(addrs, func(i, j int) bool {
+			return addrs[i].GetAddr().Port < addrs[j].GetAddr().Port
+		})
+		if addrs[0].GetWeight() != defaultWeight*zoneLocalWeightFactor {
+			t.Fatalf("Expected weight [%d] for local address, got [%d]", defaultWeight*zoneLocalWeightFactor, addrs[0].GetWeight())
+		}
+		checkAddressAndWeight(t, addrs[1], remotePod)
+	})
+}
+
 func mkPodSet(pods ...watcher.Address) watcher.PodSet {
 	set := make(watcher.PodSet)
 	for _, p := range pods {
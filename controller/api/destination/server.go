@@ -1,6 +1,7 @@
 package destination
 
 import (
+	"encoding/json"
 	"fmt"
 	"net"
 	"strconv"
@@ -9,19 +10,24 @@ import (
 	pb "github.com/linkerd/linkerd2-proxy-api/go/destination"
 	"github.com/linkerd/linkerd2/controller/api/destination/watcher"
 	"github.com/linkerd/linkerd2/controller/k8s"
+	pkgK8s "github.com/linkerd/linkerd2/pkg/k8s"
 	"github.com/linkerd/linkerd2/pkg/prometheus"
 	logging "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
 )
 
 type (
 	server struct {
 		endpoints     *watcher.EndpointsWatcher
+		externalNames *watcher.ExternalNameWatcher
+		ips           *watcher.IPWatcher
 		profiles      *watcher.ProfileWatcher
 		trafficSplits *watcher.TrafficSplitWatcher
+		k8sAPI        *k8s.API
 
 		enableH2Upgrade     bool
 		controllerNS        string
@@ -31,6 +37,16 @@ type (
 		log      *logging.Entry
 		shutdown <-chan struct{}
 	}
+
+	// contextToken is sent by the proxy with each request to identify the
+	// client.  Proxies are injected with a token of the form ns:<namespace>,
+	// which destination controllers of every version understand.  The JSON
+	// form, which can also name the client's node, is accepted so that the
+	// injected token can switch to it once no older controllers remain.
+	contextToken struct {
+		Ns       string `json:"ns,omitempty"`
+		NodeName string `json:"nodeName,omitempty"`
+	}
 )
 
 // NewServer returns a new instance of the destination server.
@@ -44,12 +60,20 @@ type (
 // omitted, "default" is used as a default.append
 //
 // Addresses for the given destination are fetched from the Kubernetes Endpoints
-// API.
+// API, or from the EndpointSlice API if enableEndpointSlices is set.
+// Destinations may also be given as an IP address: a service's cluster IP
+// resolves to that service's endpoints and a pod IP resolves to that pod.
+// ExternalName services resolve to the addresses of their external name.
+//
+// Services annotated with linkerd.io/topology-mode have their endpoints
+// filtered or weighted according to the zone of the client, which is
+// determined from the node named in the request's context token.
 func NewServer(
 	addr string,
 	controllerNS string,
 	identityTrustDomain string,
 	enableH2Upgrade bool,
+	enableEndpointSlices bool,
 	k8sAPI *k8s.API,
 	clusterDomain string,
 	shutdown <-chan struct{},
@@ -58,14 +82,19 @@ func NewServer(
 		"addr":      addr,
 		"component": "server",
 	})
-	endpoints := watcher.NewEndpointsWatcher(k8sAPI, log)
+	endpoints := watcher.NewEndpointsWatcher(k8sAPI, log, enableEndpointSlices)
+	externalNames := watcher.NewExternalNameWatcher(k8sAPI, net.DefaultResolver, log)
+	ips := watcher.NewIPWatcher(k8sAPI, log)
 	profiles := watcher.NewProfileWatcher(k8sAPI, log)
 	trafficSplits := watcher.NewTrafficSplitWatcher(k8sAPI, log)
 
 	srv := server{
 		endpoints,
+		externalNames,
+		ips,
 		profiles,
 		trafficSplits,
+		k8sAPI,
 		enableH2Upgrade,
 		controllerNS,
 		identityTrustDomain,
@@ -96,9 +125,7 @@ func (s *server) Get(dest *pb.GetDestination, stream pb.Destination_GetServer) e
 	}
 
 	if ip := net.ParseIP(host); ip != nil {
-		// TODO handle lookup by IP address
-		log.Debug("Lookup of IP addresses is not yet supported")
-		return status.Errorf(codes.InvalidArgument, "Cannot resolve IP addresses")
+		return s.getByIP(dest, ip.String(), port, stream, log)
 	}
 
 	service, instanceID, err := parseK8sServiceName(host, s.clusterDomain)
@@ -107,11 +134,119 @@ func (s *server) Get(dest *pb.GetDestination, stream pb.Destination_GetServer) e
 		return status.Errorf(codes.InvalidArgument, "Invalid authority: %s", dest.GetPath())
 	}
 
+	return s.getByService(dest, service, port, instanceID, stream, log)
+}
+
+// getByIP streams the addresses for an IP destination.  If the IP is the
+// cluster IP of a service, the service's endpoints are streamed.  Otherwise,
+// the address of the pod which is assigned that IP is streamed.
+func (s *server) getByIP(
+	dest *pb.GetDestination,
+	ip string,
+	port watcher.Port,
+	stream pb.Destination_GetServer,
+	log *logging.Entry,
+) error {
+	service, ok, err := s.serviceByIP(ip)
+	if err != nil {
+		log.Errorf("Failed to look up service for IP %s: %s", ip, err)
+		return status.Errorf(codes.Internal, "Failed to look up IP %s", ip)
+	}
+	if ok {
+		log.Debugf("Resolved IP %s to service %s", ip, service)
+		return s.getByService(dest, service, port, "", stream, log)
+	}
+
+	translator := newEndpointTranslator(
+		s.controllerNS,
+		s.identityTrustDomain,
+		s.enableH2Upgrade,
+		watcher.ServiceID{},
+		nil,
+		stream,
+		log,
+	)
+
+	s.ips.Subscribe(ip, port, translator)
+	defer s.ips.Unsubscribe(ip, port, translator)
+
+	select {
+	case <-s.shutdown:
+	case <-stream.Context().Done():
+		log.Debugf("Get %s cancelled", dest.GetPath())
+	}
+
+	return nil
+}
+
+// serviceByIP returns the ID of the service whose cluster IP is the given IP,
+// if there is exactly one such service.
+func (s *server) serviceByIP(ip string) (watcher.ServiceID, bool, error) {
+	services, err := s.k8sAPI.GetServicesByIP(ip)
+	if err != nil {
+		return watcher.ServiceID{}, false, err
+	}
+	if len(services) != 1 {
+		if len(services) > 1 {
+			s.log.Warnf("Could not uniquely identify service at %s (found %d services)", ip, len(services))
+		}
+		return watcher.ServiceID{}, false, nil
+	}
+	return watcher.ServiceID{
+		Namespace: services[0].Namespace,
+		Name:      services[0].Name,
+	}, true, nil
+}
+
+// serviceByExternalName returns the ID of the ExternalName service which
+// aliases the given host.  If several services alias the host, the one in the
+// client's namespace is preferred.
+func (s *server) serviceByExternalName(host, clientNs string) (watcher.ServiceID, bool, error) {
+	services, err := s.k8sAPI.GetServicesByExternalName(host)
+	if err != nil {
+		return watcher.ServiceID{}, false, err
+	}
+	if len(services) > 1 {
+		local := []*corev1.Service{}
+		for _, svc := range services {
+			if svc.Namespace == clientNs {
+				local = append(local, svc)
+			}
+		}
+		services = local
+	}
+	if len(services) != 1 {
+		return watcher.ServiceID{}, false, nil
+	}
+	return watcher.ServiceID{
+		Namespace: services[0].Namespace,
+		Name:      services[0].Name,
+	}, true, nil
+}
+
+func (s *server) getByService(
+	dest *pb.GetDestination,
+	service watcher.ServiceID,
+	port watcher.Port,
+	instanceID instanceID,
+	stream pb.Destination_GetServer,
+	log *logging.Entry,
+) error {
+	svc, err := s.k8sAPI.Svc().Lister().Services(service.Namespace).Get(service.Name)
+	if err == nil && watcher.IsExternalName(svc) {
+		if instanceID != "" {
+			log.Debugf("Invalid service %s", dest.GetPath())
+			return status.Errorf(codes.InvalidArgument, "Invalid authority: %s", dest.GetPath())
+		}
+		return s.getByExternalName(dest, service, port, stream, log)
+	}
+
 	translator := newEndpointTranslator(
 		s.controllerNS,
 		s.identityTrustDomain,
 		s.enableH2Upgrade,
 		service,
+		s.topologyFor(service, dest.GetContextToken(), stream, log),
 		stream,
 		log,
 	)
@@ -136,6 +271,87 @@ func (s *server) Get(dest *pb.GetDestination, stream pb.Destination_GetServer) e
 	return nil
 }
 
+// getByExternalName streams the addresses which the external name of an
+// ExternalName service resolves to.
+func (s *server) getByExternalName(
+	dest *pb.GetDestination,
+	service watcher.ServiceID,
+	port watcher.Port,
+	stream pb.Destination_GetServer,
+	log *logging.Entry,
+) error {
+	translator := newEndpointTranslator(
+		s.controllerNS,
+		s.identityTrustDomain,
+		s.enableH2Upgrade,
+		service,
+		nil,
+		stream,
+		log,
+	)
+
+	s.externalNames.Subscribe(service, port, translator)
+	defer s.externalNames.Unsubscribe(service, port, translator)
+
+	select {
+	case <-s.shutdown:
+	case <-stream.Context().Done():
+		log.Debugf("Get %s cancelled", dest.GetPath())
+	}
+
+	return nil
+}
+
+// topologyFor returns the topology to apply to the endpoints of the given
+// service for the requesting client, or nil if no topology applies.
+func (s *server) topologyFor(
+	service watcher.ServiceID,
+	token string,
+	stream pb.Destination_GetServer,
+	log *logging.Entry,
+) *topology {
+	svc, err := s.k8sAPI.Svc().Lister().Services(service.Namespace).Get(service.Name)
+	if err != nil {
+		return nil
+	}
+	mode, ok := svc.Annotations[pkgK8s.TopologyModeAnnotation]
+	if !ok {
+		return nil
+	}
+
+	nodes := s.k8sAPI.Node().Lister()
+	zone := nodeZone(nodes, s.clientNodeName(token, stream))
+	t := newTopology(mode, zone, nodes)
+	if t == nil {
+		log.Debugf("Ignoring %s=%s for client in zone %q", pkgK8s.TopologyModeAnnotation, mode, zone)
+	}
+	return t
+}
+
+// clientNodeName returns the name of the node the client is running on.  The
+// node is taken from the context token if present.  Otherwise it is looked up
+// from the pod which is assigned the client's IP, which fails for clients in
+// the host network as several pods share their IP.
+func (s *server) clientNodeName(token string, stream pb.Destination_GetServer) string {
+	if nodeName := parseContextToken(token).NodeName; nodeName != "" {
+		return nodeName
+	}
+
+	client, ok := peer.FromContext(stream.Context())
+	if !ok || client == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(client.Addr.String())
+	if err != nil {
+		return ""
+	}
+	pods, err := s.k8sAPI.GetPodsByIP(host)
+	if err != nil || len(pods) != 1 {
+		return ""
+	}
+	return pods[0].Spec.NodeName
+}
+
 func (s *server) GetProfile(dest *pb.GetDestination, stream pb.Destination_GetProfileServer) error {
 	log := s.log
 	client, _ := peer.FromContext(stream.Context())
@@ -156,16 +372,41 @@ func (s *server) GetProfile(dest *pb.GetDestination, stream pb.Destination_GetPr
 		return status.Errorf(codes.InvalidArgument, "invalid authority: %s", err)
 	}
 
+	var service watcher.ServiceID
 	if ip := net.ParseIP(host); ip != nil {
-		// TODO handle lookup by IP address
-		log.Debug("Lookup of IP addresses is not supported for profiles")
-		return status.Errorf(codes.InvalidArgument, "cannot discover profiles for IP addresses")
-	}
-
-	service, _, err := parseK8sServiceName(host, s.clusterDomain)
-	if err != nil {
-		log.Debugf("Invalid service %s", dest.GetPath())
-		return status.Errorf(codes.InvalidArgument, "invalid service: %s", err)
+		// Profiles are only attached to services, so an IP address can only
+		// be given a profile if it is the cluster IP of a service.
+		var ok bool
+		service, ok, err = s.serviceByIP(ip.String())
+		if err != nil {
+			log.Errorf("Failed to look up service for IP %s: %s", ip, err)
+			return status.Errorf(codes.Internal, "failed to look up IP %s", ip)
+		}
+		if !ok {
+			log.Debugf("No service found for IP %s", ip)
+			return status.Errorf(codes.InvalidArgument, "no service found for IP address %s", ip)
+		}
+		log.Debugf("Resolved IP %s to service %s", ip, service)
+	} else {
+		var parseErr error
+		service, _, parseErr = parseK8sServiceName(host, s.clusterDomain)
+		if parseErr != nil {
+			// A host outside of the cluster domain may be the external name
+			// of an ExternalName service, in which case that service's
+			// profile is served.
+			var ok bool
+			clientNs := parseContextToken(dest.GetContextToken()).Ns
+			service, ok, err = s.serviceByExternalName(host, clientNs)
+			if err != nil {
+				log.Errorf("Failed to look up service for external name %s: %s", host, err)
+				return status.Errorf(codes.Internal, "failed to look up external name %s", host)
+			}
+			if !ok {
+				log.Debugf("Invalid service %s", dest.GetPath())
+				return status.Errorf(codes.InvalidArgument, "invalid service: %s", parseErr)
+			}
+			log.Debugf("Resolved external name %s to service %s", host, service)
+		}
 	}
 
 	// The adaptor merges profile updates with traffic split updates and
@@ -190,12 +431,7 @@ func (s *server) GetProfile(dest *pb.GetDestination, stream pb.Destination_GetPr
 	// up to the fallbackProfileListener to merge updates from the primary and
 	// secondary listeners and send the appropriate updates to the stream.
 	if dest.GetContextToken() != "" {
-		profile, err := profileID(dest.GetPath(), dest.GetContextToken(), s.clusterDomain)
-		if err != nil {
-			log.Debugf("Invalid service %s", dest.GetPath())
-			return status.Errorf(codes.InvalidArgument, "invalid profile ID: %s", err)
-		}
-
+		profile := profileID(service, dest.GetContextToken(), s.clusterDomain)
 		err = s.profiles.Subscribe(profile, primary)
 		if err != nil {
 			log.Warnf("Failed to subscribe to profile %s: %s", dest.GetPath(), err)
@@ -204,11 +440,7 @@ func (s *server) GetProfile(dest *pb.GetDestination, stream pb.Destination_GetPr
 		defer s.profiles.Unsubscribe(profile, primary)
 	}
 
-	profile, err := profileID(dest.GetPath(), "", s.clusterDomain)
-	if err != nil {
-		log.Debugf("Invalid service %s", dest.GetPath())
-		return status.Errorf(codes.InvalidArgument, "invalid profile ID: %s", err)
-	}
+	profile := profileID(service, "", s.clusterDomain)
 	err = s.profiles.Subscribe(profile, secondary)
 	if err != nil {
 		log.Warnf("Failed to subscribe to profile %s: %s", dest.GetPath(), err)
@@ -229,33 +461,36 @@ func (s *server) GetProfile(dest *pb.GetDestination, stream pb.Destination_GetPr
 /// util ///
 ////////////
 
-func nsFromToken(token string) string {
+func parseContextToken(token string) contextToken {
+	ctx := contextToken{}
+	if token == "" {
+		return ctx
+	}
+	if err := json.Unmarshal([]byte(token), &ctx); err == nil {
+		return ctx
+	}
+
 	// ns:<namespace>
 	parts := strings.Split(token, ":")
 	if len(parts) == 2 && parts[0] == "ns" {
-		return parts[1]
+		ctx.Ns = parts[1]
 	}
 
-	return ""
+	return ctx
 }
 
-func profileID(authority string, contextToken string, clusterDomain string) (watcher.ProfileID, error) {
-	host, _, err := getHostAndPort(authority)
-	if err != nil {
-		return watcher.ProfileID{}, fmt.Errorf("invalid authority: %s", err)
-	}
-	service, _, err := parseK8sServiceName(host, clusterDomain)
-	if err != nil {
-		return watcher.ProfileID{}, fmt.Errorf("invalid k8s service name: %s", err)
-	}
+// profileID returns the ID of the ServiceProfile for the given service.  If
+// the context token names the client's namespace, the profile is looked up in
+// that namespace.
+func profileID(service watcher.ServiceID, contextToken string, clusterDomain string) watcher.ProfileID {
 	id := watcher.ProfileID{
 		Name:      fmt.Sprintf("%s.%s.svc.%s", service.Name, service.Namespace, clusterDomain),
 		Namespace: service.Namespace,
 	}
-	if contextNs := nsFromToken(contextToken); contextNs != "" {
+	if contextNs := parseContextToken(contextToken).Ns; contextNs != "" {
 		id.Namespace = contextNs
 	}
-	return id, nil
+	return id
 }
 
 func getHostAndPort(authority string) (string, watcher.Port, error) {
@@ -1,6 +1,10 @@
 package destination
 
 import (
+	"context"
+	"net"
+	"reflect"
+	"sort"
 	"testing"
 
 	pb "github.com/linkerd/linkerd2-proxy-api/go/destination"
@@ -9,6 +13,7 @@ import (
 	"github.com/linkerd/linkerd2/controller/k8s"
 	"github.com/linkerd/linkerd2/pkg/addr"
 	logging "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/peer"
 )
 
 type mockDestinationGetServer struct {
@@ -40,6 +45,7 @@ metadata:
   namespace: ns
 spec:
   type: LoadBalancer
+  clusterIP: 172.17.12.0
   ports:
   - port: 8989`,
 		`
@@ -66,9 +72,99 @@ metadata:
   ownerReferences:
   - kind: ReplicaSet
     name: rs-1
-  status:
-    phase: Running
-    podIP: 172.17.0.12`,
+status:
+  phase: Running
+  podIP: 172.17.0.12`,
+		`
+apiVersion: v1
+kind: Node
+metadata:
+  name: node-a
+  labels:
+    topology.kubernetes.io/zone: zone-a`,
+		`
+apiVersion: v1
+kind: Node
+metadata:
+  name: node-b
+  labels:
+    topology.kubernetes.io/zone: zone-b`,
+		`
+apiVersion: v1
+kind: Service
+metadata:
+  name: name2
+  namespace: ns
+  annotations:
+    linkerd.io/topology-mode: prefer-zone
+spec:
+  type: ClusterIP
+  clusterIP: 172.17.12.1
+  ports:
+  - port: 8989`,
+		`
+apiVersion: v1
+kind: Endpoints
+metadata:
+  name: name2
+  namespace: ns
+subsets:
+- addresses:
+  - ip: 172.17.0.13
+    targetRef:
+      kind: Pod
+      name: name2-1
+      namespace: ns
+  - ip: 172.17.0.14
+    targetRef:
+      kind: Pod
+      name: name2-2
+      namespace: ns
+  ports:
+  - port: 8989`,
+		`
+apiVersion: v1
+kind: Pod
+metadata:
+  name: name2-1
+  namespace: ns
+spec:
+  nodeName: node-a
+status:
+  phase: Running
+  podIP: 172.17.0.13`,
+		`
+apiVersion: v1
+kind: Pod
+metadata:
+  name: name2-2
+  namespace: ns
+spec:
+  nodeName: node-b
+status:
+  phase: Running
+  podIP: 172.17.0.14`,
+		`
+apiVersion: v1
+kind: Service
+metadata:
+  name: name3
+  namespace: ns
+spec:
+  type: ExternalName
+  externalName: api.example.com`,
+		`
+apiVersion: linkerd.io/v1alpha2
+kind: ServiceProfile
+metadata:
+  name: name3.ns.svc.mycluster.local
+  namespace: ns
+spec:
+  routes:
+  - name: route3
+    isRetryable: false
+    condition:
+      pathRegex: "/api"`,
 		`
 apiVersion: linkerd.io/v1alpha2
 kind: ServiceProfile
@@ -101,14 +197,21 @@ spec:
 
 	k8sAPI.Sync()
 
-	endpoints := watcher.NewEndpointsWatcher(k8sAPI, log)
+	endpoints := watcher.NewEndpointsWatcher(k8sAPI, log, false)
+	externalNames := watcher.NewExternalNameWatcher(k8sAPI, watcher.MockResolver{
+		"api.example.com": {"192.0.2.1"},
+	}, log)
+	ips := watcher.NewIPWatcher(k8sAPI, log)
 	profiles := watcher.NewProfileWatcher(k8sAPI, log)
 	trafficSplits := watcher.NewTrafficSplitWatcher(k8sAPI, log)
 
 	return &server{
 		endpoints,
+		externalNames,
+		ips,
 		profiles,
 		trafficSplits,
+		k8sAPI,
 		false,
 		"linkerd",
 		"trust.domain",
@@ -128,6 +231,17 @@ func (bgs *bufferingGetStream) Send(update *pb.Update) error {
 	return nil
 }
 
+// peerGetStream is a bufferingGetStream whose context holds the client's
+// address.
+type peerGetStream struct {
+	*bufferingGetStream
+	ctx context.Context
+}
+
+func (pgs *peerGetStream) Context() context.Context {
+	return pgs.ctx
+}
+
 type bufferingGetProfileStream struct {
 	updates []*pb.DestinationProfile
 	util.MockServerStream
@@ -182,6 +296,205 @@ func TestGet(t *testing.T) {
 		}
 
 	})
+
+	t.Run("Returns endpoints for a service cluster IP", func(t *testing.T) {
+		server := makeServer(t)
+
+		stream := &bufferingGetStream{
+			updates:          []*pb.Update{},
+			MockServerStream: util.NewMockServerStream(),
+		}
+
+		stream.Cancel() // See note above on pre-emptive cancellation.
+		err := server.Get(&pb.GetDestination{Scheme: "k8s", Path: "172.17.12.0:8989"}, stream)
+		if err != nil {
+			t.Fatalf("Got error: %s", err)
+		}
+
+		if len(stream.updates) != 1 {
+			t.Fatalf("Expected 1 update but got %d: %v", len(stream.updates), stream.updates)
+		}
+
+		if updateAddAddress(t, stream.updates[0])[0] != "172.17.0.12:8989" {
+			t.Fatalf("Expected 172.17.0.12:8989 but got %s", updateAddAddress(t, stream.updates[0])[0])
+		}
+
+		labels := stream.updates[0].GetAdd().GetMetricLabels()
+		if labels["service"] != "name1" || labels["namespace"] != "ns" {
+			t.Fatalf("Expected metric labels for service ns/name1 but got %v", labels)
+		}
+	})
+
+	t.Run("Returns the pod for a pod IP", func(t *testing.T) {
+		server := makeServer(t)
+
+		stream := &bufferingGetStream{
+			updates:          []*pb.Update{},
+			MockServerStream: util.NewMockServerStream(),
+		}
+
+		stream.Cancel() // See note above on pre-emptive cancellation.
+		err := server.Get(&pb.GetDestination{Scheme: "k8s", Path: "172.17.0.12:1234"}, stream)
+		if err != nil {
+			t.Fatalf("Got error: %s", err)
+		}
+
+		if len(stream.updates) != 1 {
+			t.Fatalf("Expected 1 update but got %d: %v", len(stream.updates), stream.updates)
+		}
+
+		if updateAddAddress(t, stream.updates[0])[0] != "172.17.0.12:1234" {
+			t.Fatalf("Expected 172.17.0.12:1234 but got %s", updateAddAddress(t, stream.updates[0])[0])
+		}
+
+		addrLabels := stream.updates[0].GetAdd().GetAddrs()[0].GetMetricLabels()
+		if addrLabels["pod"] != "name1-1" {
+			t.Fatalf("Expected pod label name1-1 but got %v", addrLabels)
+		}
+	})
+
+	t.Run("Returns the resolved addresses for an ExternalName service", func(t *testing.T) {
+		server := makeServer(t)
+
+		stream := &bufferingGetStream{
+			updates:          []*pb.Update{},
+			MockServerStream: util.NewMockServerStream(),
+		}
+
+		stream.Cancel() // See note above on pre-emptive cancellation.
+		err := server.Get(&pb.GetDestination{Scheme: "k8s", Path: "name3.ns.svc.mycluster.local:443"}, stream)
+		if err != nil {
+			t.Fatalf("Got error: %s", err)
+		}
+
+		if len(stream.updates) != 1 {
+			t.Fatalf("Expected 1 update but got %d: %v", len(stream.updates), stream.updates)
+		}
+
+		if updateAddAddress(t, stream.updates[0])[0] != "192.0.2.1:443" {
+			t.Fatalf("Expected 192.0.2.1:443 but got %s", updateAddAddress(t, stream.updates[0])[0])
+		}
+	})
+
+	t.Run("Returns error for an instance of an ExternalName service", func(t *testing.T) {
+		server := makeServer(t)
+
+		stream := &bufferingGetStream{
+			updates:          []*pb.Update{},
+			MockServerStream: util.NewMockServerStream(),
+		}
+
+		err := server.Get(&pb.GetDestination{Scheme: "k8s", Path: "pod-0.name3.ns.svc.mycluster.local:443"}, stream)
+		if err == nil {
+			t.Fatalf("Expecting error, got nothing")
+		}
+	})
+
+	t.Run("Returns no endpoints for an unknown IP", func(t *testing.T) {
+		server := makeServer(t)
+
+		stream := &bufferingGetStream{
+			updates:          []*pb.Update{},
+			MockServerStream: util.NewMockServerStream(),
+		}
+
+		stream.Cancel() // See note above on pre-emptive cancellation.
+		err := server.Get(&pb.GetDestination{Scheme: "k8s", Path: "10.0.0.1:1234"}, stream)
+		if err != nil {
+			t.Fatalf("Got error: %s", err)
+		}
+
+		if len(stream.updates) != 1 {
+			t.Fatalf("Expected 1 update but got %d: %v", len(stream.updates), stream.updates)
+		}
+
+		if stream.updates[0].GetNoEndpoints() == nil || stream.updates[0].GetNoEndpoints().GetExists() {
+			t.Fatalf("Expected NoEndpoints(false) but got %v", stream.updates[0])
+		}
+	})
+}
+
+func TestGetWithTopology(t *testing.T) {
+	for _, tt := range []struct {
+		description       string
+		contextToken      string
+		clientIP          string
+		expectedAddresses []string
+	}{
+		{
+			description:       "Returns endpoints in the client's zone",
+			contextToken:      `{"ns":"client-ns", "nodeName":"node-b"}`,
+			expectedAddresses: []string{"172.17.0.14:8989"},
+		},
+		{
+			description:       "Returns all endpoints when the client's zone is unknown",
+			contextToken:      `{"ns":"client-ns", "nodeName":"node-c"}`,
+			expectedAddresses: []string{"172.17.0.13:8989", "172.17.0.14:8989"},
+		},
+		{
+			description:       "Returns all endpoints for a legacy context token",
+			contextToken:      "ns:client-ns",
+			expectedAddresses: []string{"172.17.0.13:8989", "172.17.0.14:8989"},
+		},
+		{
+			description:       "Returns endpoints in the zone of the client's pod for a legacy context token",
+			contextToken:      "ns:client-ns",
+			clientIP:          "172.17.0.14",
+			expectedAddresses: []string{"172.17.0.14:8989"},
+		},
+	} {
+		tt := tt // pin
+		t.Run(tt.description, func(t *testing.T) {
+			server := makeServer(t)
+
+			stream := &bufferingGetStream{
+				updates:          []*pb.Update{},
+				MockServerStream: util.NewMockServerStream(),
+			}
+
+			var getStream pb.Destination_GetServer = stream
+			if tt.clientIP != "" {
+				client := &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(tt.clientIP), Port: 4143}}
+				getStream = &peerGetStream{stream, peer.NewContext(stream.Context(), client)}
+			}
+
+			stream.Cancel() // See note in TestGet on pre-emptive cancellation.
+			err := server.Get(&pb.GetDestination{
+				Scheme:       "k8s",
+				Path:         "name2.ns.svc.mycluster.local:8989",
+				ContextToken: tt.contextToken,
+			}, getStream)
+			if err != nil {
+				t.Fatalf("Got error: %s", err)
+			}
+
+			if len(stream.updates) != 1 {
+				t.Fatalf("Expected 1 update but got %d: %v", len(stream.updates), stream.updates)
+			}
+
+			addresses := updateAddAddress(t, stream.updates[0])
+			sort.Strings(addresses)
+			if !reflect.DeepEqual(addresses, tt.expectedAddresses) {
+				t.Fatalf("Expected %v but got %v", tt.expectedAddresses, addresses)
+			}
+		})
+	}
+}
+
+func TestParseContextToken(t *testing.T) {
+	for _, tt := range []struct {
+		token    string
+		expected contextToken
+	}{
+		{"", contextToken{}},
+		{"ns:client-ns", contextToken{Ns: "client-ns"}},
+		{`{"ns":"client-ns", "nodeName":"node-a"}` + "\n", contextToken{Ns: "client-ns", NodeName: "node-a"}},
+		{"invalid", contextToken{}},
+	} {
+		if actual := parseContextToken(tt.token); actual != tt.expected {
+			t.Fatalf("Expected %+v for token %q but got %+v", tt.expected, tt.token, actual)
+		}
+	}
 }
 
 func TestGetProfiles(t *testing.T) {
@@ -276,6 +589,100 @@ func TestGetProfiles(t *testing.T) {
 	})
 }
 
+func TestGetProfilesByIP(t *testing.T) {
+	t.Run("Returns client profile for a service cluster IP", func(t *testing.T) {
+		server := makeServer(t)
+
+		stream := &bufferingGetProfileStream{
+			updates:          []*pb.DestinationProfile{},
+			MockServerStream: util.NewMockServerStream(),
+		}
+
+		stream.Cancel() // See note above on pre-emptive cancellation.
+		err := server.GetProfile(&pb.GetDestination{
+			Scheme:       "k8s",
+			Path:         "172.17.12.0:8989",
+			ContextToken: `{"ns":"client-ns", "nodeName":"node-a"}`,
+		}, stream)
+		if err != nil {
+			t.Fatalf("Got error: %s", err)
+		}
+
+		// See note in TestGetProfiles on the number of updates.
+		if len(stream.updates) != 1 && len(stream.updates) != 2 {
+			t.Fatalf("Expected 1 or 2 updates but got %d: %v", len(stream.updates), stream.updates)
+		}
+		routes := stream.updates[len(stream.updates)-1].GetRoutes()
+		if len(routes) != 1 {
+			t.Fatalf("Expected 1 route but got %d: %v", len(routes), routes)
+		}
+		if !routes[0].GetIsRetryable() {
+			t.Fatalf("Expected route to be retryable, but it was not")
+		}
+	})
+
+	t.Run("Returns error for a pod IP", func(t *testing.T) {
+		server := makeServer(t)
+
+		stream := &bufferingGetProfileStream{
+			updates:          []*pb.DestinationProfile{},
+			MockServerStream: util.NewMockServerStream(),
+		}
+
+		err := server.GetProfile(&pb.GetDestination{Scheme: "k8s", Path: "172.17.0.12:8989"}, stream)
+		if err == nil {
+			t.Fatalf("Expecting error, got nothing")
+		}
+	})
+}
+
+func TestGetProfilesByExternalName(t *testing.T) {
+	t.Run("Returns the profile of an ExternalName service", func(t *testing.T) {
+		server := makeServer(t)
+
+		stream := &bufferingGetProfileStream{
+			updates:          []*pb.DestinationProfile{},
+			MockServerStream: util.NewMockServerStream(),
+		}
+
+		stream.Cancel() // See note in TestGet on pre-emptive cancellation.
+		err := server.GetProfile(&pb.GetDestination{
+			Scheme:       "k8s",
+			Path:         "api.example.com:443",
+			ContextToken: `{"ns":"client-ns", "nodeName":"node-a"}`,
+		}, stream)
+		if err != nil {
+			t.Fatalf("Got error: %s", err)
+		}
+
+		// See note in TestGetProfiles on the number of updates.
+		if len(stream.updates) != 1 && len(stream.updates) != 2 {
+			t.Fatalf("Expected 1 or 2 updates but got %d: %v", len(stream.updates), stream.updates)
+		}
+		routes := stream.updates[len(stream.updates)-1].GetRoutes()
+		if len(routes) != 1 {
+			t.Fatalf("Expected 1 route but got %d: %v", len(routes), routes)
+		}
+		if routes[0].GetIsRetryable() {
+			t.Fatalf("Expected route to not be retryable, but it was")
+		}
+	})
+
+	t.Run("Returns error for an unknown external name", func(t *testing.T) {
+		server := makeServer(t)
+
+		stream := &bufferingGetProfileStream{
+			updates:          []*pb.DestinationProfile{},
+			MockServerStream: util.NewMockServerStream(),
+		}
+
+		err := server.GetProfile(&pb.GetDestination{Scheme: "k8s", Path: "web.example.com:443"}, stream)
+		if err == nil {
+			t.Fatalf("Expecting error, got nothing")
+		}
+	})
+}
+
 func updateAddAddress(t *testing.T, update *pb.Update) []string {
 	add, ok := update.GetUpdate().(*pb.Update_Add)
 	if !ok {
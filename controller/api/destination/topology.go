@@ -0,0 +1,84 @@
+package destination
+
+import (
+	"github.com/linkerd/linkerd2/controller/api/destination/watcher"
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	corelisters "k8s.io/client-go/listers/core/v1"
+)
+
+// zoneLocalWeightFactor is the factor by which the weight of endpoints in the
+// client's zone is multiplied in the weighted topology mode.
+const zoneLocalWeightFactor uint32 = 10
+
+// topology describes how the endpoints of a service are filtered or weighted
+// according to the zone of the client they are served to.
+type topology struct {
+	mode  string
+	zone  string
+	nodes corelisters.NodeLister
+}
+
+// newTopology returns the topology to apply to a service's endpoints for a
+// client in the given zone.  If the mode is not a supported topology mode or
+// the client's zone is unknown, nil is returned and no topology is applied.
+func newTopology(mode, zone string, nodes corelisters.NodeLister) *topology {
+	if mode != k8s.TopologyModePreferZone && mode != k8s.TopologyModeWeighted {
+		return nil
+	}
+	if zone == "" {
+		return nil
+	}
+	return &topology{mode, zone, nodes}
+}
+
+// isLocal returns true if the address belongs to a pod scheduled on a node in
+// the client's zone.  Addresses without a pod are never considered local.
+func (t *topology) isLocal(address watcher.Address) bool {
+	if address.Pod == nil {
+		return false
+	}
+	return nodeZone(t.nodes, address.Pod.Spec.NodeName) == t.zone
+}
+
+// weight returns the weight of the given address.
+func (t *topology) weight(address watcher.Address) uint32 {
+	if t != nil && t.mode == k8s.TopologyModeWeighted && t.isLocal(address) {
+		return defaultWeight * zoneLocalWeightFactor
+	}
+	return defaultWeight
+}
+
+// filter returns the subset of the given addresses which should be sent to
+// the client.  In the prefer-zone mode, these are the addresses in the
+// client's zone, or all addresses if there are none in the client's zone.
+func (t *topology) filter(set watcher.PodSet) watcher.PodSet {
+	if t == nil || t.mode != k8s.TopologyModePreferZone {
+		return set
+	}
+	local := watcher.PodSet{}
+	for id, address := range set {
+		if t.isLocal(address) {
+			local[id] = address
+		}
+	}
+	if len(local) == 0 {
+		return set
+	}
+	return local
+}
+
+// nodeZone returns the zone of the given node, or an empty string if the node
+// is unknown or isn't labeled with a zone.
+func nodeZone(nodes corelisters.NodeLister, nodeName string) string {
+	if nodeName == "" {
+		return ""
+	}
+	node, err := nodes.Get(nodeName)
+	if err != nil {
+		return ""
+	}
+	if zone, ok := node.Labels[k8s.ZoneLabel]; ok {
+		return zone
+	}
+	return node.Labels[k8s.LegacyZoneLabel]
+}
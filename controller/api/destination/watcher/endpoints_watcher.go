@@ -15,10 +15,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 )
 
-const (
-	kubeSystem = "kube-system"
-	podIPIndex = "ip"
-)
+const kubeSystem = "kube-system"
 
 // TODO: prom metrics for all the queues/caches
 // https://github.com/linkerd/linkerd2/issues/2204
@@ -49,6 +46,10 @@ type (
 		publishers map[ServiceID]*servicePublisher
 		k8sAPI     *k8s.API
 
+		// enableEndpointSlices selects EndpointSlices rather than Endpoints as
+		// the source of addresses.
+		enableEndpointSlices bool
+
 		log          *logging.Entry
 		sync.RWMutex // This mutex protects modification of the map itself.
 	}
@@ -63,9 +64,10 @@ type (
 	// requested, the address set will be filtered to only include addresses
 	// with the requested hostname.
 	servicePublisher struct {
-		id     ServiceID
-		log    *logging.Entry
-		k8sAPI *k8s.API
+		id                   ServiceID
+		log                  *logging.Entry
+		k8sAPI               *k8s.API
+		enableEndpointSlices bool
 
 		ports map[portAndHostname]*portPublisher
 		// All access to the servicePublisher and its portPublishers is explicitly synchronized by
@@ -77,16 +79,21 @@ type (
 	// hostname.  Multiple listeners may be subscribed to a portPublisher.
 	// portPublisher maintains the current state of the address set and
 	// publishes diffs to all listeners when updates come from either the
-	// endpoints API or the service API.
+	// endpoints API, the endpoint slice API or the service API.
 	portPublisher struct {
-		id         ServiceID
-		targetPort namedPort
-		hostname   string
-		log        *logging.Entry
-		k8sAPI     *k8s.API
-
-		exists    bool
-		pods      PodSet
+		id                   ServiceID
+		targetPort           namedPort
+		hostname             string
+		log                  *logging.Entry
+		k8sAPI               *k8s.API
+		enableEndpointSlices bool
+
+		exists bool
+		pods   PodSet
+		// slices holds the addresses contributed by each of the service's
+		// endpoint slices, keyed by slice name.  It is only used when
+		// enableEndpointSlices is set; pods is then the union of all slices.
+		slices    map[string]PodSet
 		listeners []EndpointUpdateListener
 		metrics   endpointsMetrics
 	}
@@ -102,34 +109,37 @@ type (
 var endpointsVecs = newEndpointsMetricsVecs()
 
 // NewEndpointsWatcher creates an EndpointsWatcher and begins watching the
-// k8sAPI for pod, service, and endpoint changes.
-func NewEndpointsWatcher(k8sAPI *k8s.API, log *logging.Entry) *EndpointsWatcher {
+// k8sAPI for pod, service, and endpoint changes.  If enableEndpointSlices is
+// set, endpoint slices are watched instead of endpoints.
+func NewEndpointsWatcher(k8sAPI *k8s.API, log *logging.Entry, enableEndpointSlices bool) *EndpointsWatcher {
 	ew := &EndpointsWatcher{
-		publishers: make(map[ServiceID]*servicePublisher),
-		k8sAPI:     k8sAPI,
+		publishers:           make(map[ServiceID]*servicePublisher),
+		k8sAPI:               k8sAPI,
+		enableEndpointSlices: enableEndpointSlices,
 		log: log.WithFields(logging.Fields{
 			"component": "endpoints-watcher",
 		}),
 	}
 
-	k8sAPI.Pod().Informer().AddIndexers(cache.Indexers{podIPIndex: func(obj interface{}) ([]string, error) {
-		if pod, ok := obj.(*corev1.Pod); ok {
-			return []string{pod.Status.PodIP}, nil
-		}
-		return []string{""}, fmt.Errorf("object is not a pod")
-	}})
-
 	k8sAPI.Svc().Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc:    ew.addService,
 		DeleteFunc: ew.deleteService,
 		UpdateFunc: func(_, obj interface{}) { ew.addService(obj) },
 	})
 
-	k8sAPI.Endpoint().Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
-		AddFunc:    ew.addEndpoints,
-		DeleteFunc: ew.deleteEndpoints,
-		UpdateFunc: func(_, obj interface{}) { ew.addEndpoints(obj) },
-	})
+	if enableEndpointSlices {
+		k8sAPI.ES().Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc:    ew.addEndpointSlice,
+			DeleteFunc: ew.deleteEndpointSlice,
+			UpdateFunc: func(_, obj interface{}) { ew.addEndpointSlice(obj) },
+		})
+	} else {
+		k8sAPI.Endpoint().Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc:    ew.addEndpoints,
+			DeleteFunc: ew.deleteEndpoints,
+			UpdateFunc: func(_, obj interface{}) { ew.addEndpoints(obj) },
+		})
+	}
 
 	return ew
 }
@@ -237,6 +247,45 @@ func (ew *EndpointsWatcher) deleteEndpoints(obj interface{}) {
 	}
 }
 
+func (ew *EndpointsWatcher) addEndpointSlice(obj interface{}) {
+	slice, err := k8s.ToEndpointSlice(obj)
+	if err != nil {
+		ew.log.Errorf("Failed to add endpoint slice: %s", err)
+		return
+	}
+	if slice.Namespace == kubeSystem || slice.ServiceName() == "" {
+		return
+	}
+	id := ServiceID{
+		Namespace: slice.Namespace,
+		Name:      slice.ServiceName(),
+	}
+
+	sp := ew.getOrNewServicePublisher(id)
+
+	sp.updateEndpointSlice(slice)
+}
+
+func (ew *EndpointsWatcher) deleteEndpointSlice(obj interface{}) {
+	slice, err := k8s.ToEndpointSlice(obj)
+	if err != nil {
+		ew.log.Errorf("Failed to delete endpoint slice: %s", err)
+		return
+	}
+	if slice.Namespace == kubeSystem || slice.ServiceName() == "" {
+		return
+	}
+	id := ServiceID{
+		Namespace: slice.Namespace,
+		Name:      slice.ServiceName(),
+	}
+
+	sp, ok := ew.getServicePublisher(id)
+	if ok {
+		sp.deleteEndpointSlice(slice)
+	}
+}
+
 // Returns the servicePublisher for the given id if it exists.  Otherwise,
 // create a new one and return it.
 func (ew *EndpointsWatcher) getOrNewServicePublisher(id ServiceID) *servicePublisher {
@@ -254,8 +303,9 @@ func (ew *EndpointsWatcher) getOrNewServicePublisher(id ServiceID) *servicePubli
 				"ns":        id.Namespace,
 				"svc":       id.Name,
 			}),
-			k8sAPI: ew.k8sAPI,
-			ports:  make(map[portAndHostname]*portPublisher),
+			k8sAPI:               ew.k8sAPI,
+			enableEndpointSlices: ew.enableEndpointSlices,
+			ports:                make(map[portAndHostname]*portPublisher),
 		}
 		ew.publishers[id] = sp
 	}
@@ -283,6 +333,26 @@ func (sp *servicePublisher) updateEndpoints(newEndpoints *corev1.Endpoints) {
 	}
 }
 
+func (sp *servicePublisher) updateEndpointSlice(slice *k8s.EndpointSlice) {
+	sp.Lock()
+	defer sp.Unlock()
+	sp.log.Debugf("Updating endpoint slice %s for %s", slice.Name, sp.id)
+
+	for _, port := range sp.ports {
+		port.updateEndpointSlice(slice)
+	}
+}
+
+func (sp *servicePublisher) deleteEndpointSlice(slice *k8s.EndpointSlice) {
+	sp.Lock()
+	defer sp.Unlock()
+	sp.log.Debugf("Deleting endpoint slice %s for %s", slice.Name, sp.id)
+
+	for _, port := range sp.ports {
+		port.deleteEndpointSlice(slice)
+	}
+}
+
 func (sp *servicePublisher) deleteEndpoints() {
 	sp.Lock()
 	defer sp.Unlock()
@@ -355,13 +425,21 @@ func (sp *servicePublisher) newPortPublisher(srcPort Port, hostname string) *por
 	log := sp.log.WithField("port", srcPort)
 
 	port := &portPublisher{
-		listeners:  []EndpointUpdateListener{},
-		targetPort: targetPort,
-		hostname:   hostname,
-		exists:     exists,
-		k8sAPI:     sp.k8sAPI,
-		log:        log,
-		metrics:    endpointsVecs.newEndpointsMetrics(sp.metricsLabels(srcPort, hostname)),
+		id:                   sp.id,
+		listeners:            []EndpointUpdateListener{},
+		targetPort:           targetPort,
+		hostname:             hostname,
+		exists:               exists,
+		k8sAPI:               sp.k8sAPI,
+		enableEndpointSlices: sp.enableEndpointSlices,
+		slices:               make(map[string]PodSet),
+		log:                  log,
+		metrics:              endpointsVecs.newEndpointsMetrics(sp.metricsLabels(srcPort, hostname)),
+	}
+
+	if sp.enableEndpointSlices {
+		port.loadEndpointSlices()
+		return port
 	}
 
 	endpoints, err := sp.k8sAPI.Endpoint().Lister().Endpoints(sp.id.Namespace).Get(sp.id.Name)
@@ -436,29 +514,201 @@ func (pp *portPublisher) endpointsToAddresses(endpoints *corev1.Endpoints) PodSe
 				continue
 			}
 			if endpoint.TargetRef.Kind == "Pod" {
-				id := PodID{
-					Name:      endpoint.TargetRef.Name,
-					Namespace: endpoint.TargetRef.Namespace,
-				}
-				pod, err := pp.k8sAPI.Pod().Lister().Pods(id.Namespace).Get(id.Name)
+				address, id, err := pp.podAddress(endpoint.TargetRef, endpoint.IP, resolvedPort)
 				if err != nil {
 					pp.log.Errorf("Unable to fetch pod %v: %s", id, err)
 					continue
 				}
-				ownerKind, ownerName := pp.k8sAPI.GetOwnerKindAndName(pod, false)
-				pods[id] = Address{
-					IP:        endpoint.IP,
-					Port:      resolvedPort,
-					Pod:       pod,
-					OwnerName: ownerName,
-					OwnerKind: ownerKind,
-				}
+				pods[id] = address
 			}
 		}
 	}
 	return pods
 }
 
+// updateEndpointSlice publishes the changes within a single endpoint slice.
+// Only the addresses of that slice are diffed, so that a change to one pod of
+// a large service does not require re-diffing the whole address set.
+func (pp *portPublisher) updateEndpointSlice(slice *k8s.EndpointSlice) {
+	newPods := pp.endpointSliceToAddresses(slice)
+	oldPods := pp.slices[slice.Name]
+	pp.slices[slice.Name] = newPods
+
+	// pods whose address changed within the slice are removed and added
+	// again, so that listeners stop routing to the stale address
+	add := make(PodSet)
+	remove := make(PodSet)
+	for id, address := range newPods {
+		current, ok := pp.pods[id]
+		if !ok {
+			add[id] = address
+		} else if addressChanged(current, address) {
+			remove[id] = current
+			add[id] = address
+		}
+	}
+	for id, address := range oldPods {
+		if _, ok := newPods[id]; !ok && !pp.inOtherSlice(slice.Name, id) {
+			remove[id] = address
+		}
+	}
+
+	existed := pp.exists
+	pp.exists = true
+	if !existed && len(add) == 0 && len(remove) == 0 {
+		// the first slice of the service has no ready endpoints yet
+		for _, listener := range pp.listeners {
+			listener.NoEndpoints(true)
+		}
+		pp.metrics.incUpdates()
+		pp.metrics.setExists(true)
+		return
+	}
+	pp.publishSliceDiff(add, remove)
+}
+
+// deleteEndpointSlice publishes the removal of all the addresses of a single
+// endpoint slice which are not also part of another slice.
+func (pp *portPublisher) deleteEndpointSlice(slice *k8s.EndpointSlice) {
+	oldPods, ok := pp.slices[slice.Name]
+	if !ok {
+		return
+	}
+	delete(pp.slices, slice.Name)
+
+	remove := make(PodSet)
+	for id, address := range oldPods {
+		if !pp.inOtherSlice(slice.Name, id) {
+			remove[id] = address
+		}
+	}
+
+	pp.publishSliceDiff(make(PodSet), remove)
+}
+
+// publishSliceDiff applies the diff of a single endpoint slice to the address
+// set and publishes it.
+func (pp *portPublisher) publishSliceDiff(add, remove PodSet) {
+	if pp.pods == nil {
+		pp.pods = make(PodSet)
+	}
+	for id := range remove {
+		delete(pp.pods, id)
+	}
+	for id, address := range add {
+		pp.pods[id] = address
+	}
+	pp.publishDiff(add, remove)
+}
+
+// publishDiff publishes a diff which has already been applied to the address
+// set. Empty diffs are not published.
+func (pp *portPublisher) publishDiff(add, remove PodSet) {
+	if len(add) == 0 && len(remove) == 0 {
+		return
+	}
+
+	for _, listener := range pp.listeners {
+		if len(pp.pods) == 0 {
+			listener.NoEndpoints(true)
+			continue
+		}
+		if len(remove) > 0 {
+			listener.Remove(remove)
+		}
+		if len(add) > 0 {
+			listener.Add(add)
+		}
+	}
+
+	pp.metrics.incUpdates()
+	pp.metrics.setPods(len(pp.pods))
+	pp.metrics.setExists(pp.exists)
+}
+
+func (pp *portPublisher) inOtherSlice(sliceName string, id PodID) bool {
+	for name, pods := range pp.slices {
+		if name == sliceName {
+			continue
+		}
+		if _, ok := pods[id]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// loadEndpointSlices replaces the address set with the one built from all of
+// the service's endpoint slices.
+func (pp *portPublisher) loadEndpointSlices() {
+	slices, err := pp.k8sAPI.GetEndpointSlicesFor(pp.id.Namespace, pp.id.Name)
+	if err != nil {
+		pp.log.Errorf("error getting endpoint slices: %s", err)
+		return
+	}
+
+	pp.slices = make(map[string]PodSet)
+	for _, slice := range slices {
+		pp.updateEndpointSlice(slice)
+	}
+}
+
+func (pp *portPublisher) endpointSliceToAddresses(slice *k8s.EndpointSlice) PodSet {
+	pods := make(PodSet)
+	resolvedPort := pp.resolveSliceTargetPort(slice.Ports)
+	for _, endpoint := range slice.Endpoints {
+		if !endpoint.IsReady() || len(endpoint.Addresses) == 0 {
+			continue
+		}
+		if pp.hostname != "" && (endpoint.Hostname == nil || pp.hostname != *endpoint.Hostname) {
+			continue
+		}
+		ip := endpoint.Addresses[0]
+		if endpoint.TargetRef == nil {
+			id := ServiceID{
+				Name: strings.Join([]string{
+					pp.id.Name,
+					ip,
+					fmt.Sprint(resolvedPort),
+				}, "-"),
+				Namespace: pp.id.Namespace,
+			}
+			pods[id] = Address{
+				IP:   ip,
+				Port: resolvedPort,
+			}
+			continue
+		}
+		if endpoint.TargetRef.Kind == "Pod" {
+			address, id, err := pp.podAddress(endpoint.TargetRef, ip, resolvedPort)
+			if err != nil {
+				pp.log.Errorf("Unable to fetch pod %v: %s", id, err)
+				continue
+			}
+			pods[id] = address
+		}
+	}
+	return pods
+}
+
+func (pp *portPublisher) resolveSliceTargetPort(ports []k8s.EndpointPort) Port {
+	switch pp.targetPort.Type {
+	case intstr.Int:
+		return Port(pp.targetPort.IntVal)
+	case intstr.String:
+		for _, p := range ports {
+			name := ""
+			if p.Name != nil {
+				name = *p.Name
+			}
+			if name == pp.targetPort.StrVal && p.Port != nil {
+				return Port(*p.Port)
+			}
+		}
+	}
+	return Port(0)
+}
+
 func (pp *portPublisher) resolveTargetPort(subset corev1.EndpointSubset) Port {
 	switch pp.targetPort.Type {
 	case intstr.Int:
@@ -473,8 +723,52 @@ func (pp *portPublisher) resolveTargetPort(subset corev1.EndpointSubset) Port {
 	return Port(0)
 }
 
+// podAddress returns the address of the pod referenced by an endpoint.
+func (pp *portPublisher) podAddress(ref *corev1.ObjectReference, ip string, port Port) (Address, PodID, error) {
+	id := PodID{
+		Name:      ref.Name,
+		Namespace: ref.Namespace,
+	}
+	pod, err := pp.k8sAPI.Pod().Lister().Pods(id.Namespace).Get(id.Name)
+	if err != nil {
+		return Address{}, id, err
+	}
+	ownerKind, ownerName := pp.k8sAPI.GetOwnerKindAndName(pod, false)
+	return Address{
+		IP:        ip,
+		Port:      port,
+		Pod:       pod,
+		OwnerName: ownerName,
+		OwnerKind: ownerKind,
+	}, id, nil
+}
+
 func (pp *portPublisher) updatePort(targetPort namedPort) {
 	pp.targetPort = targetPort
+
+	if pp.enableEndpointSlices {
+		// The resolved port of every address may have changed, so rebuild
+		// the address set from scratch and publish the full diff.
+		oldPods := pp.pods
+		pp.pods = make(PodSet)
+		pp.slices = make(map[string]PodSet)
+		slices, err := pp.k8sAPI.GetEndpointSlicesFor(pp.id.Namespace, pp.id.Name)
+		if err != nil {
+			pp.log.Errorf("Unable to get endpoint slices during port update: %s", err)
+			return
+		}
+		for _, slice := range slices {
+			pods := pp.endpointSliceToAddresses(slice)
+			pp.slices[slice.Name] = pods
+			for id, address := range pods {
+				pp.pods[id] = address
+			}
+		}
+		add, remove := diffAddresses(oldPods, pp.pods)
+		pp.publishDiff(add, remove)
+		return
+	}
+
 	endpoints, err := pp.k8sAPI.Endpoint().Lister().Endpoints(pp.id.Namespace).Get(pp.id.Name)
 	if err == nil {
 		pp.updateEndpoints(endpoints)
@@ -486,6 +780,7 @@ func (pp *portPublisher) updatePort(targetPort namedPort) {
 func (pp *portPublisher) noEndpoints(exists bool) {
 	pp.exists = exists
 	pp.pods = make(PodSet)
+	pp.slices = make(map[string]PodSet)
 	for _, listener := range pp.listeners {
 		listener.NoEndpoints(exists)
 	}
@@ -552,6 +847,23 @@ func getTargetPort(service *corev1.Service, port Port) namedPort {
 	return targetPort
 }
 
+// diffAddresses is like diffPods, but also removes and adds again the pods
+// whose address changed.
+func diffAddresses(oldPods, newPods PodSet) (add, remove PodSet) {
+	add, remove = diffPods(oldPods, newPods)
+	for id, address := range newPods {
+		if old, ok := oldPods[id]; ok && addressChanged(old, address) {
+			remove[id] = old
+			add[id] = address
+		}
+	}
+	return
+}
+
+func addressChanged(a, b Address) bool {
+	return a.IP != b.IP || a.Port != b.Port
+}
+
 func diffPods(oldPods, newPods PodSet) (add, remove PodSet) {
 	// TODO: this detects pods which have been added or removed, but does not
 	// detect pods which have been modified.  A modified pod should trigger
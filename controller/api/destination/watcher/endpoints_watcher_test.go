@@ -6,8 +6,13 @@ import (
 	"testing"
 
 	"github.com/linkerd/linkerd2/controller/k8s"
-
+	pkgK8s "github.com/linkerd/linkerd2/pkg/k8s"
 	logging "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
 )
 
 type bufferingEndpointListener struct {
@@ -462,41 +467,246 @@ status:
 		},
 	} {
 		tt := tt // pin
-		t.Run("subscribes listener to "+tt.serviceType, func(t *testing.T) {
-			k8sAPI, err := k8s.NewFakeAPI(tt.k8sConfigs...)
-			if err != nil {
-				t.Fatalf("NewFakeAPI returned an error: %s", err)
+		for _, enableEndpointSlices := range []bool{false, true} {
+			enableEndpointSlices := enableEndpointSlices // pin
+			source := "endpoints"
+			if enableEndpointSlices {
+				source = "endpoint slices"
 			}
+			t.Run("subscribes listener to "+tt.serviceType+" from "+source, func(t *testing.T) {
+				k8sConfigs := tt.k8sConfigs
+				if enableEndpointSlices {
+					k8sConfigs = endpointsToEndpointSlices(t, k8sConfigs)
+				}
 
-			watcher := NewEndpointsWatcher(k8sAPI, logging.WithField("test", t.Name))
+				k8sAPI, err := k8s.NewFakeAPI(k8sConfigs...)
+				if err != nil {
+					t.Fatalf("NewFakeAPI returned an error: %s", err)
+				}
 
-			k8sAPI.Sync()
+				watcher := NewEndpointsWatcher(k8sAPI, logging.WithField("test", t.Name), enableEndpointSlices)
 
-			listener := newBufferingEndpointListener()
+				k8sAPI.Sync()
 
-			err = watcher.Subscribe(tt.id, tt.port, tt.hostname, listener)
-			if tt.expectedError && err == nil {
-				t.Fatal("Expected error but was ok")
-			}
-			if !tt.expectedError && err != nil {
-				t.Fatalf("Expected no error, got [%s]", err)
-			}
+				listener := newBufferingEndpointListener()
+
+				err = watcher.Subscribe(tt.id, tt.port, tt.hostname, listener)
+				if tt.expectedError && err == nil {
+					t.Fatal("Expected error but was ok")
+				}
+				if !tt.expectedError && err != nil {
+					t.Fatalf("Expected no error, got [%s]", err)
+				}
 
-			actualAddresses := make([]string, 0)
-			actualAddresses = append(actualAddresses, listener.added...)
-			sort.Strings(actualAddresses)
+				actualAddresses := make([]string, 0)
+				actualAddresses = append(actualAddresses, listener.added...)
+				sort.Strings(actualAddresses)
 
-			testCompare(t, tt.expectedAddresses, actualAddresses)
+				testCompare(t, tt.expectedAddresses, actualAddresses)
 
-			if listener.noEndpointsCalled != tt.expectedNoEndpoints {
-				t.Fatalf("Expected noEndpointsCalled to be [%t], got [%t]",
-					tt.expectedNoEndpoints, listener.noEndpointsCalled)
+				if listener.noEndpointsCalled != tt.expectedNoEndpoints {
+					t.Fatalf("Expected noEndpointsCalled to be [%t], got [%t]",
+						tt.expectedNoEndpoints, listener.noEndpointsCalled)
+				}
+
+				if listener.noEndpointsExists != tt.expectedNoEndpointsServiceExists {
+					t.Fatalf("Expected noEndpointsExists to be [%t], got [%t]",
+						tt.expectedNoEndpointsServiceExists, listener.noEndpointsExists)
+				}
+			})
+		}
+	}
+}
+
+func TestEndpointsWatcherEndpointSlices(t *testing.T) {
+	k8sAPI, err := k8s.NewFakeAPI(`
+apiVersion: v1
+kind: Service
+metadata:
+  name: name1
+  namespace: ns
+spec:
+  type: LoadBalancer
+  ports:
+  - port: 8989`,
+		`
+apiVersion: v1
+kind: Pod
+metadata:
+  name: name1-1
+  namespace: ns
+status:
+  phase: Running
+  podIP: 172.17.0.12`,
+		`
+apiVersion: v1
+kind: Pod
+metadata:
+  name: name1-2
+  namespace: ns
+status:
+  phase: Running
+  podIP: 172.17.0.19`,
+		`
+apiVersion: v1
+kind: Pod
+metadata:
+  name: name1-3
+  namespace: ns
+status:
+  phase: Running
+  podIP: 172.17.0.20`,
+	)
+	if err != nil {
+		t.Fatalf("NewFakeAPI returned an error: %s", err)
+	}
+
+	watcher := NewEndpointsWatcher(k8sAPI, logging.WithField("test", t.Name), true)
+
+	k8sAPI.Sync()
+
+	listener := newBufferingEndpointListener()
+	err = watcher.Subscribe(ServiceID{Name: "name1", Namespace: "ns"}, 8989, "", listener)
+	if err != nil {
+		t.Fatalf("Expected no error, got [%s]", err)
+	}
+
+	sliceA := endpointSliceObj(t, "name1-a", "name1-1", "name1-2")
+	sliceB := endpointSliceObj(t, "name1-b", "name1-3")
+
+	watcher.addEndpointSlice(sliceA)
+	testCompare(t, []string{"172.17.0.12:8989", "172.17.0.19:8989"}, sortedStrings(listener.added))
+
+	// Updating a slice without changes publishes nothing.
+	listener.added = []string{}
+	listener.noEndpointsCalled = false
+	watcher.addEndpointSlice(endpointSliceObj(t, "name1-a", "name1-1", "name1-2"))
+	testCompare(t, []string{}, listener.added)
+	testCompare(t, []string{}, listener.removed)
+	if listener.noEndpointsCalled {
+		t.Fatalf("Expected no NoEndpoints call for an unchanged slice")
+	}
+
+	// A pod whose address changed is removed and added again.
+	changed := endpointSliceObj(t, "name1-a", "name1-1", "name1-2")
+	endpoints := changed.Object["endpoints"].([]interface{})
+	endpoints[0].(map[string]interface{})["addresses"] = []interface{}{"172.17.0.13"}
+	watcher.addEndpointSlice(changed)
+	testCompare(t, []string{"172.17.0.13:8989"}, listener.added)
+	testCompare(t, []string{"172.17.0.12:8989"}, listener.removed)
+	watcher.addEndpointSlice(sliceA)
+	listener.removed = []string{}
+
+	// Adding a second slice only publishes the addresses of that slice.
+	listener.added = []string{}
+	watcher.addEndpointSlice(sliceB)
+	testCompare(t, []string{"172.17.0.20:8989"}, listener.added)
+
+	// A pod moving between slices is not removed.
+	listener.added = []string{}
+	watcher.addEndpointSlice(endpointSliceObj(t, "name1-b", "name1-2", "name1-3"))
+	watcher.addEndpointSlice(endpointSliceObj(t, "name1-a", "name1-1"))
+	testCompare(t, []string{}, listener.added)
+	testCompare(t, []string{}, listener.removed)
+
+	watcher.deleteEndpointSlice(endpointSliceObj(t, "name1-b", "name1-2", "name1-3"))
+	testCompare(t, []string{"172.17.0.19:8989", "172.17.0.20:8989"}, sortedStrings(listener.removed))
+
+	// Removing the last slice leaves the service with no endpoints.
+	listener.noEndpointsCalled = false
+	watcher.deleteEndpointSlice(endpointSliceObj(t, "name1-a", "name1-1"))
+	if !listener.noEndpointsCalled || !listener.noEndpointsExists {
+		t.Fatalf("Expected NoEndpoints(true) after the last slice was deleted")
+	}
+}
+
+func sortedStrings(strs []string) []string {
+	sorted := append([]string{}, strs...)
+	sort.Strings(sorted)
+	return sorted
+}
+
+func endpointSliceObj(t *testing.T, name string, pods ...string) *unstructured.Unstructured {
+	slice := k8s.EndpointSlice{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "discovery.k8s.io/v1alpha1",
+			Kind:       "EndpointSlice",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "ns",
+			Labels:    map[string]string{k8s.EndpointSliceServiceNameLabel: "name1"},
+		},
+	}
+	ips := map[string]string{"name1-1": "172.17.0.12", "name1-2": "172.17.0.19", "name1-3": "172.17.0.20"}
+	for _, pod := range pods {
+		slice.Endpoints = append(slice.Endpoints, k8s.SliceEndpoint{
+			Addresses: []string{ips[pod]},
+			TargetRef: &corev1.ObjectReference{Kind: "Pod", Name: pod, Namespace: "ns"},
+		})
+	}
+
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&slice)
+	if err != nil {
+		t.Fatalf("Failed to convert endpoint slice: %s", err)
+	}
+	return &unstructured.Unstructured{Object: obj}
+}
+
+// endpointsToEndpointSlices replaces every Endpoints config with the
+// equivalent EndpointSlice configs, one slice per subset, so that tests can
+// exercise both sources with the same fixtures.
+func endpointsToEndpointSlices(t *testing.T, configs []string) []string {
+	converted := []string{}
+	for _, config := range configs {
+		obj, err := pkgK8s.ToRuntimeObject(config)
+		if err != nil {
+			t.Fatalf("could not decode yml: %s", err)
+		}
+		endpoints, ok := obj.(*corev1.Endpoints)
+		if !ok {
+			converted = append(converted, config)
+			continue
+		}
+
+		for i, subset := range endpoints.Subsets {
+			slice := k8s.EndpointSlice{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: "discovery.k8s.io/v1alpha1",
+					Kind:       "EndpointSlice",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      fmt.Sprintf("%s-%d", endpoints.Name, i),
+					Namespace: endpoints.Namespace,
+					Labels:    map[string]string{k8s.EndpointSliceServiceNameLabel: endpoints.Name},
+				},
+			}
+			for _, port := range subset.Ports {
+				port := port // pin
+				slice.Ports = append(slice.Ports, k8s.EndpointPort{
+					Name:     &port.Name,
+					Protocol: &port.Protocol,
+					Port:     &port.Port,
+				})
+			}
+			for _, address := range subset.Addresses {
+				endpoint := k8s.SliceEndpoint{
+					Addresses: []string{address.IP},
+					TargetRef: address.TargetRef,
+				}
+				if address.Hostname != "" {
+					hostname := address.Hostname
+					endpoint.Hostname = &hostname
+				}
+				slice.Endpoints = append(slice.Endpoints, endpoint)
 			}
 
-			if listener.noEndpointsExists != tt.expectedNoEndpointsServiceExists {
-				t.Fatalf("Expected noEndpointsExists to be [%t], got [%t]",
-					tt.expectedNoEndpointsServiceExists, listener.noEndpointsExists)
+			bytes, err := yaml.Marshal(slice)
+			if err != nil {
+				t.Fatalf("could not encode endpoint slice: %s", err)
 			}
-		})
+			converted = append(converted, string(bytes))
+		}
 	}
+	return converted
 }
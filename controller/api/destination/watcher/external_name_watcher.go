@@ -0,0 +1,412 @@
+package watcher
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/linkerd/linkerd2/controller/k8s"
+	logging "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/cache"
+)
+
+const resolveTimeout = 5 * time.Second
+
+type (
+	// Resolver resolves host names into IP addresses.  It is satisfied by
+	// net.Resolver.
+	Resolver interface {
+		LookupHost(ctx context.Context, host string) ([]string, error)
+	}
+
+	// ExternalNameWatcher watches all ExternalName services in the Kubernetes
+	// cluster.  Listeners can subscribe to a particular service and port and
+	// ExternalNameWatcher will publish the addresses the service's external
+	// name resolves to and all future changes for that service:port.
+	//
+	// The external name is resolved again whenever the service is updated,
+	// including on every informer resync, so that changes in DNS are picked up
+	// periodically.  Names are resolved without holding any lock, in the
+	// background for informer events, so that a slow resolver only delays the
+	// subscriptions to the service being resolved.
+	ExternalNameWatcher struct {
+		publishers map[ServiceID]*externalNamePublisher
+		k8sAPI     *k8s.API
+		resolver   Resolver
+
+		log          *logging.Entry
+		sync.RWMutex // This mutex protects modification of the map itself.
+	}
+
+	// externalNamePublisher represents an ExternalName service.  It keeps
+	// track of the addresses the service's external name resolves to and of
+	// the listeners subscribed to each port of the service.
+	externalNamePublisher struct {
+		id       ServiceID
+		log      *logging.Entry
+		resolver Resolver
+
+		// externalName is empty if the service doesn't exist or isn't an
+		// ExternalName service.
+		externalName string
+		// resolvedName is the external name ips were resolved from.
+		resolvedName string
+		ips          []string
+		// published is false until the first resolution of the external name
+		// has been published to the listeners.
+		published bool
+		// existed is whether the service existed when ips were last published.
+		existed    bool
+		listeners  portListeners
+		refreshing sync.WaitGroup
+		// All access to the externalNamePublisher is explicitly synchronized by
+		// this mutex.
+		sync.Mutex
+	}
+)
+
+// NewExternalNameWatcher creates an ExternalNameWatcher and begins watching
+// the k8sAPI for service changes.  External names are resolved with the given
+// resolver.
+func NewExternalNameWatcher(k8sAPI *k8s.API, resolver Resolver, log *logging.Entry) *ExternalNameWatcher {
+	ew := &ExternalNameWatcher{
+		publishers: make(map[ServiceID]*externalNamePublisher),
+		k8sAPI:     k8sAPI,
+		resolver:   resolver,
+		log: log.WithFields(logging.Fields{
+			"component": "external-name-watcher",
+		}),
+	}
+
+	k8sAPI.Svc().Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    ew.addService,
+		DeleteFunc: ew.deleteService,
+		UpdateFunc: func(_, obj interface{}) { ew.addService(obj) },
+	})
+
+	return ew
+}
+
+///////////////////////////
+/// ExternalNameWatcher ///
+///////////////////////////
+
+// Subscribe to an ExternalName service and port.
+// The provided listener will be updated each time the addresses the service's
+// external name resolves to change.
+func (ew *ExternalNameWatcher) Subscribe(id ServiceID, port Port, listener EndpointUpdateListener) {
+	ew.log.Infof("Establishing watch on external name [%s:%d]", id, port)
+
+	// A concurrent Unsubscribe must not discard a new publisher before the
+	// listener is added, but the external name is only resolved once the
+	// watcher's lock is released.
+	ew.Lock()
+	enp, created := ew.getOrNewExternalNamePublisher(id)
+	enp.subscribe(port, listener)
+	ew.Unlock()
+
+	// The first resolution of a new publisher is published to all of the
+	// listeners which subscribed in the meantime, including this one.
+	if created {
+		enp.refresh()
+	}
+}
+
+// Unsubscribe removes a listener from the subscribers list for this service
+// and port.
+func (ew *ExternalNameWatcher) Unsubscribe(id ServiceID, port Port, listener EndpointUpdateListener) {
+	ew.log.Infof("Stopping watch on external name [%s:%d]", id, port)
+
+	ew.Lock()
+	defer ew.Unlock()
+
+	enp, ok := ew.publishers[id]
+	if !ok {
+		ew.log.Errorf("Cannot unsubscribe from unknown service [%s:%d]", id, port)
+		return
+	}
+	if enp.unsubscribe(port, listener) == 0 {
+		delete(ew.publishers, id)
+	}
+}
+
+func (ew *ExternalNameWatcher) addService(obj interface{}) {
+	service := obj.(*corev1.Service)
+	id := ServiceID{
+		Namespace: service.Namespace,
+		Name:      service.Name,
+	}
+
+	if enp, ok := ew.getExternalNamePublisher(id); ok {
+		enp.updateService(service)
+	}
+}
+
+func (ew *ExternalNameWatcher) deleteService(obj interface{}) {
+	service, ok := obj.(*corev1.Service)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			ew.log.Errorf("Couldn't get object from DeletedFinalStateUnknown %#v", obj)
+			return
+		}
+		service, ok = tombstone.Obj.(*corev1.Service)
+		if !ok {
+			ew.log.Errorf("DeletedFinalStateUnknown contained object that is not a Service %#v", obj)
+			return
+		}
+	}
+	id := ServiceID{
+		Namespace: service.Namespace,
+		Name:      service.Name,
+	}
+
+	if enp, ok := ew.getExternalNamePublisher(id); ok {
+		enp.updateService(nil)
+	}
+}
+
+// Returns the externalNamePublisher for the given service if it exists.
+// Otherwise, create a new one, seeded with the current external name of the
+// service, and return it along with true.  The new publisher's external name
+// is not resolved yet.  The caller must hold the watcher's lock.
+func (ew *ExternalNameWatcher) getOrNewExternalNamePublisher(id ServiceID) (*externalNamePublisher, bool) {
+	enp, ok := ew.publishers[id]
+	if !ok {
+		enp = &externalNamePublisher{
+			id: id,
+			log: ew.log.WithFields(logging.Fields{
+				"component": "external-name-publisher",
+				"ns":        id.Namespace,
+				"svc":       id.Name,
+			}),
+			resolver:  ew.resolver,
+			listeners: make(portListeners),
+		}
+
+		svc, err := ew.k8sAPI.Svc().Lister().Services(id.Namespace).Get(id.Name)
+		if err != nil && !apierrors.IsNotFound(err) {
+			enp.log.Errorf("error getting service: %s", err)
+		}
+		if err == nil {
+			enp.externalName = externalName(svc)
+		}
+		ew.publishers[id] = enp
+	}
+	return enp, !ok
+}
+
+func (ew *ExternalNameWatcher) getExternalNamePublisher(id ServiceID) (enp *externalNamePublisher, ok bool) {
+	ew.RLock()
+	defer ew.RUnlock()
+	enp, ok = ew.publishers[id]
+	return
+}
+
+/////////////////////////////
+/// externalNamePublisher ///
+/////////////////////////////
+
+// updateService records the external name of the given service and resolves
+// it in the background.  A nil service indicates that the service was
+// deleted.
+func (enp *externalNamePublisher) updateService(service *corev1.Service) {
+	enp.Lock()
+	newExternalName := ""
+	if service != nil {
+		newExternalName = externalName(service)
+	}
+	if newExternalName != enp.externalName {
+		enp.log.Debugf("Updating external name for %s to %q", enp.id, newExternalName)
+	}
+	enp.externalName = newExternalName
+	enp.Unlock()
+
+	enp.refreshing.Add(1)
+	go func() {
+		defer enp.refreshing.Done()
+		enp.refresh()
+	}()
+}
+
+// refresh resolves the publisher's external name and publishes any change in
+// the resolved addresses.  The name is resolved without holding the
+// publisher's mutex.  If the name cannot be resolved, the addresses it last
+// resolved to keep being served.
+func (enp *externalNamePublisher) refresh() {
+	enp.Lock()
+	name := enp.externalName
+	enp.Unlock()
+
+	ips, err := enp.resolve(name)
+
+	enp.Lock()
+	defer enp.Unlock()
+
+	if name != enp.externalName {
+		// the service changed while resolving, and is being refreshed again
+		return
+	}
+	if err != nil {
+		enp.log.Errorf("Failed to resolve external name %s: %s", name, err)
+		if enp.published && enp.resolvedName == name {
+			return
+		}
+	}
+	enp.resolvedName = name
+	enp.publish(ips)
+}
+
+// publish replaces the resolved addresses and publishes the change to the
+// listeners.  The caller must hold the publisher's mutex.
+func (enp *externalNamePublisher) publish(ips []string) {
+	oldIPs := enp.ips
+	existed := enp.existed
+	enp.ips = ips
+	enp.existed = enp.externalName != ""
+
+	if !enp.published {
+		enp.published = true
+		enp.listeners.each(enp.publishState)
+		return
+	}
+
+	if len(enp.ips) == 0 {
+		if len(oldIPs) == 0 && existed == enp.existed {
+			return
+		}
+		enp.listeners.each(func(_ Port, listener EndpointUpdateListener) {
+			listener.NoEndpoints(enp.existed)
+		})
+		return
+	}
+
+	add, remove := diffIPs(oldIPs, enp.ips)
+	enp.listeners.each(func(port Port, listener EndpointUpdateListener) {
+		if len(oldIPs) == 0 {
+			listener.Add(enp.addresses(enp.ips, port))
+			return
+		}
+		if len(add) > 0 {
+			listener.Add(enp.addresses(add, port))
+		}
+		if len(remove) > 0 {
+			listener.Remove(enp.addresses(remove, port))
+		}
+	})
+}
+
+// publishState publishes the current addresses to a listener.  The caller
+// must hold the publisher's mutex.
+func (enp *externalNamePublisher) publishState(port Port, listener EndpointUpdateListener) {
+	if len(enp.ips) > 0 {
+		listener.Add(enp.addresses(enp.ips, port))
+	} else {
+		listener.NoEndpoints(enp.existed)
+	}
+}
+
+// resolve returns the sorted IPv4 addresses the given external name resolves
+// to.
+func (enp *externalNamePublisher) resolve(name string) ([]string, error) {
+	if name == "" {
+		return nil, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), resolveTimeout)
+	defer cancel()
+	addrs, err := enp.resolver.LookupHost(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only IPv4 addresses can be served to the proxy.
+	ips := []string{}
+	for _, addr := range addrs {
+		if ip := net.ParseIP(addr); ip != nil && ip.To4() != nil {
+			ips = append(ips, ip.String())
+		}
+	}
+	sort.Strings(ips)
+	return ips, nil
+}
+
+// addresses returns the set of addresses for the given IPs on the given port.
+func (enp *externalNamePublisher) addresses(ips []string, port Port) PodSet {
+	set := make(PodSet)
+	for _, ip := range ips {
+		id := ServiceID{
+			Name: strings.Join([]string{
+				enp.id.Name,
+				ip,
+				fmt.Sprint(port),
+			}, "-"),
+			Namespace: enp.id.Namespace,
+		}
+		set[id] = Address{
+			IP:   ip,
+			Port: port,
+		}
+	}
+	return set
+}
+
+// subscribe adds the listener to the given port.  If the external name has not
+// been resolved yet, the listener is sent the addresses once it has.
+func (enp *externalNamePublisher) subscribe(port Port, listener EndpointUpdateListener) {
+	enp.Lock()
+	defer enp.Unlock()
+
+	if enp.published {
+		enp.publishState(port, listener)
+	}
+	enp.listeners.add(port, listener)
+}
+
+// unsubscribe removes the listener and returns the number of listeners
+// still subscribed to the service.
+func (enp *externalNamePublisher) unsubscribe(port Port, listener EndpointUpdateListener) int {
+	enp.Lock()
+	defer enp.Unlock()
+
+	return enp.listeners.remove(port, listener)
+}
+
+// IsExternalName returns true if the given service is an ExternalName
+// service.
+func IsExternalName(service *corev1.Service) bool {
+	return externalName(service) != ""
+}
+
+func externalName(service *corev1.Service) string {
+	if service.Spec.Type != corev1.ServiceTypeExternalName {
+		return ""
+	}
+	return service.Spec.ExternalName
+}
+
+func diffIPs(oldIPs, newIPs []string) (add, remove []string) {
+	old := make(map[string]struct{}, len(oldIPs))
+	for _, ip := range oldIPs {
+		old[ip] = struct{}{}
+	}
+	current := make(map[string]struct{}, len(newIPs))
+	for _, ip := range newIPs {
+		current[ip] = struct{}{}
+		if _, ok := old[ip]; !ok {
+			add = append(add, ip)
+		}
+	}
+	for _, ip := range oldIPs {
+		if _, ok := current[ip]; !ok {
+			remove = append(remove, ip)
+		}
+	}
+	return
+}
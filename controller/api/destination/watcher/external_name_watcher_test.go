@@ -0,0 +1,252 @@
+package watcher
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/linkerd/linkerd2/controller/k8s"
+	logging "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestExternalNameWatcher(t *testing.T) {
+	for _, tt := range []struct {
+		description                      string
+		k8sConfigs                       []string
+		resolver                         MockResolver
+		id                               ServiceID
+		port                             Port
+		expectedAddresses                []string
+		expectedNoEndpoints              bool
+		expectedNoEndpointsServiceExists bool
+	}{
+		{
+			description: "an ExternalName service",
+			k8sConfigs: []string{`
+apiVersion: v1
+kind: Service
+metadata:
+  name: name1
+  namespace: ns
+spec:
+  type: ExternalName
+  externalName: api.example.com`,
+			},
+			resolver: MockResolver{
+				"api.example.com": {"192.0.2.2", "192.0.2.1", "2001:db8::1"},
+			},
+			id:                               ServiceID{Name: "name1", Namespace: "ns"},
+			port:                             443,
+			expectedAddresses:                []string{"192.0.2.1:443", "192.0.2.2:443"},
+			expectedNoEndpoints:              false,
+			expectedNoEndpointsServiceExists: false,
+		},
+		{
+			description: "an ExternalName service which cannot be resolved",
+			k8sConfigs: []string{`
+apiVersion: v1
+kind: Service
+metadata:
+  name: name1
+  namespace: ns
+spec:
+  type: ExternalName
+  externalName: api.example.com`,
+			},
+			resolver:                         MockResolver{},
+			id:                               ServiceID{Name: "name1", Namespace: "ns"},
+			port:                             443,
+			expectedAddresses:                []string{},
+			expectedNoEndpoints:              true,
+			expectedNoEndpointsServiceExists: true,
+		},
+		{
+			description:                      "a service which doesn't exist",
+			k8sConfigs:                       []string{},
+			resolver:                         MockResolver{},
+			id:                               ServiceID{Name: "name1", Namespace: "ns"},
+			port:                             443,
+			expectedAddresses:                []string{},
+			expectedNoEndpoints:              true,
+			expectedNoEndpointsServiceExists: false,
+		},
+	} {
+		tt := tt // pin
+		t.Run("subscribes listener to "+tt.description, func(t *testing.T) {
+			k8sAPI, err := k8s.NewFakeAPI(tt.k8sConfigs...)
+			if err != nil {
+				t.Fatalf("NewFakeAPI returned an error: %s", err)
+			}
+
+			watcher := NewExternalNameWatcher(k8sAPI, tt.resolver, logging.WithField("test", t.Name))
+
+			k8sAPI.Sync()
+
+			listener := newBufferingEndpointListener()
+
+			watcher.Subscribe(tt.id, tt.port, listener)
+
+			actualAddresses := make([]string, 0)
+			actualAddresses = append(actualAddresses, listener.added...)
+			sort.Strings(actualAddresses)
+
+			testCompare(t, tt.expectedAddresses, actualAddresses)
+
+			if listener.noEndpointsCalled != tt.expectedNoEndpoints {
+				t.Fatalf("Expected noEndpointsCalled to be [%t], got [%t]",
+					tt.expectedNoEndpoints, listener.noEndpointsCalled)
+			}
+
+			if listener.noEndpointsExists != tt.expectedNoEndpointsServiceExists {
+				t.Fatalf("Expected noEndpointsExists to be [%t], got [%t]",
+					tt.expectedNoEndpointsServiceExists, listener.noEndpointsExists)
+			}
+		})
+	}
+}
+
+func TestExternalNameWatcherServiceChanges(t *testing.T) {
+	k8sAPI, err := k8s.NewFakeAPI()
+	if err != nil {
+		t.Fatalf("NewFakeAPI returned an error: %s", err)
+	}
+
+	resolver := MockResolver{
+		"api.example.com": {"192.0.2.1", "192.0.2.2"},
+		"web.example.com": {"192.0.2.2", "192.0.2.3"},
+	}
+	watcher := NewExternalNameWatcher(k8sAPI, resolver, logging.WithField("test", t.Name))
+
+	k8sAPI.Sync()
+
+	id := ServiceID{Name: "name1", Namespace: "ns"}
+	listener := newBufferingEndpointListener()
+	watcher.Subscribe(id, 443, listener)
+
+	if !listener.noEndpointsCalled || listener.noEndpointsExists {
+		t.Fatalf("Expected NoEndpoints(false) on subscription")
+	}
+
+	newService := func(externalName string) *corev1.Service {
+		return &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: id.Name, Namespace: id.Namespace},
+			Spec: corev1.ServiceSpec{
+				Type:         corev1.ServiceTypeExternalName,
+				ExternalName: externalName,
+			},
+		}
+	}
+
+	// Services are resolved in the background on informer events.
+	update := func(service *corev1.Service, deleted bool) {
+		if deleted {
+			watcher.deleteService(service)
+		} else {
+			watcher.addService(service)
+		}
+		enp, _ := watcher.getExternalNamePublisher(id)
+		enp.refreshing.Wait()
+	}
+
+	update(newService("api.example.com"), false)
+	testCompare(t, []string{"192.0.2.1:443", "192.0.2.2:443"}, sortedStrings(listener.added))
+
+	// Re-resolving the same addresses does not publish anything new.
+	update(newService("api.example.com"), false)
+	testCompare(t, []string{"192.0.2.1:443", "192.0.2.2:443"}, sortedStrings(listener.added))
+
+	// Failing to resolve the name again keeps the last addresses.
+	listener.noEndpointsCalled = false
+	delete(resolver, "api.example.com")
+	update(newService("api.example.com"), false)
+	testCompare(t, []string{}, listener.removed)
+	if listener.noEndpointsCalled {
+		t.Fatalf("Expected no NoEndpoints call after a failed resolution")
+	}
+
+	// Changing the external name only publishes the difference.
+	update(newService("web.example.com"), false)
+	testCompare(t, []string{"192.0.2.1:443", "192.0.2.2:443", "192.0.2.3:443"}, sortedStrings(listener.added))
+	testCompare(t, []string{"192.0.2.1:443"}, sortedStrings(listener.removed))
+
+	listener.noEndpointsCalled = false
+	update(newService("web.example.com"), true)
+	if !listener.noEndpointsCalled || listener.noEndpointsExists {
+		t.Fatalf("Expected NoEndpoints(false) after the service was deleted")
+	}
+
+	watcher.Unsubscribe(id, 443, listener)
+	if _, ok := watcher.getExternalNamePublisher(id); ok {
+		t.Fatalf("Expected publisher to be removed after the last unsubscribe")
+	}
+}
+
+// blockingResolver blocks the resolution of slow.example.com until release is
+// closed, closing started once it is blocked.
+type blockingResolver struct {
+	MockResolver
+	started chan struct{}
+	release chan struct{}
+}
+
+func (b blockingResolver) LookupHost(ctx context.Context, host string) ([]string, error) {
+	if host == "slow.example.com" {
+		close(b.started)
+		<-b.release
+	}
+	return b.MockResolver.LookupHost(ctx, host)
+}
+
+func TestExternalNameWatcherSlowResolver(t *testing.T) {
+	k8sAPI, err := k8s.NewFakeAPI(`
+apiVersion: v1
+kind: Service
+metadata:
+  name: slow
+  namespace: ns
+spec:
+  type: ExternalName
+  externalName: slow.example.com`, `
+apiVersion: v1
+kind: Service
+metadata:
+  name: fast
+  namespace: ns
+spec:
+  type: ExternalName
+  externalName: fast.example.com`)
+	if err != nil {
+		t.Fatalf("NewFakeAPI returned an error: %s", err)
+	}
+
+	resolver := blockingResolver{
+		MockResolver: MockResolver{
+			"slow.example.com": {"192.0.2.1"},
+			"fast.example.com": {"192.0.2.2"},
+		},
+		started: make(chan struct{}),
+		release: make(chan struct{}),
+	}
+	watcher := NewExternalNameWatcher(k8sAPI, resolver, logging.WithField("test", t.Name))
+
+	k8sAPI.Sync()
+
+	slowListener := newBufferingEndpointListener()
+	subscribed := make(chan struct{})
+	go func() {
+		watcher.Subscribe(ServiceID{Name: "slow", Namespace: "ns"}, 443, slowListener)
+		close(subscribed)
+	}()
+	<-resolver.started
+
+	// Subscribing to another service isn't held up by the slow resolution.
+	fastListener := newBufferingEndpointListener()
+	watcher.Subscribe(ServiceID{Name: "fast", Namespace: "ns"}, 443, fastListener)
+	testCompare(t, []string{"192.0.2.2:443"}, fastListener.added)
+
+	close(resolver.release)
+	<-subscribed
+	testCompare(t, []string{"192.0.2.1:443"}, slowListener.added)
+}
@@ -0,0 +1,319 @@
+package watcher
+
+import (
+	"reflect"
+	"sync"
+
+	"github.com/linkerd/linkerd2/controller/k8s"
+	logging "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+type (
+	// IPWatcher watches all pods in the Kubernetes cluster.  Listeners can
+	// subscribe to a particular pod IP and port and IPWatcher will publish the
+	// address of the pod currently assigned that IP and all future changes for
+	// that IP:port.
+	IPWatcher struct {
+		publishers map[string]*ipPublisher
+		k8sAPI     *k8s.API
+
+		log          *logging.Entry
+		sync.RWMutex // This mutex protects modification of the map itself.
+	}
+
+	// ipPublisher represents a pod IP.  It keeps track of the pod which is
+	// currently assigned the IP, if any, and of the listeners subscribed to
+	// each port on that IP.
+	ipPublisher struct {
+		ip     string
+		log    *logging.Entry
+		k8sAPI *k8s.API
+
+		address   *Address
+		listeners portListeners
+		// All access to the ipPublisher is explicitly synchronized by this mutex.
+		sync.Mutex
+	}
+)
+
+// NewIPWatcher creates an IPWatcher and begins watching the k8sAPI for pod
+// changes.
+func NewIPWatcher(k8sAPI *k8s.API, log *logging.Entry) *IPWatcher {
+	iw := &IPWatcher{
+		publishers: make(map[string]*ipPublisher),
+		k8sAPI:     k8sAPI,
+		log: log.WithFields(logging.Fields{
+			"component": "ip-watcher",
+		}),
+	}
+
+	k8sAPI.Pod().Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    iw.addPod,
+		DeleteFunc: iw.deletePod,
+		UpdateFunc: iw.updatePod,
+	})
+
+	return iw
+}
+
+/////////////////
+/// IPWatcher ///
+/////////////////
+
+// Subscribe to a pod IP and port.
+// The provided listener will be updated each time the pod assigned the given
+// IP changes.
+func (iw *IPWatcher) Subscribe(ip string, port Port, listener EndpointUpdateListener) {
+	iw.log.Infof("Establishing watch on IP [%s:%d]", ip, port)
+
+	// The watcher's lock is held while subscribing so that a concurrent
+	// Unsubscribe cannot discard the publisher before the listener is added.
+	iw.Lock()
+	defer iw.Unlock()
+
+	ipp := iw.getOrNewIPPublisher(ip)
+	ipp.subscribe(port, listener)
+}
+
+// Unsubscribe removes a listener from the subscribers list for this IP and
+// port.
+func (iw *IPWatcher) Unsubscribe(ip string, port Port, listener EndpointUpdateListener) {
+	iw.log.Infof("Stopping watch on IP [%s:%d]", ip, port)
+
+	iw.Lock()
+	defer iw.Unlock()
+
+	ipp, ok := iw.publishers[ip]
+	if !ok {
+		iw.log.Errorf("Cannot unsubscribe from unknown IP [%s:%d]", ip, port)
+		return
+	}
+	if ipp.unsubscribe(port, listener) == 0 {
+		delete(iw.publishers, ip)
+	}
+}
+
+func (iw *IPWatcher) addPod(obj interface{}) {
+	pod := obj.(*corev1.Pod)
+	if pod.Spec.HostNetwork || pod.Status.PodIP == "" {
+		return
+	}
+
+	if ipp, ok := iw.getIPPublisher(pod.Status.PodIP); ok {
+		ipp.updatePod(pod)
+	}
+}
+
+func (iw *IPWatcher) updatePod(oldObj, newObj interface{}) {
+	oldPod := oldObj.(*corev1.Pod)
+	newPod := newObj.(*corev1.Pod)
+	if oldPod.Status.PodIP != newPod.Status.PodIP {
+		iw.deletePod(oldPod)
+	}
+	iw.addPod(newPod)
+}
+
+func (iw *IPWatcher) deletePod(obj interface{}) {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			iw.log.Errorf("Couldn't get object from DeletedFinalStateUnknown %#v", obj)
+			return
+		}
+		pod, ok = tombstone.Obj.(*corev1.Pod)
+		if !ok {
+			iw.log.Errorf("DeletedFinalStateUnknown contained object that is not a Pod %#v", obj)
+			return
+		}
+	}
+	if pod.Status.PodIP == "" {
+		return
+	}
+
+	if ipp, ok := iw.getIPPublisher(pod.Status.PodIP); ok {
+		ipp.deletePod(pod)
+	}
+}
+
+// Returns the ipPublisher for the given IP if it exists.  Otherwise, create a
+// new one, seeded with the pod currently assigned that IP, and return it.  The
+// caller must hold the watcher's lock.
+func (iw *IPWatcher) getOrNewIPPublisher(ip string) *ipPublisher {
+	ipp, ok := iw.publishers[ip]
+	if !ok {
+		ipp = &ipPublisher{
+			ip: ip,
+			log: iw.log.WithFields(logging.Fields{
+				"component": "ip-publisher",
+				"ip":        ip,
+			}),
+			k8sAPI:    iw.k8sAPI,
+			listeners: make(portListeners),
+		}
+		ipp.address = ipp.lookupPod()
+		iw.publishers[ip] = ipp
+	}
+	return ipp
+}
+
+func (iw *IPWatcher) getIPPublisher(ip string) (ipp *ipPublisher, ok bool) {
+	iw.RLock()
+	defer iw.RUnlock()
+	ipp, ok = iw.publishers[ip]
+	return
+}
+
+///////////////////
+/// ipPublisher ///
+///////////////////
+
+// lookupPod returns the address of the single pending or running pod which is
+// assigned the publisher's IP.  If no such pod exists, or if the IP cannot be
+// attributed to a single pod, nil is returned.
+func (ipp *ipPublisher) lookupPod() *Address {
+	pods, err := ipp.k8sAPI.GetPodsByIP(ipp.ip)
+	if err != nil {
+		ipp.log.Errorf("Failed to look up pods by IP: %s", err)
+		return nil
+	}
+
+	var address *Address
+	for _, pod := range pods {
+		if !k8s.IsPendingOrRunning(pod) {
+			continue
+		}
+		if address != nil {
+			ipp.log.Warnf("Could not uniquely identify pod at %s (found %d pods)", ipp.ip, len(pods))
+			return nil
+		}
+		address = ipp.podToAddress(pod)
+	}
+	return address
+}
+
+func (ipp *ipPublisher) podToAddress(pod *corev1.Pod) *Address {
+	ownerKind, ownerName := ipp.k8sAPI.GetOwnerKindAndName(pod, false)
+	return &Address{
+		IP:        ipp.ip,
+		Pod:       pod,
+		OwnerKind: ownerKind,
+		OwnerName: ownerName,
+	}
+}
+
+func (ipp *ipPublisher) updatePod(pod *corev1.Pod) {
+	if !k8s.IsPendingOrRunning(pod) {
+		ipp.deletePod(pod)
+		return
+	}
+
+	ipp.Lock()
+	defer ipp.Unlock()
+
+	if ipp.address != nil {
+		if ipp.address.Pod.Namespace == pod.Namespace && ipp.address.Pod.Name == pod.Name {
+			ipp.refreshPod(pod)
+			return
+		}
+		ipp.listeners.each(func(port Port, listener EndpointUpdateListener) {
+			listener.Remove(ipp.podSet(port))
+		})
+	}
+
+	ipp.log.Debugf("Updating pod for IP %s to %s/%s", ipp.ip, pod.Namespace, pod.Name)
+	ipp.address = ipp.podToAddress(pod)
+	ipp.listeners.each(func(port Port, listener EndpointUpdateListener) {
+		listener.Add(ipp.podSet(port))
+	})
+}
+
+// refreshPod replaces the address of the publisher's pod with its latest
+// version, and publishes it again if any of the metadata sent to listeners
+// changed.  The caller must hold the publisher's mutex.
+func (ipp *ipPublisher) refreshPod(pod *corev1.Pod) {
+	old := ipp.address
+	ipp.address = ipp.podToAddress(pod)
+	if !podMetadataChanged(old, ipp.address) {
+		return
+	}
+
+	ipp.log.Debugf("Updating pod %s/%s for IP %s", pod.Namespace, pod.Name, ipp.ip)
+	ipp.listeners.each(func(port Port, listener EndpointUpdateListener) {
+		listener.Add(ipp.podSet(port))
+	})
+}
+
+func (ipp *ipPublisher) deletePod(pod *corev1.Pod) {
+	ipp.Lock()
+	defer ipp.Unlock()
+
+	if ipp.address == nil ||
+		ipp.address.Pod.Namespace != pod.Namespace ||
+		ipp.address.Pod.Name != pod.Name {
+		return
+	}
+
+	ipp.log.Debugf("Deleting pod %s/%s for IP %s", pod.Namespace, pod.Name, ipp.ip)
+	ipp.address = nil
+	ipp.listeners.each(func(_ Port, listener EndpointUpdateListener) {
+		listener.NoEndpoints(false)
+	})
+}
+
+// podSet returns the set containing the address of the publisher's pod on the
+// given port.  The caller must hold the publisher's mutex and ensure that the
+// publisher has a pod.
+func (ipp *ipPublisher) podSet(port Port) PodSet {
+	address := *ipp.address
+	address.Port = port
+	id := PodID{
+		Namespace: address.Pod.Namespace,
+		Name:      address.Pod.Name,
+	}
+	return PodSet{id: address}
+}
+
+func (ipp *ipPublisher) subscribe(port Port, listener EndpointUpdateListener) {
+	ipp.Lock()
+	defer ipp.Unlock()
+
+	if ipp.address != nil {
+		listener.Add(ipp.podSet(port))
+	} else {
+		listener.NoEndpoints(false)
+	}
+	ipp.listeners.add(port, listener)
+}
+
+// unsubscribe removes the listener from the given port and returns the number
+// of listeners remaining on the publisher.
+func (ipp *ipPublisher) unsubscribe(port Port, listener EndpointUpdateListener) int {
+	ipp.Lock()
+	defer ipp.Unlock()
+
+	return ipp.listeners.remove(port, listener)
+}
+
+// podMetadataChanged returns true if the addresses of the same pod differ in
+// the metadata published to listeners: readiness, labels, annotations, owner
+// or node.
+func podMetadataChanged(old, new *Address) bool {
+	return old.OwnerKind != new.OwnerKind ||
+		old.OwnerName != new.OwnerName ||
+		old.Pod.Spec.NodeName != new.Pod.Spec.NodeName ||
+		isPodReady(old.Pod) != isPodReady(new.Pod) ||
+		!reflect.DeepEqual(old.Pod.Labels, new.Pod.Labels) ||
+		!reflect.DeepEqual(old.Pod.Annotations, new.Pod.Annotations)
+}
+
+func isPodReady(pod *corev1.Pod) bool {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
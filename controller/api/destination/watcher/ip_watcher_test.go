@@ -0,0 +1,208 @@
+package watcher
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/linkerd/linkerd2/controller/k8s"
+	logging "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestIPWatcher(t *testing.T) {
+	for _, tt := range []struct {
+		description                      string
+		k8sConfigs                       []string
+		ip                               string
+		port                             Port
+		expectedAddresses                []string
+		expectedNoEndpoints              bool
+		expectedNoEndpointsServiceExists bool
+	}{
+		{
+			description: "a running pod",
+			k8sConfigs: []string{`
+apiVersion: v1
+kind: Pod
+metadata:
+  name: name1-1
+  namespace: ns
+  ownerReferences:
+  - kind: ReplicaSet
+    name: rs-1
+status:
+  phase: Running
+  podIP: 172.17.0.12`,
+			},
+			ip:                               "172.17.0.12",
+			port:                             8989,
+			expectedAddresses:                []string{"172.17.0.12:8989"},
+			expectedNoEndpoints:              false,
+			expectedNoEndpointsServiceExists: false,
+		},
+		{
+			description:                      "an unknown IP",
+			k8sConfigs:                       []string{},
+			ip:                               "172.17.0.12",
+			port:                             8989,
+			expectedAddresses:                []string{},
+			expectedNoEndpoints:              true,
+			expectedNoEndpointsServiceExists: false,
+		},
+		{
+			description: "a host network pod",
+			k8sConfigs: []string{`
+apiVersion: v1
+kind: Pod
+metadata:
+  name: name1-1
+  namespace: ns
+spec:
+  hostNetwork: true
+status:
+  phase: Running
+  podIP: 172.17.0.12`,
+			},
+			ip:                               "172.17.0.12",
+			port:                             8989,
+			expectedAddresses:                []string{},
+			expectedNoEndpoints:              true,
+			expectedNoEndpointsServiceExists: false,
+		},
+		{
+			description: "a completed pod sharing its IP with a running pod",
+			k8sConfigs: []string{`
+apiVersion: v1
+kind: Pod
+metadata:
+  name: name1-1
+  namespace: ns
+status:
+  phase: Succeeded
+  podIP: 172.17.0.12`,
+				`
+apiVersion: v1
+kind: Pod
+metadata:
+  name: name1-2
+  namespace: ns
+status:
+  phase: Running
+  podIP: 172.17.0.12`,
+			},
+			ip:                               "172.17.0.12",
+			port:                             8989,
+			expectedAddresses:                []string{"172.17.0.12:8989"},
+			expectedNoEndpoints:              false,
+			expectedNoEndpointsServiceExists: false,
+		},
+	} {
+		tt := tt // pin
+		t.Run("subscribes listener to "+tt.description, func(t *testing.T) {
+			k8sAPI, err := k8s.NewFakeAPI(tt.k8sConfigs...)
+			if err != nil {
+				t.Fatalf("NewFakeAPI returned an error: %s", err)
+			}
+
+			watcher := NewIPWatcher(k8sAPI, logging.WithField("test", t.Name))
+
+			k8sAPI.Sync()
+
+			listener := newBufferingEndpointListener()
+
+			watcher.Subscribe(tt.ip, tt.port, listener)
+
+			actualAddresses := make([]string, 0)
+			actualAddresses = append(actualAddresses, listener.added...)
+			sort.Strings(actualAddresses)
+
+			testCompare(t, tt.expectedAddresses, actualAddresses)
+
+			if listener.noEndpointsCalled != tt.expectedNoEndpoints {
+				t.Fatalf("Expected noEndpointsCalled to be [%t], got [%t]",
+					tt.expectedNoEndpoints, listener.noEndpointsCalled)
+			}
+
+			if listener.noEndpointsExists != tt.expectedNoEndpointsServiceExists {
+				t.Fatalf("Expected noEndpointsExists to be [%t], got [%t]",
+					tt.expectedNoEndpointsServiceExists, listener.noEndpointsExists)
+			}
+		})
+	}
+}
+
+func TestIPWatcherPodChanges(t *testing.T) {
+	k8sAPI, err := k8s.NewFakeAPI()
+	if err != nil {
+		t.Fatalf("NewFakeAPI returned an error: %s", err)
+	}
+
+	watcher := NewIPWatcher(k8sAPI, logging.WithField("test", t.Name))
+
+	k8sAPI.Sync()
+
+	listener := newBufferingEndpointListener()
+	watcher.Subscribe("172.17.0.12", 8989, listener)
+
+	if !listener.noEndpointsCalled || listener.noEndpointsExists {
+		t.Fatalf("Expected NoEndpoints(false) on subscription")
+	}
+
+	newPod := func(name string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns"},
+			Status: corev1.PodStatus{
+				Phase: corev1.PodRunning,
+				PodIP: "172.17.0.12",
+			},
+		}
+	}
+
+	pod1 := newPod("name1-1")
+	watcher.addPod(pod1)
+	testCompare(t, []string{"172.17.0.12:8989"}, listener.added)
+
+	// Updating the same pod without any relevant change does not publish
+	// anything new.
+	watcher.updatePod(pod1, pod1)
+	testCompare(t, []string{"172.17.0.12:8989"}, listener.added)
+
+	// Relabelling the pod publishes its new address.
+	relabelled := pod1.DeepCopy()
+	relabelled.Labels = map[string]string{"app": "name1"}
+	watcher.updatePod(pod1, relabelled)
+	testCompare(t, []string{"172.17.0.12:8989", "172.17.0.12:8989"}, listener.added)
+
+	// So does the pod becoming ready.
+	ready := relabelled.DeepCopy()
+	ready.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}}
+	watcher.updatePod(relabelled, ready)
+	testCompare(t, []string{"172.17.0.12:8989", "172.17.0.12:8989", "172.17.0.12:8989"}, listener.added)
+	if ipp, _ := watcher.getIPPublisher("172.17.0.12"); ipp.address.Pod != ready {
+		t.Fatalf("Expected the publisher to hold the latest version of the pod")
+	}
+
+	// The IP being reassigned to another pod replaces the address.
+	pod2 := newPod("name1-2")
+	watcher.addPod(pod2)
+	testCompare(t, []string{"172.17.0.12:8989"}, listener.removed)
+	testCompare(t, []string{"172.17.0.12:8989", "172.17.0.12:8989", "172.17.0.12:8989", "172.17.0.12:8989"}, listener.added)
+
+	// Deleting a pod which no longer holds the IP is ignored.
+	listener.noEndpointsCalled = false
+	watcher.deletePod(pod1)
+	if listener.noEndpointsCalled {
+		t.Fatalf("Expected deletion of a stale pod to be ignored")
+	}
+
+	watcher.deletePod(pod2)
+	if !listener.noEndpointsCalled || listener.noEndpointsExists {
+		t.Fatalf("Expected NoEndpoints(false) after the pod was deleted")
+	}
+
+	watcher.Unsubscribe("172.17.0.12", 8989, listener)
+	if _, ok := watcher.getIPPublisher("172.17.0.12"); ok {
+		t.Fatalf("Expected publisher to be removed after the last unsubscribe")
+	}
+}
@@ -0,0 +1,47 @@
+package watcher
+
+// portListeners holds the listeners of a publisher serving all of its ports
+// from the same state, such as ipPublisher and externalNamePublisher, indexed
+// by the port they subscribed to.  It is synchronized by the publisher's
+// mutex.
+type portListeners map[Port][]EndpointUpdateListener
+
+// add subscribes the listener to the given port.
+func (pl portListeners) add(port Port, listener EndpointUpdateListener) {
+	pl[port] = append(pl[port], listener)
+}
+
+// remove unsubscribes the listener from the given port and returns the number
+// of listeners remaining across all ports.
+func (pl portListeners) remove(port Port, listener EndpointUpdateListener) int {
+	listeners := pl[port]
+	for i, l := range listeners {
+		if l == listener {
+			n := len(listeners)
+			listeners[i] = listeners[n-1]
+			listeners[n-1] = nil
+			listeners = listeners[:n-1]
+			break
+		}
+	}
+	if len(listeners) == 0 {
+		delete(pl, port)
+	} else {
+		pl[port] = listeners
+	}
+
+	count := 0
+	for _, l := range pl {
+		count += len(l)
+	}
+	return count
+}
+
+// each calls f with every listener and the port it subscribed to.
+func (pl portListeners) each(f func(Port, EndpointUpdateListener)) {
+	for port, listeners := range pl {
+		for _, listener := range listeners {
+			f(port, listener)
+		}
+	}
+}
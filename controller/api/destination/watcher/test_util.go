@@ -1,7 +1,9 @@
 package watcher
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"reflect"
 	"testing"
 
@@ -26,6 +28,19 @@ func (bpl *BufferingProfileListener) Update(profile *sp.ServiceProfile) {
 	bpl.Profiles = append(bpl.Profiles, profile)
 }
 
+// MockResolver implements Resolver by resolving host names from a static
+// table.  Useful for unit tests.
+type MockResolver map[string][]string
+
+// LookupHost returns the addresses of the host in the table.
+func (m MockResolver) LookupHost(_ context.Context, host string) ([]string, error) {
+	addrs, ok := m[host]
+	if !ok {
+		return nil, fmt.Errorf("no such host %s", host)
+	}
+	return addrs, nil
+}
+
 func testCompare(t *testing.T, expected interface{}, actual interface{}) {
 	if !reflect.DeepEqual(expected, actual) {
 		expectedBytes, _ := json.Marshal(expected)
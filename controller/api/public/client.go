@@ -79,6 +79,18 @@ func (c *grpcOverHTTPClient) Config(ctx context.Context, req *pb.Empty, _ ...grp
 	return &msg, err
 }
 
+func (c *grpcOverHTTPClient) IdentityDenylist(ctx context.Context, req *pb.Empty, _ ...grpc.CallOption) (*pb.IdentityDenylistResponse, error) {
+	var msg pb.IdentityDenylistResponse
+	err := c.apiRequest(ctx, "IdentityDenylist", req, &msg)
+	return &msg, err
+}
+
+func (c *grpcOverHTTPClient) SLOStatus(ctx context.Context, req *pb.SLOStatusRequest, _ ...grpc.CallOption) (*pb.SLOStatusResponse, error) {
+	var msg pb.SLOStatusResponse
+	err := c.apiRequest(ctx, "SLOStatus", req, &msg)
+	return &msg, err
+}
+
 func (c *grpcOverHTTPClient) ListPods(ctx context.Context, req *pb.ListPodsRequest, _ ...grpc.CallOption) (*pb.ListPodsResponse, error) {
 	var msg pb.ListPodsResponse
 	err := c.apiRequest(ctx, "ListPods", req, &msg)
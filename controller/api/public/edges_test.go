@@ -52,7 +52,7 @@ func genOutboundPromSample(resourceNamespace, resourceName, resourceNameDst, res
 
 func testEdges(t *testing.T, expectations []edgesExpected) {
 	for _, exp := range expectations {
-		mockProm, fakeGrpcServer, err := newMockGrpcServer(exp.expectedStatRPC)
+		metrics, fakeGrpcServer, err := newMockGrpcServer(exp.expectedStatRPC)
 		if err != nil {
 			t.Fatalf("Error creating mock grpc server: %s", err)
 		}
@@ -62,7 +62,7 @@ func testEdges(t *testing.T, expectations []edgesExpected) {
 			t.Fatalf("Expected error: %s, Got: %s", exp.err, err)
 		}
 
-		err = exp.verifyPromQueries(mockProm)
+		err = exp.verifyPromQueries(metrics)
 		if err != nil {
 			t.Fatal(err)
 		}
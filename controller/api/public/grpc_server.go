@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"runtime"
+	"sort"
 	"time"
 
 	"github.com/golang/protobuf/ptypes/duration"
@@ -15,14 +16,16 @@ import (
 	pb "github.com/linkerd/linkerd2/controller/gen/public"
 	"github.com/linkerd/linkerd2/controller/k8s"
 	"github.com/linkerd/linkerd2/pkg/config"
+	"github.com/linkerd/linkerd2/pkg/identity"
 	pkgK8s "github.com/linkerd/linkerd2/pkg/k8s"
 	"github.com/linkerd/linkerd2/pkg/prometheus"
 	"github.com/linkerd/linkerd2/pkg/version"
-	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 )
 
@@ -33,7 +36,7 @@ type APIServer interface {
 }
 
 type grpcServer struct {
-	prometheusAPI         promv1.API
+	metrics               MetricsSource
 	destinationClient     destinationPb.DestinationClient
 	k8sAPI                *k8s.API
 	controllerNamespace   string
@@ -57,7 +60,7 @@ const (
 )
 
 func newGrpcServer(
-	promAPI promv1.API,
+	metrics MetricsSource,
 	destinationClient destinationPb.DestinationClient,
 	k8sAPI *k8s.API,
 	controllerNamespace string,
@@ -66,7 +69,7 @@ func newGrpcServer(
 ) *grpcServer {
 
 	grpcServer := &grpcServer{
-		prometheusAPI:         promAPI,
+		metrics:               metrics,
 		destinationClient:     destinationClient,
 		k8sAPI:                k8sAPI,
 		controllerNamespace:   controllerNamespace,
@@ -237,6 +240,24 @@ func (s *grpcServer) Config(ctx context.Context, req *pb.Empty) (*configPb.All,
 	return &configPb.All{Global: global, Proxy: proxy}, nil
 }
 
+func (s *grpcServer) IdentityDenylist(ctx context.Context, req *pb.Empty) (*pb.IdentityDenylistResponse, error) {
+	cm, err := s.k8sAPI.Client.CoreV1().ConfigMaps(s.controllerNamespace).Get(pkgK8s.IdentityDenylistConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return &pb.IdentityDenylistResponse{Identities: []string{}}, nil
+		}
+		return nil, fmt.Errorf("error retrieving identity denylist - %s", err)
+	}
+
+	denied := identity.ParseDenylist(cm.Data[pkgK8s.IdentityDenylistKey])
+	identities := make([]string, 0, len(denied))
+	for id := range denied {
+		identities = append(identities, id)
+	}
+	sort.Strings(identities)
+	return &pb.IdentityDenylistResponse{Identities: identities}, nil
+}
+
 func (s *grpcServer) Tap(req *pb.TapRequest, stream pb.Api_TapServer) error {
 	return status.Error(codes.Unimplemented, "Tap is deprecated in public API, use tap APIServer")
 }
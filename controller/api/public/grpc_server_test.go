@@ -394,10 +394,10 @@ status:
 				t.Fatalf("NewFakeAPI returned an error: %s", err)
 			}
 
-			mProm := MockProm{Res: exp.promRes}
+			metrics := newMockMetrics(exp.promRes)
 
 			fakeGrpcServer := newGrpcServer(
-				&mProm,
+				metrics,
 				nil,
 				k8sAPI,
 				"linkerd",
@@ -417,7 +417,7 @@ status:
 			}
 
 			if exp.promReqNamespace != "" {
-				err := verifyPromQueries(&mProm, exp.promReqNamespace)
+				err := verifyPromQueries(metrics, exp.promReqNamespace)
 				if err != nil {
 					t.Fatalf("Expected prometheus query with namespace: %s, Got error: %s", exp.promReqNamespace, err)
 				}
@@ -427,15 +427,15 @@ status:
 }
 
 // TODO: consider refactoring with expectedStatRPC.verifyPromQueries
-func verifyPromQueries(mProm *MockProm, namespace string) error {
+func verifyPromQueries(metrics *InMemoryMetrics, namespace string) error {
 	namespaceSelector := fmt.Sprintf("namespace=\"%s\"", namespace)
-	for _, element := range mProm.QueriesExecuted {
+	for _, element := range metrics.QueriesExecuted {
 		if strings.Contains(element, namespaceSelector) {
 			return nil
 		}
 	}
 	return fmt.Errorf("Prometheus queries incorrect. \nExpected query containing:\n%s \nGot:\n%+v",
-		namespaceSelector, mProm.QueriesExecuted)
+		namespaceSelector, metrics.QueriesExecuted)
 }
 
 func listServiceResponsesEqual(a pb.ListServicesResponse, b pb.ListServicesResponse) bool {
@@ -499,7 +499,7 @@ metadata:
 			}
 
 			fakeGrpcServer := newGrpcServer(
-				&MockProm{},
+				NewInMemoryMetrics(),
 				nil,
 				k8sAPI,
 				"linkerd",
@@ -529,7 +529,7 @@ func TestConfig(t *testing.T) {
 		}
 
 		fakeGrpcServer := newGrpcServer(
-			&MockProm{},
+			NewInMemoryMetrics(),
 			nil,
 			k8sAPI,
 			"linkerd",
@@ -561,3 +561,61 @@ func TestConfig(t *testing.T) {
 		}
 	})
 }
+
+func TestIdentityDenylist(t *testing.T) {
+	for _, tt := range []struct {
+		description string
+		k8sConfigs  []string
+		expected    []string
+	}{
+		{
+			description: "Denied identities are returned",
+			k8sConfigs: []string{`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: linkerd-identity-denylist
+  namespace: linkerd
+data:
+  identities: |
+    web.emojivoto.serviceaccount.identity.linkerd.cluster.local
+    emoji.emojivoto.serviceaccount.identity.linkerd.cluster.local
+`,
+			},
+			expected: []string{
+				"emoji.emojivoto.serviceaccount.identity.linkerd.cluster.local",
+				"web.emojivoto.serviceaccount.identity.linkerd.cluster.local",
+			},
+		},
+		{
+			description: "No identities are returned without a denylist",
+			k8sConfigs:  []string{},
+			expected:    []string{},
+		},
+	} {
+		tt := tt // pin
+		t.Run(tt.description, func(t *testing.T) {
+			k8sAPI, err := k8s.NewFakeAPI(tt.k8sConfigs...)
+			if err != nil {
+				t.Fatalf("NewFakeAPI returned an error: %s", err)
+			}
+
+			fakeGrpcServer := newGrpcServer(
+				NewInMemoryMetrics(),
+				nil,
+				k8sAPI,
+				"linkerd",
+				"mycluster.local",
+				[]string{},
+			)
+
+			rsp, err := fakeGrpcServer.IdentityDenylist(context.Background(), &pb.Empty{})
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(rsp.GetIdentities(), tt.expected) {
+				t.Fatalf("Expected identities %v, got %v", tt.expected, rsp.GetIdentities())
+			}
+		})
+	}
+}
@@ -12,8 +12,6 @@ import (
 	"github.com/linkerd/linkerd2/controller/k8s"
 	"github.com/linkerd/linkerd2/pkg/prometheus"
 	"github.com/linkerd/linkerd2/pkg/protohttp"
-	promApi "github.com/prometheus/client_golang/api"
-	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/metadata"
 )
@@ -28,6 +26,8 @@ var (
 	edgesPath        = fullURLPathFor("Edges")
 	destGetPath      = fullURLPathFor("DestinationGet")
 	configPath       = fullURLPathFor("Config")
+	denylistPath     = fullURLPathFor("IdentityDenylist")
+	sloStatusPath    = fullURLPathFor("SLOStatus")
 )
 
 type handler struct {
@@ -64,6 +64,10 @@ func (h *handler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 		h.handleDestGet(w, req)
 	case configPath:
 		h.handleConfig(w, req)
+	case denylistPath:
+		h.handleIdentityDenylist(w, req)
+	case sloStatusPath:
+		h.handleSLOStatus(w, req)
 	default:
 		http.NotFound(w, req)
 	}
@@ -261,6 +265,48 @@ func (h *handler) handleConfig(w http.ResponseWriter, req *http.Request) {
 	}
 }
 
+func (h *handler) handleIdentityDenylist(w http.ResponseWriter, req *http.Request) {
+	var protoRequest pb.Empty
+	err := protohttp.HTTPRequestToProto(req, &protoRequest)
+	if err != nil {
+		protohttp.WriteErrorToHTTPResponse(w, err)
+		return
+	}
+
+	rsp, err := h.grpcServer.IdentityDenylist(req.Context(), &protoRequest)
+	if err != nil {
+		protohttp.WriteErrorToHTTPResponse(w, err)
+		return
+	}
+
+	err = protohttp.WriteProtoToHTTPResponse(w, rsp)
+	if err != nil {
+		protohttp.WriteErrorToHTTPResponse(w, err)
+		return
+	}
+}
+
+func (h *handler) handleSLOStatus(w http.ResponseWriter, req *http.Request) {
+	var protoRequest pb.SLOStatusRequest
+	err := protohttp.HTTPRequestToProto(req, &protoRequest)
+	if err != nil {
+		protohttp.WriteErrorToHTTPResponse(w, err)
+		return
+	}
+
+	rsp, err := h.grpcServer.SLOStatus(req.Context(), &protoRequest)
+	if err != nil {
+		protohttp.WriteErrorToHTTPResponse(w, err)
+		return
+	}
+
+	err = protohttp.WriteProtoToHTTPResponse(w, rsp)
+	if err != nil {
+		protohttp.WriteErrorToHTTPResponse(w, err)
+		return
+	}
+}
+
 type streamServer struct {
 	w   protohttp.FlushableResponseWriter
 	req *http.Request
@@ -300,7 +346,7 @@ func fullURLPathFor(method string) string {
 // NewServer creates a Public API HTTP server.
 func NewServer(
 	addr string,
-	prometheusClient promApi.Client,
+	metrics MetricsSource,
 	destinationClient destinationPb.DestinationClient,
 	k8sAPI *k8s.API,
 	controllerNamespace string,
@@ -309,7 +355,7 @@ func NewServer(
 ) *http.Server {
 	baseHandler := &handler{
 		grpcServer: newGrpcServer(
-			promv1.NewAPI(prometheusClient),
+			metrics,
 			destinationClient,
 			k8sAPI,
 			controllerNamespace,
@@ -66,6 +66,16 @@ func (m *mockGrpcServer) Config(ctx context.Context, req *pb.Empty) (*configPb.A
 	return m.ResponseToReturn.(*configPb.All), m.ErrorToReturn
 }
 
+func (m *mockGrpcServer) IdentityDenylist(ctx context.Context, req *pb.Empty) (*pb.IdentityDenylistResponse, error) {
+	m.LastRequestReceived = req
+	return m.ResponseToReturn.(*pb.IdentityDenylistResponse), m.ErrorToReturn
+}
+
+func (m *mockGrpcServer) SLOStatus(ctx context.Context, req *pb.SLOStatusRequest) (*pb.SLOStatusResponse, error) {
+	m.LastRequestReceived = req
+	return m.ResponseToReturn.(*pb.SLOStatusResponse), m.ErrorToReturn
+}
+
 func (m *mockGrpcServer) Tap(req *pb.TapRequest, tapServer pb.Api_TapServer) error {
 	m.LastRequestReceived = req
 	if m.ErrorToReturn != nil {
@@ -0,0 +1,197 @@
+package public
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	promApi "github.com/prometheus/client_golang/api"
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	log "github.com/sirupsen/logrus"
+)
+
+// MetricsSource is the backend the public API queries the proxies' metrics
+// from. Queries are written in PromQL, so that any backend evaluating it, such
+// as Prometheus, Thanos or Cortex, can serve them.
+type MetricsSource interface {
+	// Query evaluates a query at the current time.
+	Query(ctx context.Context, query string) (model.Vector, error)
+
+	// QueryRange evaluates a query at every step of a time range.
+	QueryRange(ctx context.Context, query string, timeRange promv1.Range) (model.Matrix, error)
+}
+
+//
+// Prometheus
+//
+
+type prometheusSource struct {
+	api promv1.API
+}
+
+// NewPrometheusSource returns a MetricsSource querying the Prometheus HTTP
+// API, as served by Prometheus itself, Thanos Query or Cortex.
+func NewPrometheusSource(api promv1.API) MetricsSource {
+	return &prometheusSource{api: api}
+}
+
+func (p *prometheusSource) Query(ctx context.Context, query string) (model.Vector, error) {
+	// single data point (aka summary) query
+	res, warn, err := p.api.Query(ctx, query, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+	if warn != nil {
+		log.Warnf("%v", warn)
+	}
+
+	if res.Type() != model.ValVector {
+		return nil, fmt.Errorf("Unexpected query result type (expected Vector): %s", res.Type())
+	}
+	return res.(model.Vector), nil
+}
+
+func (p *prometheusSource) QueryRange(ctx context.Context, query string, timeRange promv1.Range) (model.Matrix, error) {
+	// time series query, evaluated at every step of the range
+	res, warn, err := p.api.QueryRange(ctx, query, timeRange)
+	if err != nil {
+		return nil, err
+	}
+	if warn != nil {
+		log.Warnf("%v", warn)
+	}
+
+	if res.Type() != model.ValMatrix {
+		return nil, fmt.Errorf("Unexpected query result type (expected Matrix): %s", res.Type())
+	}
+	return res.(model.Matrix), nil
+}
+
+// PrometheusClientConfig configures the HTTP client of the Prometheus API.
+type PrometheusClientConfig struct {
+	Address string
+
+	// Headers are added to every request, e.g. the X-Scope-OrgID header
+	// selecting the tenant of a multi-tenant Cortex.
+	Headers http.Header
+
+	// BearerTokenFile is the path of a file holding a token to authenticate
+	// with, read again on every request so that the token can be rotated.
+	BearerTokenFile string
+
+	// Username and Password authenticate with HTTP basic auth, if Username is
+	// set.
+	Username string
+	Password string
+}
+
+// NewPrometheusClient returns a client of the Prometheus API described by
+// config.
+func NewPrometheusClient(config PrometheusClientConfig) (promApi.Client, error) {
+	if config.BearerTokenFile != "" && config.Username != "" {
+		return nil, fmt.Errorf("at most one of bearer token and basic auth can be used to authenticate with Prometheus")
+	}
+
+	return promApi.NewClient(promApi.Config{
+		Address: config.Address,
+		RoundTripper: &authRoundTripper{
+			headers:         config.Headers,
+			bearerTokenFile: config.BearerTokenFile,
+			username:        config.Username,
+			password:        config.Password,
+			next:            promApi.DefaultRoundTripper,
+		},
+	})
+}
+
+// authRoundTripper adds headers and credentials to the requests of next.
+type authRoundTripper struct {
+	headers         http.Header
+	bearerTokenFile string
+	username        string
+	password        string
+	next            http.RoundTripper
+}
+
+func (rt *authRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	// RoundTrippers must not modify the request they are given
+	req = req.Clone(req.Context())
+	for name, values := range rt.headers {
+		req.Header[name] = values
+	}
+
+	if rt.bearerTokenFile != "" {
+		token, err := ioutil.ReadFile(rt.bearerTokenFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the Prometheus bearer token: %s", err)
+		}
+		req.Header.Set("Authorization", "Bearer "+strings.TrimSpace(string(token)))
+	} else if rt.username != "" {
+		credentials := base64.StdEncoding.EncodeToString([]byte(rt.username + ":" + rt.password))
+		req.Header.Set("Authorization", "Basic "+credentials)
+	}
+
+	return rt.next.RoundTrip(req)
+}
+
+//
+// In memory
+//
+
+// InMemoryMetrics is a MetricsSource serving canned results by query, which
+// allows running the public API without a metrics backend, e.g. in tests.
+// Queries without a result return DefaultVector or DefaultMatrix, which are
+// empty unless set.
+type InMemoryMetrics struct {
+	Vectors  map[string]model.Vector
+	Matrices map[string]model.Matrix
+
+	DefaultVector model.Vector
+	DefaultMatrix model.Matrix
+
+	QueriesExecuted []string // expose the queries received, to test query generation
+	mu              sync.Mutex
+}
+
+// NewInMemoryMetrics returns an InMemoryMetrics without results.
+func NewInMemoryMetrics() *InMemoryMetrics {
+	return &InMemoryMetrics{
+		Vectors:  make(map[string]model.Vector),
+		Matrices: make(map[string]model.Matrix),
+	}
+}
+
+// Query returns the vector of the query, or DefaultVector.
+func (m *InMemoryMetrics) Query(ctx context.Context, query string) (model.Vector, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.QueriesExecuted = append(m.QueriesExecuted, query)
+	if vec, ok := m.Vectors[query]; ok {
+		return vec, nil
+	}
+	if m.DefaultVector != nil {
+		return m.DefaultVector, nil
+	}
+	return model.Vector{}, nil
+}
+
+// QueryRange returns the matrix of the query, or DefaultMatrix, whatever the
+// time range.
+func (m *InMemoryMetrics) QueryRange(ctx context.Context, query string, timeRange promv1.Range) (model.Matrix, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.QueriesExecuted = append(m.QueriesExecuted, query)
+	if matrix, ok := m.Matrices[query]; ok {
+		return matrix, nil
+	}
+	if m.DefaultMatrix != nil {
+		return m.DefaultMatrix, nil
+	}
+	return model.Matrix{}, nil
+}
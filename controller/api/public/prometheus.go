@@ -5,11 +5,12 @@ import (
 	"fmt"
 	"math"
 	"sort"
+	"strconv"
 	"strings"
-	"time"
 
 	pb "github.com/linkerd/linkerd2/controller/gen/public"
 	"github.com/linkerd/linkerd2/pkg/k8s"
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
 	"github.com/prometheus/common/model"
 	log "github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
@@ -17,9 +18,10 @@ import (
 
 type promType string
 type promResult struct {
-	prom promType
-	vec  model.Vector
-	err  error
+	prom   promType
+	vec    model.Vector
+	matrix model.Matrix
+	err    error
 }
 
 const (
@@ -32,14 +34,28 @@ const (
 	promLatencyP95     = promType("0.95")
 	promLatencyP99     = promType("0.99")
 
+	promLatencyHistogram = promType("QUERY_LATENCY_HISTOGRAM")
+
+	// maxLatencyQuantiles bounds the latency quantiles a request can ask for,
+	// as each of them costs a query
+	maxLatencyQuantiles = 10
+
 	namespaceLabel    = model.LabelName("namespace")
 	dstNamespaceLabel = model.LabelName("dst_namespace")
 )
 
+// defaultLatencyQuantiles are always queried, for the latency_ms_p50, p95 and
+// p99 fields of BasicStats.
+var defaultLatencyQuantiles = []promType{promLatencyP50, promLatencyP95, promLatencyP99}
+
 func extractSampleValue(sample *model.Sample) uint64 {
+	return roundSampleValue(sample.Value)
+}
+
+func roundSampleValue(sampleValue model.SampleValue) uint64 {
 	value := uint64(0)
-	if !math.IsNaN(float64(sample.Value)) {
-		value = uint64(math.Round(float64(sample.Value)))
+	if !math.IsNaN(float64(sampleValue)) {
+		value = uint64(math.Round(float64(sampleValue)))
 	}
 	return value
 }
@@ -51,24 +67,31 @@ func (s *grpcServer) queryProm(ctx context.Context, query string) (model.Vector,
 	defer span.End()
 	span.AddAttributes(trace.StringAttribute("queryString", query))
 
-	// single data point (aka summary) query
-	res, warn, err := s.prometheusAPI.Query(ctx, query, time.Time{})
+	res, err := s.metrics.Query(ctx, query)
 	if err != nil {
 		log.Errorf("Query(%+v) failed with: %+v", query, err)
 		return nil, err
 	}
-	if warn != nil {
-		log.Warnf("%v", warn)
-	}
 	log.Debugf("Query response:\n\t%+v", res)
 
-	if res.Type() != model.ValVector {
-		err = fmt.Errorf("Unexpected query result type (expected Vector): %s", res.Type())
-		log.Error(err)
+	return res, nil
+}
+
+func (s *grpcServer) queryPromRange(ctx context.Context, query string, timeRange promv1.Range) (model.Matrix, error) {
+	log.Debugf("Range query request:\n\t%+v (%s to %s, step %s)", query, timeRange.Start, timeRange.End, timeRange.Step)
+
+	_, span := trace.StartSpan(ctx, "query.prometheus.range")
+	defer span.End()
+	span.AddAttributes(trace.StringAttribute("queryString", query))
+
+	res, err := s.metrics.QueryRange(ctx, query, timeRange)
+	if err != nil {
+		log.Errorf("QueryRange(%+v) failed with: %+v", query, err)
 		return nil, err
 	}
+	log.Debugf("Range query response:\n\t%+v", res)
 
-	return res.(model.Vector), nil
+	return res, nil
 }
 
 // add filtering by resource type
@@ -170,47 +193,57 @@ func promResourceType(resource *pb.Resource) model.LabelName {
 	return model.LabelName(l5dLabel)
 }
 
-func (s *grpcServer) getPrometheusMetrics(ctx context.Context, requestQueryTemplates map[promType]string, latencyQueryTemplate, labels, timeWindow, groupBy string) ([]promResult, error) {
-	resultChan := make(chan promResult)
+func (s *grpcServer) getPrometheusMetrics(ctx context.Context, requestQueryTemplates map[promType]string, latencyQueryTemplate string, quantiles []promType, labels, timeWindow, groupBy string) ([]promResult, error) {
+	queries := buildPromQueries(requestQueryTemplates, latencyQueryTemplate, quantiles, labels, timeWindow, groupBy)
+	return runPromQueries(queries, func(typ promType, query string) promResult {
+		vec, err := s.queryProm(ctx, query)
+		return promResult{prom: typ, vec: vec, err: err}
+	})
+}
+
+// getPrometheusRangeMetrics runs the same queries as getPrometheusMetrics,
+// evaluated at every step of timeRange.
+func (s *grpcServer) getPrometheusRangeMetrics(ctx context.Context, requestQueryTemplates map[promType]string, latencyQueryTemplate string, quantiles []promType, labels, timeWindow, groupBy string, timeRange promv1.Range) ([]promResult, error) {
+	queries := buildPromQueries(requestQueryTemplates, latencyQueryTemplate, quantiles, labels, timeWindow, groupBy)
+	return runPromQueries(queries, func(typ promType, query string) promResult {
+		matrix, err := s.queryPromRange(ctx, query, timeRange)
+		return promResult{prom: typ, matrix: matrix, err: err}
+	})
+}
 
-	// kick off asynchronous queries: request count queries + 3 latency queries
+// buildPromQueries renders the request count queries, and a latency query for
+// each quantile.
+func buildPromQueries(requestQueryTemplates map[promType]string, latencyQueryTemplate string, quantiles []promType, labels, timeWindow, groupBy string) map[promType]string {
+	queries := make(map[promType]string)
 	for pt, requestQueryTemplate := range requestQueryTemplates {
-		var query string
 		if pt == promTCPConnections {
-			query = fmt.Sprintf(requestQueryTemplate, labels, groupBy)
+			queries[pt] = fmt.Sprintf(requestQueryTemplate, labels, groupBy)
 		} else {
-			query = fmt.Sprintf(requestQueryTemplate, labels, timeWindow, groupBy)
+			queries[pt] = fmt.Sprintf(requestQueryTemplate, labels, timeWindow, groupBy)
 		}
+	}
 
-		go func(typ promType, promQuery string) {
-			resultVector, err := s.queryProm(ctx, promQuery)
-			resultChan <- promResult{
-				prom: typ,
-				vec:  resultVector,
-				err:  err,
-			}
-		}(pt, query)
+	for _, quantile := range quantiles {
+		queries[quantile] = fmt.Sprintf(latencyQueryTemplate, quantile, labels, timeWindow, groupBy)
 	}
+	return queries
+}
 
-	quantiles := []promType{promLatencyP50, promLatencyP95, promLatencyP99}
+// runPromQueries runs the queries concurrently with run.
+func runPromQueries(queries map[promType]string, run func(promType, string) promResult) ([]promResult, error) {
+	resultChan := make(chan promResult)
 
-	for _, quantile := range quantiles {
-		go func(quantile promType) {
-			latencyQuery := fmt.Sprintf(latencyQueryTemplate, quantile, labels, timeWindow, groupBy)
-			latencyResult, err := s.queryProm(ctx, latencyQuery)
-
-			resultChan <- promResult{
-				prom: quantile,
-				vec:  latencyResult,
-				err:  err,
-			}
-		}(quantile)
+	// kick off asynchronous queries: request count queries + latency queries
+	for pt, query := range queries {
+		go func(typ promType, promQuery string) {
+			resultChan <- run(typ, promQuery)
+		}(pt, query)
 	}
 
 	// process results, receive one message per prometheus query type
 	var err error
 	results := []promResult{}
-	for i := 0; i < len(quantiles)+len(requestQueryTemplates); i++ {
+	for i := 0; i < len(queries); i++ {
 		result := <-resultChan
 		if result.err != nil {
 			log.Errorf("queryProm failed with: %s", result.err)
@@ -225,3 +258,79 @@ func (s *grpcServer) getPrometheusMetrics(ctx context.Context, requestQueryTempl
 
 	return results, nil
 }
+
+// latencyQuantiles returns the quantiles to query for a request asking for
+// the requested ones on top of the default ones.
+func latencyQuantiles(requested []float64) []promType {
+	quantiles := append([]promType{}, defaultLatencyQuantiles...)
+	for _, q := range requested {
+		quantile := quantilePromType(q)
+		found := false
+		for _, existing := range quantiles {
+			found = found || existing == quantile
+		}
+		if !found {
+			quantiles = append(quantiles, quantile)
+		}
+	}
+	return quantiles
+}
+
+func quantilePromType(quantile float64) promType {
+	return promType(strconv.FormatFloat(quantile, 'f', -1, 64))
+}
+
+func validateLatencyQuantiles(quantiles []float64) error {
+	if len(quantiles) > maxLatencyQuantiles {
+		return fmt.Errorf("at most %d latency quantiles can be requested", maxLatencyQuantiles)
+	}
+	for _, q := range quantiles {
+		if !(q > 0 && q < 1) {
+			return fmt.Errorf("latency quantile %v needs to be between 0 and 1", q)
+		}
+	}
+	return nil
+}
+
+// addLatencyValue records the value of a latency quantile or latency bucket
+// sample in stats, adding the quantile to stats.LatencyQuantiles if it's one
+// of the requested ones. It returns false if prom isn't a latency query.
+func addLatencyValue(stats *pb.BasicStats, prom promType, metric model.Metric, value uint64, requested []float64) bool {
+	if prom == promLatencyHistogram {
+		le, err := strconv.ParseFloat(string(metric[model.BucketLabel]), 64)
+		if err != nil {
+			log.Warnf("Invalid latency bucket bound: %s", metric[model.BucketLabel])
+			return true
+		}
+		i := sort.Search(len(stats.LatencyHistogram), func(i int) bool { return stats.LatencyHistogram[i].LeMs >= le })
+		stats.LatencyHistogram = append(stats.LatencyHistogram, nil)
+		copy(stats.LatencyHistogram[i+1:], stats.LatencyHistogram[i:])
+		stats.LatencyHistogram[i] = &pb.LatencyBucket{LeMs: le, Count: value}
+		return true
+	}
+
+	quantile, err := strconv.ParseFloat(string(prom), 64)
+	if err != nil {
+		return false
+	}
+
+	switch prom {
+	case promLatencyP50:
+		stats.LatencyMsP50 = value
+	case promLatencyP95:
+		stats.LatencyMsP95 = value
+	case promLatencyP99:
+		stats.LatencyMsP99 = value
+	}
+
+	for _, q := range requested {
+		if quantilePromType(q) == prom {
+			i := sort.Search(len(stats.LatencyQuantiles), func(i int) bool { return stats.LatencyQuantiles[i].Quantile >= quantile })
+			stats.LatencyQuantiles = append(stats.LatencyQuantiles, nil)
+			copy(stats.LatencyQuantiles[i+1:], stats.LatencyQuantiles[i:])
+			stats.LatencyQuantiles[i] = &pb.LatencyQuantile{Quantile: quantile, LatencyMs: value}
+			break
+		}
+	}
+	return true
+}
@@ -0,0 +1,177 @@
+package public
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	sp "github.com/linkerd/linkerd2/controller/gen/apis/serviceprofile/v1alpha2"
+	pb "github.com/linkerd/linkerd2/controller/gen/public"
+	"github.com/linkerd/linkerd2/pkg/slo"
+	"github.com/prometheus/common/model"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// sloQuery is the error ratio query of an indicator over a window.
+type sloQuery struct {
+	indicator int
+	window    model.Duration
+}
+
+func (s *grpcServer) SLOStatus(ctx context.Context, req *pb.SLOStatusRequest) (*pb.SLOStatusResponse, error) {
+	profiles, err := s.getProfilesWithObjectives(req)
+	if err != nil {
+		return sloStatusError(err.Error()), nil
+	}
+
+	indicators := []slo.Indicator{}
+	for _, p := range profiles {
+		pi, err := slo.Indicators(p)
+		if err != nil {
+			return sloStatusError(fmt.Sprintf("ServiceProfile \"%s\" in namespace \"%s\" has an invalid objective: %s", p.Name, p.Namespace, err)), nil
+		}
+		for _, i := range pi {
+			if req.GetObjective() == "" || i.Objective == req.GetObjective() {
+				indicators = append(indicators, i)
+			}
+		}
+	}
+
+	// every indicator is queried over its window, for its error budget, and
+	// over the windows of its burn rate alerts
+	queries := make(map[promType]string)
+	sloQueries := make(map[promType]sloQuery)
+	for idx, i := range indicators {
+		windows := append([]model.Duration{i.Window}, slo.BurnRateWindows(i.Window)...)
+		for _, w := range windows {
+			key := promType(fmt.Sprintf("%d/%s", idx, w))
+			queries[key] = i.ErrorRatioQuery(w)
+			sloQueries[key] = sloQuery{idx, w}
+		}
+	}
+
+	results, err := runPromQueries(queries, func(typ promType, query string) promResult {
+		vec, err := s.queryProm(ctx, query)
+		return promResult{prom: typ, vec: vec, err: err}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	errorRatios := make(map[sloQuery]float64)
+	for _, result := range results {
+		if len(result.vec) == 0 || math.IsNaN(float64(result.vec[0].Value)) {
+			continue
+		}
+		errorRatios[sloQueries[result.prom]] = float64(result.vec[0].Value)
+	}
+
+	statuses := []*pb.SLOStatus{}
+	for idx, i := range indicators {
+		statuses = append(statuses, buildSLOStatus(i, func(w model.Duration) (float64, bool) {
+			ratio, ok := errorRatios[sloQuery{idx, w}]
+			return ratio, ok
+		}))
+	}
+
+	return &pb.SLOStatusResponse{
+		Response: &pb.SLOStatusResponse_Ok_{
+			Ok: &pb.SLOStatusResponse_Ok{
+				Statuses: statuses,
+			},
+		},
+	}, nil
+}
+
+// getProfilesWithObjectives returns the ServiceProfiles selected by req which
+// declare objectives, sorted by namespace and name.
+func (s *grpcServer) getProfilesWithObjectives(req *pb.SLOStatusRequest) ([]*sp.ServiceProfile, error) {
+	if req.GetService() != "" && req.GetNamespace() == "" {
+		return nil, fmt.Errorf("a namespace is required to select a service")
+	}
+
+	var profiles []*sp.ServiceProfile
+	var err error
+	if req.GetNamespace() == "" {
+		profiles, err = s.k8sAPI.SP().Lister().List(labels.Everything())
+	} else if req.GetService() == "" {
+		profiles, err = s.k8sAPI.SP().Lister().ServiceProfiles(req.GetNamespace()).List(labels.Everything())
+	} else {
+		var p *sp.ServiceProfile
+		p, err = s.k8sAPI.SP().Lister().ServiceProfiles(req.GetNamespace()).Get(req.GetService())
+		profiles = []*sp.ServiceProfile{p}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	withObjectives := []*sp.ServiceProfile{}
+	for _, p := range profiles {
+		if len(p.Spec.Objectives) > 0 {
+			withObjectives = append(withObjectives, p)
+		}
+	}
+
+	sort.Slice(withObjectives, func(i, j int) bool {
+		a, b := withObjectives[i], withObjectives[j]
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+	return withObjectives, nil
+}
+
+// buildSLOStatus computes the status of an indicator from its error ratios
+// over its window and the windows of its burn rate alerts.
+func buildSLOStatus(i slo.Indicator, errorRatio func(model.Duration) (float64, bool)) *pb.SLOStatus {
+	budget := 1 - i.Target
+	status := &pb.SLOStatus{
+		Namespace:          i.Namespace,
+		Service:            i.Service,
+		Objective:          i.Objective,
+		Route:              i.Route,
+		Window:             i.Window.String(),
+		Indicator:          i.Kind,
+		Target:             i.Target,
+		LatencyThresholdMs: i.LatencyThresholdMs,
+	}
+
+	if ratio, ok := errorRatio(i.Window); ok {
+		status.HasData = true
+		status.Actual = 1 - ratio
+		status.ErrorBudgetRemaining = 1 - ratio/budget
+	}
+
+	for _, w := range slo.BurnRateWindows(i.Window) {
+		burnRate := &pb.SLOStatus_BurnRate{Window: w.String()}
+		if ratio, ok := errorRatio(w); ok {
+			burnRate.HasData = true
+			burnRate.Rate = ratio / budget
+		}
+		status.BurnRates = append(status.BurnRates, burnRate)
+	}
+
+	for _, alert := range slo.BurnRateAlerts(i.Window) {
+		long, longOk := errorRatio(alert.LongWindow)
+		short, shortOk := errorRatio(alert.ShortWindow)
+		status.Alerts = append(status.Alerts, &pb.SLOStatus_BurnRateAlert{
+			LongWindow:  alert.LongWindow.String(),
+			ShortWindow: alert.ShortWindow.String(),
+			Threshold:   alert.Threshold,
+			Severity:    alert.Severity,
+			Firing:      longOk && shortOk && long/budget > alert.Threshold && short/budget > alert.Threshold,
+		})
+	}
+
+	return status
+}
+
+func sloStatusError(msg string) *pb.SLOStatusResponse {
+	return &pb.SLOStatusResponse{
+		Response: &pb.SLOStatusResponse_Error{
+			Error: msg,
+		},
+	}
+}
@@ -0,0 +1,176 @@
+package public
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	pb "github.com/linkerd/linkerd2/controller/gen/public"
+	"github.com/linkerd/linkerd2/pkg/slo"
+	"github.com/prometheus/common/model"
+)
+
+var booksProfileWithObjectivesConfig = `apiVersion: linkerd.io/v1alpha2
+kind: ServiceProfile
+metadata:
+  name: books.default.svc.cluster.local
+  namespace: default
+spec:
+  routes:
+  - name: GET /books
+    condition:
+      method: GET
+      pathRegex: /books
+  objectives:
+  - name: availability
+    window: 1d
+    successRate: 0.999
+  - name: books-latency
+    route: GET /books
+    window: 1h
+    latency:
+      threshold: 300ms
+      target: 0.99`
+
+var authorsProfileConfig = `apiVersion: linkerd.io/v1alpha2
+kind: ServiceProfile
+metadata:
+  name: authors.default.svc.cluster.local
+  namespace: default
+spec:
+  routes:
+  - name: GET /authors
+    condition:
+      method: GET
+      pathRegex: /authors`
+
+func errorRatioVector(ratio float64) model.Vector {
+	return model.Vector{&model.Sample{Metric: model.Metric{}, Value: model.SampleValue(ratio)}}
+}
+
+func approxEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestSLOStatus(t *testing.T) {
+	t.Run("Reports the error budgets of all objectives", func(t *testing.T) {
+		metrics, fakeGrpcServer, err := newMockGrpcServer(expectedStatRPC{
+			mockPromResponse: errorRatioVector(0.0005),
+			k8sConfigs:       []string{booksProfileWithObjectivesConfig, authorsProfileConfig},
+		})
+		if err != nil {
+			t.Fatalf("Error creating mock grpc server: %s", err)
+		}
+
+		rsp, err := fakeGrpcServer.SLOStatus(context.TODO(), &pb.SLOStatusRequest{Namespace: "default"})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if rsp.GetError() != "" {
+			t.Fatalf("Unexpected error response: %s", rsp.GetError())
+		}
+
+		statuses := rsp.GetOk().GetStatuses()
+		if len(statuses) != 2 {
+			t.Fatalf("Expected 2 statuses, got %d: %v", len(statuses), statuses)
+		}
+
+		availability := statuses[0]
+		if availability.GetObjective() != "availability" || availability.GetIndicator() != slo.SuccessRate || availability.GetWindow() != "1d" {
+			t.Fatalf("Unexpected status: %v", availability)
+		}
+		if !availability.GetHasData() || !approxEqual(availability.GetActual(), 0.9995) || !approxEqual(availability.GetErrorBudgetRemaining(), 0.5) {
+			t.Fatalf("Unexpected error budget: %v", availability)
+		}
+		if len(availability.GetBurnRates()) != 6 || !approxEqual(availability.GetBurnRates()[0].GetRate(), 0.5) {
+			t.Fatalf("Unexpected burn rates: %v", availability.GetBurnRates())
+		}
+		if len(availability.GetAlerts()) != 3 || availability.GetAlerts()[0].GetFiring() {
+			t.Fatalf("Unexpected alerts: %v", availability.GetAlerts())
+		}
+
+		latency := statuses[1]
+		if latency.GetRoute() != "GET /books" || latency.GetIndicator() != slo.Latency || latency.GetLatencyThresholdMs() != 300 {
+			t.Fatalf("Unexpected status: %v", latency)
+		}
+
+		// the 1d objective is queried over 1d, 5m, 30m, 1h, 2h and 6h, the 1h
+		// objective over 1h and 5m
+		if len(metrics.QueriesExecuted) != 8 {
+			t.Fatalf("Expected 8 queries, got %d: %v", len(metrics.QueriesExecuted), metrics.QueriesExecuted)
+		}
+	})
+
+	t.Run("Filters objectives by service and name", func(t *testing.T) {
+		_, fakeGrpcServer, err := newMockGrpcServer(expectedStatRPC{
+			mockPromResponse: model.Vector{},
+			k8sConfigs:       []string{booksProfileWithObjectivesConfig, authorsProfileConfig},
+		})
+		if err != nil {
+			t.Fatalf("Error creating mock grpc server: %s", err)
+		}
+
+		rsp, err := fakeGrpcServer.SLOStatus(context.TODO(), &pb.SLOStatusRequest{
+			Namespace: "default",
+			Service:   "books.default.svc.cluster.local",
+			Objective: "books-latency",
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		statuses := rsp.GetOk().GetStatuses()
+		if len(statuses) != 1 || statuses[0].GetObjective() != "books-latency" {
+			t.Fatalf("Unexpected statuses: %v", statuses)
+		}
+		if statuses[0].GetHasData() {
+			t.Fatalf("Expected no data without traffic, got %v", statuses[0])
+		}
+	})
+
+	t.Run("Requires a namespace to select a service", func(t *testing.T) {
+		_, fakeGrpcServer, err := newMockGrpcServer(expectedStatRPC{
+			mockPromResponse: model.Vector{},
+		})
+		if err != nil {
+			t.Fatalf("Error creating mock grpc server: %s", err)
+		}
+
+		rsp, err := fakeGrpcServer.SLOStatus(context.TODO(), &pb.SLOStatusRequest{Service: "books.default.svc.cluster.local"})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if rsp.GetError() != "a namespace is required to select a service" {
+			t.Fatalf("Unexpected error response: %s", rsp.GetError())
+		}
+	})
+}
+
+func TestBuildSLOStatus(t *testing.T) {
+	window, _ := model.ParseDuration("30d")
+	indicator := slo.Indicator{Service: "books", Objective: "availability", Window: window, Kind: slo.SuccessRate, Target: 0.999}
+
+	// a 2% error ratio burns the 0.1% budget 20 times too fast, firing the
+	// 14.4x, 6x and 3x alerts, while the 3d window hasn't seen enough errors
+	// for the 1x alert
+	status := buildSLOStatus(indicator, func(w model.Duration) (float64, bool) {
+		if w.String() == "3d" || w.String() == "30d" {
+			return 0.0005, true
+		}
+		return 0.02, true
+	})
+
+	firing := []bool{}
+	for _, alert := range status.GetAlerts() {
+		firing = append(firing, alert.GetFiring())
+	}
+	expected := []bool{true, true, true, false}
+	if len(firing) != len(expected) {
+		t.Fatalf("Expected alerts firing %v, got %v", expected, firing)
+	}
+	for i := range expected {
+		if firing[i] != expected[i] {
+			t.Fatalf("Expected alerts firing %v, got %v", expected, firing)
+		}
+	}
+}
@@ -2,15 +2,18 @@ package public
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
 	"sort"
 
 	"github.com/deislabs/smi-sdk-go/pkg/apis/split/v1alpha1"
 	proto "github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/linkerd/linkerd2/controller/api/util"
 	pb "github.com/linkerd/linkerd2/controller/gen/public"
 	"github.com/linkerd/linkerd2/pkg/k8s"
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
 	"github.com/prometheus/common/model"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -24,6 +27,12 @@ type resourceResult struct {
 	err error
 }
 
+type rollupsResult struct {
+	namespaces *pb.StatTable
+	totals     *pb.StatTable_PodGroup_Row
+	err        error
+}
+
 type k8sStat struct {
 	object   metav1.Object
 	podStats *podStats
@@ -50,9 +59,23 @@ const (
 
 	reqQuery             = "sum(increase(response_total%s[%s])) by (%s, classification, tls)"
 	latencyQuantileQuery = "histogram_quantile(%s, sum(irate(response_latency_ms_bucket%s[%s])) by (le, %s))"
+	latencyBucketsQuery  = "sum(increase(response_latency_ms_bucket%s[%s])) by (le, %s)"
 	tcpConnectionsQuery  = "sum(tcp_open_connections%s) by (%s)"
 	tcpReadBytesQuery    = "sum(increase(tcp_read_bytes_total%s[%s])) by (%s)"
 	tcpWriteBytesQuery   = "sum(increase(tcp_write_bytes_total%s[%s])) by (%s)"
+
+	// the queries of the totals of rollups, which aren't grouped by resource
+	totalReqQuery             = "sum(increase(response_total%s[%s])) by (classification, tls)"
+	totalLatencyQuantileQuery = "histogram_quantile(%s, sum(irate(response_latency_ms_bucket%s[%s])) by (le))"
+	totalLatencyBucketsQuery  = "sum(increase(response_latency_ms_bucket%s[%s])) by (le)"
+	totalTCPConnectionsQuery  = "sum(tcp_open_connections%s)"
+	totalTCPReadBytesQuery    = "sum(increase(tcp_read_bytes_total%s[%s]))"
+	totalTCPWriteBytesQuery   = "sum(increase(tcp_write_bytes_total%s[%s]))"
+
+	// maxTimeSeriesPoints bounds the number of points in the time series
+	// returned for a time range, as Prometheus refuses range queries
+	// resulting in more than 11000 points per series.
+	maxTimeSeriesPoints = 11000
 )
 
 type podStats struct {
@@ -81,6 +104,23 @@ func (s *grpcServer) StatSummary(ctx context.Context, req *pb.StatSummaryRequest
 		return statSummaryError(req, "service only supported as a target on 'from' queries, or as a destination on 'to' queries"), nil
 	}
 
+	if req.GetTimeRange() != nil {
+		if _, err := promRange(req.GetTimeRange()); err != nil {
+			return statSummaryError(req, err.Error()), nil
+		}
+	}
+	if err := validateLatencyQuantiles(req.GetLatencyQuantiles()); err != nil {
+		return statSummaryError(req, err.Error()), nil
+	}
+	if req.GetRollups() {
+		if req.GetSelector().GetResource().GetType() != k8s.All {
+			return statSummaryError(req, "rollups are only supported for resource type 'all'"), nil
+		}
+		if req.GetTimeRange() != nil {
+			return statSummaryError(req, "rollups are not supported with a time range"), nil
+		}
+	}
+
 	switch req.Outbound.(type) {
 	case *pb.StatSummaryRequest_ToResource:
 		if req.Outbound.(*pb.StatSummaryRequest_ToResource).ToResource.Type == k8s.All {
@@ -119,6 +159,13 @@ func (s *grpcServer) StatSummary(ctx context.Context, req *pb.StatSummaryRequest
 		}()
 	}
 
+	rollupsChan := make(chan rollupsResult, 1)
+	if req.GetRollups() {
+		go func() {
+			rollupsChan <- s.rollups(ctx, req)
+		}()
+	}
+
 	for i := 0; i < len(resourcesToQuery); i++ {
 		result := <-resultChan
 		if result.err != nil {
@@ -127,17 +174,72 @@ func (s *grpcServer) StatSummary(ctx context.Context, req *pb.StatSummaryRequest
 		statTables = append(statTables, result.res)
 	}
 
+	ok := &pb.StatSummaryResponse_Ok{
+		StatTables: statTables,
+	}
+	if req.GetRollups() {
+		result := <-rollupsChan
+		if result.err != nil {
+			return nil, util.GRPCError(result.err)
+		}
+		ok.Namespaces = result.namespaces
+		ok.Totals = result.totals
+	}
+
 	rsp := pb.StatSummaryResponse{
 		Response: &pb.StatSummaryResponse_Ok_{ // https://github.com/golang/protobuf/issues/205
-			Ok: &pb.StatSummaryResponse_Ok{
-				StatTables: statTables,
-			},
+			Ok: ok,
 		},
 	}
 
 	return &rsp, nil
 }
 
+// rollups returns the stats of each namespace selected by an "all" request,
+// from one set of queries grouped by namespace, and their totals, from the
+// same queries without grouping.
+func (s *grpcServer) rollups(ctx context.Context, req *pb.StatSummaryRequest) rollupsResult {
+	nsReq := proto.Clone(req).(*pb.StatSummaryRequest)
+	res := nsReq.Selector.Resource
+	res.Type, res.Name, res.Namespace = k8s.Namespace, res.Namespace, ""
+
+	totals := &pb.StatTable_PodGroup_Row{
+		Resource: &pb.Resource{
+			Namespace: req.GetSelector().GetResource().GetNamespace(),
+			Type:      k8s.All,
+		},
+		TimeWindow: req.GetTimeWindow(),
+	}
+
+	totalsErr := make(chan error, 1)
+	go func() {
+		if req.SkipStats {
+			totalsErr <- nil
+			return
+		}
+		var err error
+		totals.Stats, totals.TcpStats, err = s.getTotalMetrics(ctx, nsReq, req.TimeWindow)
+		totalsErr <- err
+	}()
+
+	namespaces := s.k8sResourceQuery(ctx, nsReq)
+	if err := <-totalsErr; err != nil {
+		return rollupsResult{err: err}
+	}
+	if namespaces.err != nil {
+		return rollupsResult{err: namespaces.err}
+	}
+
+	// the pod counts aren't metrics, and the namespaces don't share pods
+	for _, row := range namespaces.res.GetPodGroup().GetRows() {
+		totals.MeshedPodCount += row.GetMeshedPodCount()
+		totals.RunningPodCount += row.GetRunningPodCount()
+		totals.FailedPodCount += row.GetFailedPodCount()
+	}
+
+	return rollupsResult{namespaces: namespaces.res, totals: totals}
+}
+
 func isInvalidServiceRequest(selector *pb.ResourceSelection, fromResource *pb.Resource) bool {
 	if fromResource != nil {
 		return fromResource.Type == k8s.Service
@@ -199,15 +301,20 @@ func (s *grpcServer) k8sResourceQuery(ctx context.Context, req *pb.StatSummaryRe
 
 	var requestMetrics map[rKey]*pb.BasicStats
 	var tcpMetrics map[rKey]*pb.TcpStats
+	var timeSeries map[rKey][]*pb.TimeSeriesPoint
 	if !req.SkipStats {
-		requestMetrics, tcpMetrics, err = s.getStatMetrics(ctx, req, req.TimeWindow)
+		if req.GetTimeRange() != nil {
+			timeSeries, err = s.getStatTimeSeries(ctx, req)
+		} else {
+			requestMetrics, tcpMetrics, err = s.getStatMetrics(ctx, req, req.TimeWindow)
+		}
 		if err != nil {
 			return resourceResult{res: nil, err: err}
 		}
 	}
 
 	rows := make([]*pb.StatTable_PodGroup_Row, 0)
-	keys := getResultKeys(req, k8sObjects, requestMetrics)
+	keys := getResultKeys(req, k8sObjects, requestMetrics, timeSeries)
 
 	for _, key := range keys {
 		objInfo, ok := k8sObjects[key]
@@ -235,6 +342,7 @@ func (s *grpcServer) k8sResourceQuery(ctx context.Context, req *pb.StatSummaryRe
 			TimeWindow: req.TimeWindow,
 			Stats:      basicStats,
 			TcpStats:   tcpStats,
+			TimeSeries: timeSeries[key],
 		}
 
 		podStat := objInfo.podStats
@@ -283,6 +391,7 @@ func (s *grpcServer) trafficSplitResourceQuery(ctx context.Context, req *pb.Stat
 	}
 
 	tsBasicStats := make(map[tsKey]*pb.BasicStats)
+	tsTimeSeries := make(map[tsKey][]*pb.TimeSeriesPoint)
 	rows := make([]*pb.StatTable_PodGroup_Row, 0)
 
 	for _, ts := range tss {
@@ -295,7 +404,7 @@ func (s *grpcServer) trafficSplitResourceQuery(ctx context.Context, req *pb.Stat
 		}
 
 		if !req.SkipStats {
-			tsBasicStats, err = s.getTrafficSplitMetrics(ctx, req, tsStats, req.TimeWindow)
+			tsBasicStats, tsTimeSeries, err = s.getTrafficSplitMetrics(ctx, req, tsStats, req.TimeWindow)
 			if err != nil {
 				return resourceResult{res: nil, err: err}
 			}
@@ -328,6 +437,7 @@ func (s *grpcServer) trafficSplitResourceQuery(ctx context.Context, req *pb.Stat
 				TimeWindow: req.TimeWindow,
 				Stats:      tsBasicStats[currentLeaf],
 				TsStats:    trafficSplitStats,
+				TimeSeries: tsTimeSeries[currentLeaf],
 			}
 			rows = append(rows, &row)
 		}
@@ -358,9 +468,14 @@ func sortTrafficSplitRows(rows []*pb.StatTable_PodGroup_Row) []*pb.StatTable_Pod
 
 func (s *grpcServer) nonK8sResourceQuery(ctx context.Context, req *pb.StatSummaryRequest) resourceResult {
 	var requestMetrics map[rKey]*pb.BasicStats
+	var timeSeries map[rKey][]*pb.TimeSeriesPoint
 	if !req.SkipStats {
 		var err error
-		requestMetrics, _, err = s.getStatMetrics(ctx, req, req.TimeWindow)
+		if req.GetTimeRange() != nil {
+			timeSeries, err = s.getStatTimeSeries(ctx, req)
+		} else {
+			requestMetrics, _, err = s.getStatMetrics(ctx, req, req.TimeWindow)
+		}
 		if err != nil {
 			return resourceResult{res: nil, err: err}
 		}
@@ -381,6 +496,20 @@ func (s *grpcServer) nonK8sResourceQuery(ctx context.Context, req *pb.StatSummar
 		}
 		rows = append(rows, &row)
 	}
+	for rkey, series := range timeSeries {
+		rkey.Type = req.GetSelector().GetResource().GetType()
+
+		row := pb.StatTable_PodGroup_Row{
+			Resource: &pb.Resource{
+				Type:      rkey.Type,
+				Namespace: rkey.Namespace,
+				Name:      rkey.Name,
+			},
+			TimeWindow: req.TimeWindow,
+			TimeSeries: series,
+		}
+		rows = append(rows, &row)
+	}
 
 	rsp := pb.StatTable{
 		Table: &pb.StatTable_PodGroup_{
@@ -405,6 +534,7 @@ func getResultKeys(
 	req *pb.StatSummaryRequest,
 	k8sObjects map[rKey]k8sStat,
 	metricResults map[rKey]*pb.BasicStats,
+	timeSeries map[rKey][]*pb.TimeSeriesPoint,
 ) []rKey {
 	var keys []rKey
 
@@ -419,6 +549,9 @@ func getResultKeys(
 		for key := range metricResults {
 			keys = append(keys, key)
 		}
+		for key := range timeSeries {
+			keys = append(keys, key)
+		}
 	}
 	return keys
 }
@@ -485,13 +618,16 @@ func (s *grpcServer) getStatMetrics(ctx context.Context, req *pb.StatSummaryRequ
 	promQueries := map[promType]string{
 		promRequests: reqQuery,
 	}
+	if req.GetLatencyHistogram() {
+		promQueries[promLatencyHistogram] = latencyBucketsQuery
+	}
 
 	if req.TcpStats {
 		promQueries[promTCPConnections] = tcpConnectionsQuery
 		promQueries[promTCPReadBytes] = tcpReadBytesQuery
 		promQueries[promTCPWriteBytes] = tcpWriteBytesQuery
 	}
-	results, err := s.getPrometheusMetrics(ctx, promQueries, latencyQuantileQuery, reqLabels.String(), timeWindow, groupBy.String())
+	results, err := s.getPrometheusMetrics(ctx, promQueries, latencyQuantileQuery, latencyQuantiles(req.GetLatencyQuantiles()), reqLabels.String(), timeWindow, groupBy.String())
 
 	if err != nil {
 		return nil, nil, err
@@ -501,8 +637,88 @@ func (s *grpcServer) getStatMetrics(ctx context.Context, req *pb.StatSummaryRequ
 	return basicStats, tcpStats, nil
 }
 
-func (s *grpcServer) getTrafficSplitMetrics(ctx context.Context, req *pb.StatSummaryRequest, tsStats *trafficSplitStats, timeWindow string) (map[tsKey]*pb.BasicStats, error) {
+// getTotalMetrics returns the stats of all the resources selected by req,
+// queried without grouping them.
+func (s *grpcServer) getTotalMetrics(ctx context.Context, req *pb.StatSummaryRequest, timeWindow string) (*pb.BasicStats, *pb.TcpStats, error) {
+	reqLabels, _ := buildRequestLabels(req)
+	labels := reqLabels.String()
+
+	queries := map[promType]string{
+		promRequests: fmt.Sprintf(totalReqQuery, labels, timeWindow),
+	}
+	if req.GetLatencyHistogram() {
+		queries[promLatencyHistogram] = fmt.Sprintf(totalLatencyBucketsQuery, labels, timeWindow)
+	}
+	if req.TcpStats {
+		queries[promTCPConnections] = fmt.Sprintf(totalTCPConnectionsQuery, labels)
+		queries[promTCPReadBytes] = fmt.Sprintf(totalTCPReadBytesQuery, labels, timeWindow)
+		queries[promTCPWriteBytes] = fmt.Sprintf(totalTCPWriteBytesQuery, labels, timeWindow)
+	}
+	for _, quantile := range latencyQuantiles(req.GetLatencyQuantiles()) {
+		queries[quantile] = fmt.Sprintf(totalLatencyQuantileQuery, quantile, labels, timeWindow)
+	}
+
+	results, err := runPromQueries(queries, func(typ promType, query string) promResult {
+		vec, err := s.queryProm(ctx, query)
+		return promResult{prom: typ, vec: vec, err: err}
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	basicStats := &pb.BasicStats{}
+	var tcpStats *pb.TcpStats
+	if req.TcpStats {
+		tcpStats = &pb.TcpStats{}
+	}
+	for _, result := range results {
+		for _, sample := range result.vec {
+			value := extractSampleValue(sample)
+			switch result.prom {
+			case promTCPConnections:
+				tcpStats.OpenConnections = value
+			case promTCPReadBytes:
+				tcpStats.ReadBytesTotal = value
+			case promTCPWriteBytes:
+				tcpStats.WriteBytesTotal = value
+			default:
+				addBasicStatsValue(basicStats, result.prom, sample.Metric, value, req.GetLatencyQuantiles())
+			}
+		}
+	}
+
+	if reflect.DeepEqual(basicStats, &pb.BasicStats{}) {
+		basicStats = nil
+	}
+	return basicStats, tcpStats, nil
+}
+
+// getStatTimeSeries returns the request stats of the resources at every step
+// of the request's time range.
+func (s *grpcServer) getStatTimeSeries(ctx context.Context, req *pb.StatSummaryRequest) (map[rKey][]*pb.TimeSeriesPoint, error) {
+	timeRange, err := promRange(req.GetTimeRange())
+	if err != nil {
+		return nil, err
+	}
+
+	reqLabels, groupBy := buildRequestLabels(req)
+	promQueries := map[promType]string{
+		promRequests: reqQuery,
+	}
+	if req.GetLatencyHistogram() {
+		promQueries[promLatencyHistogram] = latencyBucketsQuery
+	}
+	results, err := s.getPrometheusRangeMetrics(ctx, promQueries, latencyQuantileQuery, latencyQuantiles(req.GetLatencyQuantiles()), reqLabels.String(), req.TimeWindow, groupBy.String(), timeRange)
+	if err != nil {
+		return nil, err
+	}
+
+	return processPrometheusTimeSeries(req, results, groupBy), nil
+}
+
+func (s *grpcServer) getTrafficSplitMetrics(ctx context.Context, req *pb.StatSummaryRequest, tsStats *trafficSplitStats, timeWindow string) (map[tsKey]*pb.BasicStats, map[tsKey][]*pb.TimeSeriesPoint, error) {
 	tsBasicStats := make(map[tsKey]*pb.BasicStats)
+	tsTimeSeries := make(map[tsKey][]*pb.TimeSeriesPoint)
 	labels, groupBy := buildTrafficSplitRequestLabels(req)
 
 	apex := tsStats.apex
@@ -515,25 +731,47 @@ func (s *grpcServer) getTrafficSplitMetrics(ctx context.Context, req *pb.StatSum
 	promQueries := map[promType]string{
 		promRequests: reqQuery,
 	}
+	if req.GetLatencyHistogram() {
+		promQueries[promLatencyHistogram] = latencyBucketsQuery
+	}
+
+	leafKey := func(leaf rKey) tsKey {
+		return tsKey{
+			Namespace: namespace,
+			Name:      tsStats.name,
+			Type:      req.Selector.Resource.Type,
+			Apex:      apex,
+			Leaf:      leaf.Name,
+		}
+	}
+
+	if req.GetTimeRange() != nil {
+		timeRange, err := promRange(req.GetTimeRange())
+		if err != nil {
+			return nil, nil, err
+		}
+		results, err := s.getPrometheusRangeMetrics(ctx, promQueries, latencyQuantileQuery, latencyQuantiles(req.GetLatencyQuantiles()), reqLabels, timeWindow, groupBy.String(), timeRange)
+		if err != nil {
+			return nil, nil, err
+		}
+		for rKey, series := range processPrometheusTimeSeries(req, results, groupBy) {
+			tsTimeSeries[leafKey(rKey)] = series
+		}
+		return tsBasicStats, tsTimeSeries, nil
+	}
 
-	results, err := s.getPrometheusMetrics(ctx, promQueries, latencyQuantileQuery, reqLabels, timeWindow, groupBy.String())
+	results, err := s.getPrometheusMetrics(ctx, promQueries, latencyQuantileQuery, latencyQuantiles(req.GetLatencyQuantiles()), reqLabels, timeWindow, groupBy.String())
 
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	basicStats, _ := processPrometheusMetrics(req, results, groupBy) // we don't need tcpStat info for traffic split
 
 	for rKey, basicStatsVal := range basicStats {
-		tsBasicStats[tsKey{
-			Namespace: namespace,
-			Name:      tsStats.name,
-			Type:      req.Selector.Resource.Type,
-			Apex:      apex,
-			Leaf:      rKey.Name,
-		}] = basicStatsVal
+		tsBasicStats[leafKey(rKey)] = basicStatsVal
 	}
-	return tsBasicStats, nil
+	return tsBasicStats, tsTimeSeries, nil
 }
 
 func processPrometheusMetrics(req *pb.StatSummaryRequest, results []promResult, groupBy model.LabelNames) (map[rKey]*pb.BasicStats, map[rKey]*pb.TcpStats) {
@@ -558,23 +796,6 @@ func processPrometheusMetrics(req *pb.StatSummaryRequest, results []promResult,
 			value := extractSampleValue(sample)
 
 			switch result.prom {
-			case promRequests:
-				addBasicStats()
-				switch string(sample.Metric[model.LabelName("classification")]) {
-				case success:
-					basicStats[resource].SuccessCount += value
-				case failure:
-					basicStats[resource].FailureCount += value
-				}
-			case promLatencyP50:
-				addBasicStats()
-				basicStats[resource].LatencyMsP50 = value
-			case promLatencyP95:
-				addBasicStats()
-				basicStats[resource].LatencyMsP95 = value
-			case promLatencyP99:
-				addBasicStats()
-				basicStats[resource].LatencyMsP99 = value
 			case promTCPConnections:
 				addTCPStats()
 				tcpStats[resource].OpenConnections = value
@@ -584,6 +805,9 @@ func processPrometheusMetrics(req *pb.StatSummaryRequest, results []promResult,
 			case promTCPWriteBytes:
 				addTCPStats()
 				tcpStats[resource].WriteBytesTotal = value
+			default:
+				addBasicStats()
+				addBasicStatsValue(basicStats[resource], result.prom, sample.Metric, value, req.GetLatencyQuantiles())
 			}
 
 		}
@@ -592,6 +816,97 @@ func processPrometheusMetrics(req *pb.StatSummaryRequest, results []promResult,
 	return basicStats, tcpStats
 }
 
+// processPrometheusTimeSeries builds the time series of the request stats of
+// each resource from the results of range queries.
+func processPrometheusTimeSeries(req *pb.StatSummaryRequest, results []promResult, groupBy model.LabelNames) map[rKey][]*pb.TimeSeriesPoint {
+	points := make(map[rKey]map[model.Time]*pb.BasicStats)
+
+	for _, result := range results {
+		for _, stream := range result.matrix {
+			resource := metricToKey(req, stream.Metric, groupBy)
+			if points[resource] == nil {
+				points[resource] = make(map[model.Time]*pb.BasicStats)
+			}
+
+			for _, pair := range stream.Values {
+				stats := points[resource][pair.Timestamp]
+				if stats == nil {
+					stats = &pb.BasicStats{}
+					points[resource][pair.Timestamp] = stats
+				}
+				addBasicStatsValue(stats, result.prom, stream.Metric, roundSampleValue(pair.Value), req.GetLatencyQuantiles())
+			}
+		}
+	}
+
+	timeSeries := make(map[rKey][]*pb.TimeSeriesPoint)
+	for resource, statsByTime := range points {
+		times := make([]model.Time, 0, len(statsByTime))
+		for t := range statsByTime {
+			times = append(times, t)
+		}
+		sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+
+		series := make([]*pb.TimeSeriesPoint, 0, len(times))
+		for _, t := range times {
+			timestamp, err := ptypes.TimestampProto(t.Time())
+			if err != nil {
+				continue
+			}
+			series = append(series, &pb.TimeSeriesPoint{
+				Time:  timestamp,
+				Stats: statsByTime[t],
+			})
+		}
+		timeSeries[resource] = series
+	}
+
+	return timeSeries
+}
+
+// addBasicStatsValue adds the value of a request count or latency sample to
+// stats.
+func addBasicStatsValue(stats *pb.BasicStats, prom promType, metric model.Metric, value uint64, quantiles []float64) {
+	if prom == promRequests {
+		switch string(metric[model.LabelName("classification")]) {
+		case success:
+			stats.SuccessCount += value
+		case failure:
+			stats.FailureCount += value
+		}
+		return
+	}
+	addLatencyValue(stats, prom, metric, value, quantiles)
+}
+
+// promRange converts a request's time range for Prometheus range queries.
+func promRange(timeRange *pb.TimeRange) (promv1.Range, error) {
+	start, err := ptypes.Timestamp(timeRange.GetStart())
+	if err != nil {
+		return promv1.Range{}, fmt.Errorf("invalid time range start: %s", err)
+	}
+	end, err := ptypes.Timestamp(timeRange.GetEnd())
+	if err != nil {
+		return promv1.Range{}, fmt.Errorf("invalid time range end: %s", err)
+	}
+	step, err := ptypes.Duration(timeRange.GetStep())
+	if err != nil {
+		return promv1.Range{}, fmt.Errorf("invalid time range step: %s", err)
+	}
+
+	if !end.After(start) {
+		return promv1.Range{}, errors.New("time range end must be after its start")
+	}
+	if step <= 0 {
+		return promv1.Range{}, errors.New("time range step must be positive")
+	}
+	if points := int64(end.Sub(start)/step) + 1; points > maxTimeSeriesPoints {
+		return promv1.Range{}, fmt.Errorf("time range has too many steps (%d), the maximum is %d", points, maxTimeSeriesPoints)
+	}
+
+	return promv1.Range{Start: start, End: end, Step: step}, nil
+}
+
 func metricToKey(req *pb.StatSummaryRequest, metric model.Metric, groupBy model.LabelNames) rKey {
 	// this key is used to match the metric stats we queried from prometheus
 	// with the k8s object stats we queried from k8s
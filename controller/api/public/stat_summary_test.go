@@ -4,9 +4,12 @@ import (
 	"context"
 	"errors"
 	"sort"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
 	pb "github.com/linkerd/linkerd2/controller/gen/public"
 	"github.com/linkerd/linkerd2/controller/k8s"
 	pkgK8s "github.com/linkerd/linkerd2/pkg/k8s"
@@ -80,7 +83,7 @@ func genEmptyResponse() pb.StatSummaryResponse {
 
 func testStatSummary(t *testing.T, expectations []statSumExpected) {
 	for _, exp := range expectations {
-		mockProm, fakeGrpcServer, err := newMockGrpcServer(exp.expectedStatRPC)
+		metrics, fakeGrpcServer, err := newMockGrpcServer(exp.expectedStatRPC)
 		if err != nil {
 			t.Fatalf("Error creating mock grpc server: %s", err)
 		}
@@ -90,7 +93,7 @@ func testStatSummary(t *testing.T, expectations []statSumExpected) {
 			t.Fatalf("Expected error: %s, Got: %s", exp.err, err)
 		}
 
-		err = exp.verifyPromQueries(mockProm)
+		err = exp.verifyPromQueries(metrics)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1413,7 +1416,7 @@ status:
 
 		for _, exp := range expectations {
 			fakeGrpcServer := newGrpcServer(
-				&MockProm{Res: exp.mockPromResponse},
+				newMockMetrics(exp.mockPromResponse),
 				nil,
 				k8sAPI,
 				"linkerd",
@@ -1438,7 +1441,7 @@ status:
 			t.Fatalf("NewFakeAPI returned an error: %s", err)
 		}
 		fakeGrpcServer := newGrpcServer(
-			&MockProm{Res: model.Vector{}},
+			NewInMemoryMetrics(),
 			nil,
 			k8sAPI,
 			"linkerd",
@@ -1896,3 +1899,363 @@ status:
 		testStatSummary(t, expectations)
 	})
 }
+
+func TestStatSummaryTimeRange(t *testing.T) {
+	start := time.Date(2019, 11, 5, 10, 0, 0, 0, time.UTC)
+	startProto, _ := ptypes.TimestampProto(start)
+	endProto, _ := ptypes.TimestampProto(start.Add(time.Minute))
+	secondProto, _ := ptypes.TimestampProto(start.Add(30 * time.Second))
+
+	req := pb.StatSummaryRequest{
+		Selector: &pb.ResourceSelection{
+			Resource: &pb.Resource{
+				Namespace: "emojivoto",
+				Type:      pkgK8s.Deployment,
+			},
+		},
+		TimeWindow: "30s",
+		TimeRange: &pb.TimeRange{
+			Start: startProto,
+			End:   endProto,
+			Step:  ptypes.DurationProto(30 * time.Second),
+		},
+	}
+
+	t.Run("Returns the time series of each resource", func(t *testing.T) {
+		metrics, fakeGrpcServer, err := newMockGrpcServer(expectedStatRPC{
+			k8sConfigs: []string{`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: emoji
+  namespace: emojivoto
+spec:
+  selector:
+    matchLabels:
+      app: emoji-svc
+`,
+			},
+			mockPromResponse: model.Matrix{
+				&model.SampleStream{
+					Metric: model.Metric{
+						"deployment":     "emoji",
+						"namespace":      "emojivoto",
+						"classification": "success",
+					},
+					Values: []model.SamplePair{
+						{Timestamp: model.TimeFromUnixNano(start.Add(30 * time.Second).UnixNano()), Value: 5},
+						{Timestamp: model.TimeFromUnixNano(start.UnixNano()), Value: 2.6},
+					},
+				},
+			},
+		})
+		if err != nil {
+			t.Fatalf("Error creating mock grpc server: %s", err)
+		}
+
+		rsp, err := fakeGrpcServer.StatSummary(context.TODO(), &req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		for _, query := range metrics.QueriesExecuted {
+			if strings.Contains(query, "tcp_") {
+				t.Fatalf("Expected no TCP queries for a time range, got %s", query)
+			}
+		}
+
+		rows := rsp.GetOk().GetStatTables()[0].GetPodGroup().GetRows()
+		if len(rows) != 1 {
+			t.Fatalf("Expected 1 row, got %d", len(rows))
+		}
+		if rows[0].GetStats() != nil {
+			t.Fatalf("Expected no stats for a time range, got %v", rows[0].GetStats())
+		}
+
+		// the mock returns the same matrix for the latency queries
+		expected := []*pb.TimeSeriesPoint{
+			{
+				Time:  startProto,
+				Stats: &pb.BasicStats{SuccessCount: 3, LatencyMsP50: 3, LatencyMsP95: 3, LatencyMsP99: 3},
+			},
+			{
+				Time:  secondProto,
+				Stats: &pb.BasicStats{SuccessCount: 5, LatencyMsP50: 5, LatencyMsP95: 5, LatencyMsP99: 5},
+			},
+		}
+		series := rows[0].GetTimeSeries()
+		if len(series) != len(expected) {
+			t.Fatalf("Expected %d points, got %d", len(expected), len(series))
+		}
+		for i, point := range series {
+			if !proto.Equal(point, expected[i]) {
+				t.Fatalf("Expected point %d to be %v, got %v", i, expected[i], point)
+			}
+		}
+	})
+
+	t.Run("Rejects invalid time ranges", func(t *testing.T) {
+		_, fakeGrpcServer, err := newMockGrpcServer(expectedStatRPC{mockPromResponse: model.Matrix{}})
+		if err != nil {
+			t.Fatalf("Error creating mock grpc server: %s", err)
+		}
+
+		invalid := req
+		invalid.TimeRange = &pb.TimeRange{
+			Start: endProto,
+			End:   startProto,
+			Step:  ptypes.DurationProto(30 * time.Second),
+		}
+		rsp, err := fakeGrpcServer.StatSummary(context.TODO(), &invalid)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if msg := rsp.GetError().GetError(); msg != "time range end must be after its start" {
+			t.Fatalf("Unexpected error message: %s", msg)
+		}
+	})
+}
+
+func TestStatSummaryLatencyQuantiles(t *testing.T) {
+	req := pb.StatSummaryRequest{
+		Selector: &pb.ResourceSelection{
+			Resource: &pb.Resource{
+				Namespace: "emojivoto",
+				Type:      pkgK8s.Pod,
+				Name:      "emojivoto-1",
+			},
+		},
+		TimeWindow:       "1m",
+		LatencyQuantiles: []float64{0.999, 0.75, 0.99},
+		LatencyHistogram: true,
+	}
+
+	t.Run("Queries and returns the requested quantiles and buckets", func(t *testing.T) {
+		exp := expectedStatRPC{
+			k8sConfigs: []string{`
+apiVersion: v1
+kind: Pod
+metadata:
+  name: emojivoto-1
+  namespace: emojivoto
+  labels:
+    app: emoji-svc
+    linkerd.io/control-plane-ns: linkerd
+status:
+  phase: Running
+`,
+			},
+			mockPromResponse: model.Vector{
+				&model.Sample{
+					Metric: model.Metric{
+						"pod":            "emojivoto-1",
+						"namespace":      "emojivoto",
+						"classification": "success",
+						"le":             "10",
+					},
+					Value: 123,
+				},
+			},
+			expectedPrometheusQueries: []string{
+				`histogram_quantile(0.5, sum(irate(response_latency_ms_bucket{direction="inbound", namespace="emojivoto", pod="emojivoto-1"}[1m])) by (le, namespace, pod))`,
+				`histogram_quantile(0.75, sum(irate(response_latency_ms_bucket{direction="inbound", namespace="emojivoto", pod="emojivoto-1"}[1m])) by (le, namespace, pod))`,
+				`histogram_quantile(0.95, sum(irate(response_latency_ms_bucket{direction="inbound", namespace="emojivoto", pod="emojivoto-1"}[1m])) by (le, namespace, pod))`,
+				`histogram_quantile(0.99, sum(irate(response_latency_ms_bucket{direction="inbound", namespace="emojivoto", pod="emojivoto-1"}[1m])) by (le, namespace, pod))`,
+				`histogram_quantile(0.999, sum(irate(response_latency_ms_bucket{direction="inbound", namespace="emojivoto", pod="emojivoto-1"}[1m])) by (le, namespace, pod))`,
+				`sum(increase(response_latency_ms_bucket{direction="inbound", namespace="emojivoto", pod="emojivoto-1"}[1m])) by (le, namespace, pod)`,
+				`sum(increase(response_total{direction="inbound", namespace="emojivoto", pod="emojivoto-1"}[1m])) by (namespace, pod, classification, tls)`,
+			},
+		}
+		metrics, fakeGrpcServer, err := newMockGrpcServer(exp)
+		if err != nil {
+			t.Fatalf("Error creating mock grpc server: %s", err)
+		}
+
+		rsp, err := fakeGrpcServer.StatSummary(context.TODO(), &req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if err := exp.verifyPromQueries(metrics); err != nil {
+			t.Fatal(err)
+		}
+
+		expected := &pb.BasicStats{
+			SuccessCount: 123,
+			LatencyMsP50: 123,
+			LatencyMsP95: 123,
+			LatencyMsP99: 123,
+			LatencyQuantiles: []*pb.LatencyQuantile{
+				{Quantile: 0.75, LatencyMs: 123},
+				{Quantile: 0.99, LatencyMs: 123},
+				{Quantile: 0.999, LatencyMs: 123},
+			},
+			LatencyHistogram: []*pb.LatencyBucket{
+				{LeMs: 10, Count: 123},
+			},
+		}
+		stats := rsp.GetOk().GetStatTables()[0].GetPodGroup().GetRows()[0].GetStats()
+		if !proto.Equal(stats, expected) {
+			t.Fatalf("Expected: %v\nGot: %v", expected, stats)
+		}
+	})
+
+	t.Run("Rejects invalid quantiles", func(t *testing.T) {
+		_, fakeGrpcServer, err := newMockGrpcServer(expectedStatRPC{mockPromResponse: model.Vector{}})
+		if err != nil {
+			t.Fatalf("Error creating mock grpc server: %s", err)
+		}
+
+		invalid := req
+		invalid.LatencyQuantiles = []float64{99.9}
+		rsp, err := fakeGrpcServer.StatSummary(context.TODO(), &invalid)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if msg := rsp.GetError().GetError(); msg != "latency quantile 99.9 needs to be between 0 and 1" {
+			t.Fatalf("Unexpected error message: %s", msg)
+		}
+	})
+}
+
+func TestStatSummaryRollups(t *testing.T) {
+	req := pb.StatSummaryRequest{
+		Selector: &pb.ResourceSelection{
+			Resource: &pb.Resource{
+				Type: pkgK8s.All,
+			},
+		},
+		TimeWindow: "1m",
+		Rollups:    true,
+	}
+
+	t.Run("Returns the namespace stats and their totals", func(t *testing.T) {
+		exp := expectedStatRPC{
+			k8sConfigs: []string{`
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: emojivoto
+`, `
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: books
+`, `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: emojivoto-1
+  namespace: emojivoto
+  labels:
+    linkerd.io/control-plane-ns: linkerd
+status:
+  phase: Running
+`, `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: books-1
+  namespace: books
+status:
+  phase: Running
+`,
+			},
+			// the same samples are returned for every query
+			mockPromResponse: model.Vector{
+				&model.Sample{
+					Metric: model.Metric{"namespace": "emojivoto", "classification": "success"},
+					Value:  30,
+				},
+				&model.Sample{
+					Metric: model.Metric{"namespace": "books", "classification": "failure"},
+					Value:  10,
+				},
+			},
+		}
+		mockProm, fakeGrpcServer, err := newMockGrpcServer(exp)
+		if err != nil {
+			t.Fatalf("Error creating mock grpc server: %s", err)
+		}
+
+		rsp, err := fakeGrpcServer.StatSummary(context.TODO(), &req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		for _, expectedQuery := range []string{
+			`sum(increase(response_total{direction="inbound"}[1m])) by (namespace, classification, tls)`,
+			`histogram_quantile(0.5, sum(irate(response_latency_ms_bucket{direction="inbound"}[1m])) by (le, namespace))`,
+			`sum(increase(response_total{direction="inbound"}[1m])) by (classification, tls)`,
+			`histogram_quantile(0.5, sum(irate(response_latency_ms_bucket{direction="inbound"}[1m])) by (le))`,
+		} {
+			found := false
+			for _, query := range mockProm.QueriesExecuted {
+				found = found || query == expectedQuery
+			}
+			if !found {
+				t.Fatalf("Expected query %s, got %v", expectedQuery, mockProm.QueriesExecuted)
+			}
+		}
+		for _, query := range mockProm.QueriesExecuted {
+			if strings.Contains(query, "increase(response_latency_ms_bucket") {
+				t.Fatalf("Unexpected latency buckets query: %s", query)
+			}
+		}
+
+		for _, table := range rsp.GetOk().GetStatTables() {
+			for _, row := range table.GetPodGroup().GetRows() {
+				if row.GetResource().GetType() == pkgK8s.Namespace {
+					t.Fatalf("Unexpected namespace row among the stat tables: %v", row)
+				}
+			}
+		}
+
+		namespaces := map[string]*pb.StatTable_PodGroup_Row{}
+		for _, row := range rsp.GetOk().GetNamespaces().GetPodGroup().GetRows() {
+			namespaces[row.GetResource().GetName()] = row
+		}
+		if len(namespaces) != 2 {
+			t.Fatalf("Expected 2 namespace rows, got %v", namespaces)
+		}
+		if stats := namespaces["emojivoto"].GetStats(); stats.GetSuccessCount() != 30 || stats.GetFailureCount() != 0 {
+			t.Fatalf("Unexpected emojivoto stats: %v", stats)
+		}
+
+		expected := &pb.StatTable_PodGroup_Row{
+			Resource: &pb.Resource{
+				Type: pkgK8s.All,
+			},
+			TimeWindow:      "1m",
+			MeshedPodCount:  1,
+			RunningPodCount: 2,
+			Stats: &pb.BasicStats{
+				SuccessCount: 30,
+				FailureCount: 10,
+				// the mock returns the samples of the namespaces for the
+				// latency queries too, the last one wins
+				LatencyMsP50: 10,
+				LatencyMsP95: 10,
+				LatencyMsP99: 10,
+			},
+		}
+		if totals := rsp.GetOk().GetTotals(); !proto.Equal(totals, expected) {
+			t.Fatalf("Expected: %v\nGot: %v", expected, totals)
+		}
+	})
+
+	t.Run("Rejects rollups for other resource types", func(t *testing.T) {
+		_, fakeGrpcServer, err := newMockGrpcServer(expectedStatRPC{mockPromResponse: model.Vector{}})
+		if err != nil {
+			t.Fatalf("Error creating mock grpc server: %s", err)
+		}
+
+		invalid := req
+		invalid.Selector = &pb.ResourceSelection{Resource: &pb.Resource{Type: pkgK8s.Deployment}}
+		rsp, err := fakeGrpcServer.StatSummary(context.TODO(), &invalid)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if msg := rsp.GetError().GetError(); msg != "rollups are only supported for resource type 'all'" {
+			t.Fatalf("Unexpected error message: %s", msg)
+		}
+	})
+}
@@ -33,6 +33,8 @@ type MockAPIClient struct {
 	EdgesResponseToReturn          *pb.EdgesResponse
 	SelfCheckResponseToReturn      *healthcheckPb.SelfCheckResponse
 	ConfigResponseToReturn         *configPb.All
+	DenylistResponseToReturn       *pb.IdentityDenylistResponse
+	SLOStatusResponseToReturn      *pb.SLOStatusResponse
 	APITapClientToReturn           pb.Api_TapClient
 	APITapByResourceClientToReturn pb.Api_TapByResourceClient
 	DestinationGetClientToReturn   destinationPb.Destination_GetClient
@@ -99,6 +101,16 @@ func (c *MockAPIClient) Config(ctx context.Context, in *pb.Empty, _ ...grpc.Call
 	return c.ConfigResponseToReturn, c.ErrorToReturn
 }
 
+// IdentityDenylist provides a mock of a Public API method.
+func (c *MockAPIClient) IdentityDenylist(ctx context.Context, in *pb.Empty, _ ...grpc.CallOption) (*pb.IdentityDenylistResponse, error) {
+	return c.DenylistResponseToReturn, c.ErrorToReturn
+}
+
+// SLOStatus provides a mock of a Public API method.
+func (c *MockAPIClient) SLOStatus(ctx context.Context, in *pb.SLOStatusRequest, _ ...grpc.CallOption) (*pb.SLOStatusResponse, error) {
+	return c.SLOStatusResponseToReturn, c.ErrorToReturn
+}
+
 // MockDestinationGetClient satisfies the Destination_GetClient gRPC interface.
 type MockDestinationGetClient struct {
 	UpdatesToReturn []destinationPb.Update
@@ -555,15 +567,15 @@ type expectedStatRPC struct {
 	expectedPrometheusQueries []string    // queries we expect public-api to issue to prometheus
 }
 
-func newMockGrpcServer(exp expectedStatRPC) (*MockProm, *grpcServer, error) {
+func newMockGrpcServer(exp expectedStatRPC) (*InMemoryMetrics, *grpcServer, error) {
 	k8sAPI, err := k8s.NewFakeAPI(exp.k8sConfigs...)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	mockProm := &MockProm{Res: exp.mockPromResponse}
+	metrics := newMockMetrics(exp.mockPromResponse)
 	fakeGrpcServer := newGrpcServer(
-		mockProm,
+		metrics,
 		nil,
 		k8sAPI,
 		"linkerd",
@@ -573,23 +585,35 @@ func newMockGrpcServer(exp expectedStatRPC) (*MockProm, *grpcServer, error) {
 
 	k8sAPI.Sync()
 
-	return mockProm, fakeGrpcServer, nil
+	return metrics, fakeGrpcServer, nil
+}
+
+// newMockMetrics returns an InMemoryMetrics answering every query with res.
+func newMockMetrics(res model.Value) *InMemoryMetrics {
+	metrics := NewInMemoryMetrics()
+	switch v := res.(type) {
+	case model.Vector:
+		metrics.DefaultVector = v
+	case model.Matrix:
+		metrics.DefaultMatrix = v
+	}
+	return metrics
 }
 
-func (exp expectedStatRPC) verifyPromQueries(mockProm *MockProm) error {
+func (exp expectedStatRPC) verifyPromQueries(metrics *InMemoryMetrics) error {
 	// if exp.expectedPrometheusQueries is an empty slice we still wanna check no queries were executed.
 	if exp.expectedPrometheusQueries != nil {
 		sort.Strings(exp.expectedPrometheusQueries)
-		sort.Strings(mockProm.QueriesExecuted)
+		sort.Strings(metrics.QueriesExecuted)
 
 		// because reflect.DeepEqual([]string{}, nil) is false
-		if len(exp.expectedPrometheusQueries) == 0 && len(mockProm.QueriesExecuted) == 0 {
+		if len(exp.expectedPrometheusQueries) == 0 && len(metrics.QueriesExecuted) == 0 {
 			return nil
 		}
 
-		if !reflect.DeepEqual(exp.expectedPrometheusQueries, mockProm.QueriesExecuted) {
+		if !reflect.DeepEqual(exp.expectedPrometheusQueries, metrics.QueriesExecuted) {
 			return fmt.Errorf("Prometheus queries incorrect. \nExpected:\n%+v \nGot:\n%+v",
-				exp.expectedPrometheusQueries, mockProm.QueriesExecuted)
+				exp.expectedPrometheusQueries, metrics.QueriesExecuted)
 		}
 	}
 	return nil
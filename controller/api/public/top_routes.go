@@ -21,6 +21,7 @@ const (
 	routeReqQuery             = "sum(increase(route_response_total%s[%s])) by (%s, dst, classification)"
 	actualRouteReqQuery       = "sum(increase(route_actual_response_total%s[%s])) by (%s, dst, classification)"
 	routeLatencyQuantileQuery = "histogram_quantile(%s, sum(irate(route_response_latency_ms_bucket%s[%s])) by (le, dst, %s))"
+	routeLatencyBucketsQuery  = "sum(increase(route_response_latency_ms_bucket%s[%s])) by (le, dst, %s)"
 	dstLabel                  = `dst=~"(%s)(:\\d+)?"`
 	// DefaultRouteName is the name to display for requests that don't match any routes.
 	DefaultRouteName = "[DEFAULT]"
@@ -188,6 +189,10 @@ func validateRequest(req *pb.TopRoutesRequest) *pb.TopRoutesResponse {
 			return topRoutesError(req, fmt.Sprintf("The %s resource type is not supported with 'to' queries", targetType))
 		}
 	}
+
+	if err := validateLatencyQuantiles(req.GetLatencyQuantiles()); err != nil {
+		return topRoutesError(req, err.Error())
+	}
 	return nil
 }
 
@@ -240,8 +245,11 @@ func (s *grpcServer) getRouteMetrics(ctx context.Context, req *pb.TopRoutesReque
 		// If this req has an Outbound, then query the actual request counts as well.
 		queries[promActualRequests] = actualRouteReqQuery
 	}
+	if req.GetLatencyHistogram() {
+		queries[promLatencyHistogram] = routeLatencyBucketsQuery
+	}
 
-	results, err := s.getPrometheusMetrics(ctx, queries, routeLatencyQuantileQuery, reqLabels, timeWindow, groupBy)
+	results, err := s.getPrometheusMetrics(ctx, queries, routeLatencyQuantileQuery, latencyQuantiles(req.GetLatencyQuantiles()), reqLabels, timeWindow, groupBy)
 	if err != nil {
 		return nil, err
 	}
@@ -270,7 +278,7 @@ func (s *grpcServer) getRouteMetrics(ctx context.Context, req *pb.TopRoutesReque
 		}
 	}
 
-	processRouteMetrics(results, timeWindow, table)
+	processRouteMetrics(results, timeWindow, req.GetLatencyQuantiles(), table)
 
 	return table, nil
 }
@@ -305,7 +313,7 @@ func renderLabels(labels model.LabelSet, services []string) string {
 	return fmt.Sprintf("{%s}", strings.Join(pairs, ", "))
 }
 
-func processRouteMetrics(results []promResult, timeWindow string, table indexedTable) {
+func processRouteMetrics(results []promResult, timeWindow string, quantiles []float64, table indexedTable) {
 	for _, result := range results {
 		for _, sample := range result.vec {
 			route := string(sample.Metric[model.LabelName("rt_route")])
@@ -337,12 +345,8 @@ func processRouteMetrics(results []promResult, timeWindow string, table indexedT
 				case failure:
 					table[key].Stats.ActualFailureCount += value
 				}
-			case promLatencyP50:
-				table[key].Stats.LatencyMsP50 = value
-			case promLatencyP95:
-				table[key].Stats.LatencyMsP95 = value
-			case promLatencyP99:
-				table[key].Stats.LatencyMsP99 = value
+			default:
+				addLatencyValue(table[key].Stats, result.prom, sample.Metric, value, quantiles)
 			}
 		}
 	}
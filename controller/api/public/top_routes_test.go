@@ -208,7 +208,7 @@ func testTopRoutes(t *testing.T, expectations []topRoutesExpected) {
 	for id, exp := range expectations {
 		exp := exp // pin
 		t.Run(fmt.Sprintf("%d", id), func(t *testing.T) {
-			mockProm, fakeGrpcServer, err := newMockGrpcServer(exp.expectedStatRPC)
+			metrics, fakeGrpcServer, err := newMockGrpcServer(exp.expectedStatRPC)
 			if err != nil {
 				t.Fatalf("Error creating mock grpc server: %s", err)
 			}
@@ -218,7 +218,7 @@ func testTopRoutes(t *testing.T, expectations []topRoutesExpected) {
 				t.Fatalf("Expected error: %s, Got: %s", exp.err, err)
 			}
 
-			err = exp.verifyPromQueries(mockProm)
+			err = exp.verifyPromQueries(metrics)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -286,6 +286,46 @@ func TestTopRoutes(t *testing.T) {
 		testTopRoutes(t, expectations)
 	})
 
+	t.Run("Successfully performs a routes query with latency quantiles", func(t *testing.T) {
+		expectedResponse := GenTopRoutesResponse([]string{"/a"}, []uint64{123}, false, "books")
+		for _, row := range expectedResponse.GetOk().GetRoutes()[0].Rows {
+			row.Stats.LatencyQuantiles = []*pb.LatencyQuantile{{Quantile: 0.75, LatencyMs: 123}}
+		}
+		expectations := []topRoutesExpected{
+			{
+				expectedStatRPC: expectedStatRPC{
+					err:              nil,
+					mockPromResponse: routesMetric([]string{"/a"}),
+					expectedPrometheusQueries: []string{
+						`histogram_quantile(0.5, sum(irate(route_response_latency_ms_bucket{deployment="books", direction="inbound", dst=~"(books.default.svc.cluster.local)(:\\d+)?", namespace="default"}[1m])) by (le, dst, rt_route))`,
+						`histogram_quantile(0.75, sum(irate(route_response_latency_ms_bucket{deployment="books", direction="inbound", dst=~"(books.default.svc.cluster.local)(:\\d+)?", namespace="default"}[1m])) by (le, dst, rt_route))`,
+						`histogram_quantile(0.95, sum(irate(route_response_latency_ms_bucket{deployment="books", direction="inbound", dst=~"(books.default.svc.cluster.local)(:\\d+)?", namespace="default"}[1m])) by (le, dst, rt_route))`,
+						`histogram_quantile(0.99, sum(irate(route_response_latency_ms_bucket{deployment="books", direction="inbound", dst=~"(books.default.svc.cluster.local)(:\\d+)?", namespace="default"}[1m])) by (le, dst, rt_route))`,
+						`sum(increase(route_response_total{deployment="books", direction="inbound", dst=~"(books.default.svc.cluster.local)(:\\d+)?", namespace="default"}[1m])) by (rt_route, dst, classification)`,
+					},
+					k8sConfigs: booksConfig,
+				},
+				req: pb.TopRoutesRequest{
+					Selector: &pb.ResourceSelection{
+						Resource: &pb.Resource{
+							Namespace: "default",
+							Type:      pkgK8s.Deployment,
+							Name:      "books",
+						},
+					},
+					TimeWindow: "1m",
+					Outbound: &pb.TopRoutesRequest_None{
+						None: &pb.Empty{},
+					},
+					LatencyQuantiles: []float64{0.75},
+				},
+				expectedResponse: expectedResponse,
+			},
+		}
+
+		testTopRoutes(t, expectations)
+	})
+
 	t.Run("Successfully performs a routes query for a service", func(t *testing.T) {
 		routes := []string{"/a"}
 		counts := []uint64{123}
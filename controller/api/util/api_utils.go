@@ -4,11 +4,16 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"math"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/golang/protobuf/ptypes"
 	pb "github.com/linkerd/linkerd2/controller/gen/public"
 	"github.com/linkerd/linkerd2/pkg/k8s"
+	"github.com/prometheus/common/model"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
@@ -23,6 +28,7 @@ import (
 var (
 	defaultMetricTimeWindow    = "1m"
 	metricTimeWindowLowerBound = time.Second * 15 //the window value needs to equal or larger than that
+	maxTimeRangePoints         = 11000            // the most points Prometheus returns per series
 
 	// ValidTargets specifies resource types allowed as a target:
 	// target resource on an inbound query
@@ -65,6 +71,10 @@ type StatsBaseRequestParams struct {
 	ResourceType  string
 	ResourceName  string
 	AllNamespaces bool
+	// LatencyQuantiles are requested on top of the p50, p95 and p99
+	// latencies, and LatencyHistogram requests the latency bucket counts.
+	LatencyQuantiles []float64
+	LatencyHistogram bool
 }
 
 // StatsSummaryRequestParams contains parameters that are used to build
@@ -79,6 +89,13 @@ type StatsSummaryRequestParams struct {
 	FromName      string
 	SkipStats     bool
 	TCPStats      bool
+	// StartTime, when set, requests the time series of the stats from
+	// StartTime to EndTime, defaulting to now, at every Step.
+	StartTime time.Time
+	EndTime   time.Time
+	Step      time.Duration
+	// Rollups requests the namespace rollups and totals of an "all" query.
+	Rollups bool
 }
 
 // EdgesRequestParams contains parameters that are used to build
@@ -111,6 +128,14 @@ type TapRequestParams struct {
 	Authority   string
 	Path        string
 	Extract     bool
+	// Status is an HTTP status code, e.g. "503", or an inclusive range of
+	// them, e.g. "500-599".
+	Status     string
+	MinLatency time.Duration
+	// GrpcStatus is a gRPC status code, either numeric or by name, e.g.
+	// "14" or "Unavailable".
+	GrpcStatus string
+	Headers    map[string]string
 }
 
 // GRPCError generates a gRPC error code, as defined in
@@ -149,6 +174,11 @@ func GRPCError(err error) error {
 // StatsSummaryRequestParams.
 func BuildStatSummaryRequest(p StatsSummaryRequestParams) (*pb.StatSummaryRequest, error) {
 	window := defaultMetricTimeWindow
+	if !p.StartTime.IsZero() {
+		// each point of a time series covers the step leading up to it, and
+		// Prometheus only accepts single-unit durations such as "90s"
+		window = model.Duration(p.Step).String()
+	}
 	if p.TimeWindow != "" {
 		w, err := time.ParseDuration(p.TimeWindow)
 		if err != nil {
@@ -186,9 +216,20 @@ func BuildStatSummaryRequest(p StatsSummaryRequestParams) (*pb.StatSummaryReques
 				Type:      resourceType,
 			},
 		},
-		TimeWindow: window,
-		SkipStats:  p.SkipStats,
-		TcpStats:   p.TCPStats,
+		TimeWindow:       window,
+		SkipStats:        p.SkipStats,
+		TcpStats:         p.TCPStats,
+		LatencyQuantiles: p.LatencyQuantiles,
+		LatencyHistogram: p.LatencyHistogram,
+		Rollups:          p.Rollups,
+	}
+
+	if !p.StartTime.IsZero() {
+		timeRange, err := buildTimeRange(p.StartTime, p.EndTime, p.Step)
+		if err != nil {
+			return nil, err
+		}
+		statRequest.TimeRange = timeRange
 	}
 
 	if p.ToName != "" || p.ToType != "" || p.ToNamespace != "" {
@@ -240,6 +281,38 @@ func BuildStatSummaryRequest(p StatsSummaryRequestParams) (*pb.StatSummaryReques
 	return statRequest, nil
 }
 
+// buildTimeRange builds the time range of a StatSummaryRequest, from start to
+// end, defaulting to now, at every step.
+func buildTimeRange(start, end time.Time, step time.Duration) (*pb.TimeRange, error) {
+	if end.IsZero() {
+		end = time.Now()
+	}
+	if !end.After(start) {
+		return nil, errors.New("the end of the time range needs to be after its start")
+	}
+	if step < metricTimeWindowLowerBound {
+		return nil, errors.New("time range step needs to be at least 15s")
+	}
+	if points := int(end.Sub(start)/step) + 1; points > maxTimeRangePoints {
+		return nil, fmt.Errorf("time range has too many steps (%d), the maximum is %d", points, maxTimeRangePoints)
+	}
+
+	startProto, err := ptypes.TimestampProto(start)
+	if err != nil {
+		return nil, err
+	}
+	endProto, err := ptypes.TimestampProto(end)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.TimeRange{
+		Start: startProto,
+		End:   endProto,
+		Step:  ptypes.DurationProto(step),
+	}, nil
+}
+
 // BuildEdgesRequest builds a Public API EdgesRequest from a
 // EdgesRequestParams.
 func BuildEdgesRequest(p EdgesRequestParams) (*pb.EdgesRequest, error) {
@@ -300,7 +373,9 @@ func BuildTopRoutesRequest(p TopRoutesRequestParams) (*pb.TopRoutesRequest, erro
 				Type:      resourceType,
 			},
 		},
-		TimeWindow: window,
+		TimeWindow:       window,
+		LatencyQuantiles: p.LatencyQuantiles,
+		LatencyHistogram: p.LatencyHistogram,
 	}
 
 	if p.ToName != "" || p.ToType != "" || p.ToNamespace != "" {
@@ -333,6 +408,34 @@ func BuildTopRoutesRequest(p TopRoutesRequestParams) (*pb.TopRoutesRequest, erro
 	return topRoutesRequest, nil
 }
 
+// ParseLatencyQuantiles parses a comma-separated list of latency quantiles,
+// each either a fraction of 1 (for example "0.999") or a percentile prefixed
+// with "p" (for example "p99.9").
+func ParseLatencyQuantiles(quantiles string) ([]float64, error) {
+	if quantiles == "" {
+		return nil, nil
+	}
+
+	var parsed []float64
+	for _, quantile := range strings.Split(quantiles, ",") {
+		quantile = strings.TrimSpace(quantile)
+		percentile := strings.HasPrefix(quantile, "p")
+		q, err := strconv.ParseFloat(strings.TrimPrefix(quantile, "p"), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid latency quantile: %s", quantile)
+		}
+		if percentile {
+			// rounded so that p99.9 is the same quantile as 0.999
+			q = math.Round(q*1e7) / 1e9
+		}
+		if !(q > 0 && q < 1) {
+			return nil, fmt.Errorf("latency quantile %s needs to be between 0 and 1, or between p0 and p100", quantile)
+		}
+		parsed = append(parsed, q)
+	}
+	return parsed, nil
+}
+
 // An authority can only receive traffic, not send it, so it can't be a --from
 func validateFromResourceType(resourceType string) (string, error) {
 	name, err := k8s.CanonicalResourceNameFromFriendlyName(resourceType)
@@ -500,6 +603,55 @@ func BuildTapByResourceRequest(params TapRequestParams) (*pb.TapByResourceReques
 		matches = append(matches, &match)
 	}
 
+	if params.Status != "" {
+		min, max, err := parseStatusRange(params.Status)
+		if err != nil {
+			return nil, err
+		}
+		match := buildMatchHTTP(&pb.TapByResourceRequest_Match_Http{
+			Match: &pb.TapByResourceRequest_Match_Http_Status{
+				Status: &pb.TapByResourceRequest_Match_Http_StatusRange{Min: min, Max: max},
+			},
+		})
+		matches = append(matches, &match)
+	}
+	if params.MinLatency < 0 {
+		return nil, fmt.Errorf("minimum latency must not be negative: %s", params.MinLatency)
+	}
+	if params.MinLatency > 0 {
+		match := buildMatchHTTP(&pb.TapByResourceRequest_Match_Http{
+			Match: &pb.TapByResourceRequest_Match_Http_MinLatency{MinLatency: ptypes.DurationProto(params.MinLatency)},
+		})
+		matches = append(matches, &match)
+	}
+	if params.GrpcStatus != "" {
+		code, err := parseGrpcStatus(params.GrpcStatus)
+		if err != nil {
+			return nil, err
+		}
+		match := buildMatchHTTP(&pb.TapByResourceRequest_Match_Http{
+			Match: &pb.TapByResourceRequest_Match_Http_GrpcStatus{GrpcStatus: code},
+		})
+		matches = append(matches, &match)
+	}
+	// Sort the headers so that the request doesn't depend on map ordering.
+	headerNames := make([]string, 0, len(params.Headers))
+	for name := range params.Headers {
+		headerNames = append(headerNames, name)
+	}
+	sort.Strings(headerNames)
+	for _, name := range headerNames {
+		if name == "" {
+			return nil, errors.New("header match is missing a header name")
+		}
+		match := buildMatchHTTP(&pb.TapByResourceRequest_Match_Http{
+			Match: &pb.TapByResourceRequest_Match_Http_Header_{
+				Header: &pb.TapByResourceRequest_Match_Http_Header{Name: name, Value: params.Headers[name]},
+			},
+		})
+		matches = append(matches, &match)
+	}
+
 	extract := &pb.TapByResourceRequest_Extract{}
 	if params.Extract {
 		extract = buildExtractHTTP(&pb.TapByResourceRequest_Extract_Http{
@@ -525,6 +677,53 @@ func BuildTapByResourceRequest(params TapRequestParams) (*pb.TapByResourceReques
 	}, nil
 }
 
+// parseStatusRange parses an HTTP status code or an inclusive range of them.
+func parseStatusRange(s string) (uint32, uint32, error) {
+	bounds := strings.SplitN(s, "-", 2)
+	min, err := parseHTTPStatus(bounds[0])
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid HTTP status %q: %s", s, err)
+	}
+	max := min
+	if len(bounds) == 2 {
+		if max, err = parseHTTPStatus(bounds[1]); err != nil {
+			return 0, 0, fmt.Errorf("invalid HTTP status %q: %s", s, err)
+		}
+	}
+	if min > max {
+		return 0, 0, fmt.Errorf("invalid HTTP status %q: range is empty", s)
+	}
+	return min, max, nil
+}
+
+func parseHTTPStatus(s string) (uint32, error) {
+	code, err := strconv.ParseUint(strings.TrimSpace(s), 10, 32)
+	if err != nil {
+		return 0, err
+	}
+	if code < 100 || code > 599 {
+		return 0, fmt.Errorf("%d is not between 100 and 599", code)
+	}
+	return uint32(code), nil
+}
+
+// parseGrpcStatus parses a gRPC status code given either as a number or by
+// name, ignoring case.
+func parseGrpcStatus(s string) (uint32, error) {
+	if code, err := strconv.ParseUint(s, 10, 32); err == nil {
+		if code > uint64(codes.Unauthenticated) {
+			return 0, fmt.Errorf("unknown gRPC status %q", s)
+		}
+		return uint32(code), nil
+	}
+	for code := codes.OK; code <= codes.Unauthenticated; code++ {
+		if strings.EqualFold(code.String(), s) {
+			return uint32(code), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown gRPC status %q", s)
+}
+
 func buildMatchHTTP(match *pb.TapByResourceRequest_Match_Http) pb.TapByResourceRequest_Match {
 	return pb.TapByResourceRequest_Match{
 		Match: &pb.TapByResourceRequest_Match_Http_{
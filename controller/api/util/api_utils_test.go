@@ -4,7 +4,10 @@ import (
 	"errors"
 	"reflect"
 	"testing"
+	"time"
 
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
 	pb "github.com/linkerd/linkerd2/controller/gen/public"
 	"github.com/linkerd/linkerd2/pkg/k8s"
 	"google.golang.org/grpc/codes"
@@ -115,6 +118,60 @@ func TestBuildStatSummaryRequest(t *testing.T) {
 		}
 	})
 
+	t.Run("Builds time ranges", func(t *testing.T) {
+		end := time.Date(2019, 11, 5, 10, 0, 0, 0, time.UTC)
+		statSummaryRequest, err := BuildStatSummaryRequest(
+			StatsSummaryRequestParams{
+				StatsBaseRequestParams: StatsBaseRequestParams{
+					ResourceType: k8s.Deployment,
+				},
+				StartTime: end.Add(-time.Hour),
+				EndTime:   end,
+				Step:      time.Minute,
+			},
+		)
+		if err != nil {
+			t.Fatalf("Unexpected error from BuildStatSummaryRequest: %s", err)
+		}
+		if statSummaryRequest.TimeWindow != "1m" {
+			t.Fatalf("Expected the time window to default to the step, got %s", statSummaryRequest.TimeWindow)
+		}
+		start, _ := ptypes.Timestamp(statSummaryRequest.GetTimeRange().GetStart())
+		step, _ := ptypes.Duration(statSummaryRequest.GetTimeRange().GetStep())
+		if !start.Equal(end.Add(-time.Hour)) || step != time.Minute {
+			t.Fatalf("Unexpected time range from BuildStatSummaryRequest: %v", statSummaryRequest.GetTimeRange())
+		}
+	})
+
+	t.Run("Rejects invalid time ranges", func(t *testing.T) {
+		end := time.Date(2019, 11, 5, 10, 0, 0, 0, time.UTC)
+		expectations := []struct {
+			start time.Time
+			step  time.Duration
+			msg   string
+		}{
+			{end.Add(time.Minute), time.Minute, "the end of the time range needs to be after its start"},
+			{end.Add(-time.Hour), time.Second, "time range step needs to be at least 15s"},
+			{end.Add(-time.Hour * 24 * 30), time.Minute, "time range has too many steps (43201), the maximum is 11000"},
+		}
+
+		for _, exp := range expectations {
+			_, err := BuildStatSummaryRequest(
+				StatsSummaryRequestParams{
+					StatsBaseRequestParams: StatsBaseRequestParams{
+						ResourceType: k8s.Deployment,
+					},
+					StartTime: exp.start,
+					EndTime:   end,
+					Step:      exp.step,
+				},
+			)
+			if err == nil || err.Error() != exp.msg {
+				t.Fatalf("BuildStatSummaryRequest should have returned: %s but got: %v", exp.msg, err)
+			}
+		}
+	})
+
 	t.Run("Rejects invalid Kubernetes resource types", func(t *testing.T) {
 		expectations := map[string]string{
 			"foo": "cannot find Kubernetes canonical name from friendly name [foo]",
@@ -139,6 +196,41 @@ func TestBuildStatSummaryRequest(t *testing.T) {
 	})
 }
 
+func TestParseLatencyQuantiles(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected []float64
+		err      string
+	}{
+		{"", nil, ""},
+		{"0.75,0.999", []float64{0.75, 0.999}, ""},
+		{"p75, p99.9", []float64{0.75, 0.999}, ""},
+		{"p99.99", []float64{0.9999}, ""},
+		{"99.9", nil, "latency quantile 99.9 needs to be between 0 and 1, or between p0 and p100"},
+		{"p100", nil, "latency quantile p100 needs to be between 0 and 1, or between p0 and p100"},
+		{"high", nil, "invalid latency quantile: high"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.input, func(t *testing.T) {
+			quantiles, err := ParseLatencyQuantiles(tc.input)
+			if tc.err != "" {
+				if err == nil || err.Error() != tc.err {
+					t.Fatalf("Expected error %q, got %v", tc.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(quantiles, tc.expected) {
+				t.Fatalf("Expected quantiles %v, got %v", tc.expected, quantiles)
+			}
+		})
+	}
+}
+
 func TestBuildTopRoutesRequest(t *testing.T) {
 	t.Run("Parses valid time windows", func(t *testing.T) {
 		expectations := []string{
@@ -190,6 +282,87 @@ func TestBuildTopRoutesRequest(t *testing.T) {
 	})
 }
 
+func TestBuildTapByResourceRequest(t *testing.T) {
+	t.Run("Builds server-side response matches", func(t *testing.T) {
+		req, err := BuildTapByResourceRequest(TapRequestParams{
+			Resource:   "deploy/web",
+			Namespace:  "emojivoto",
+			Status:     "500-599",
+			MinLatency: time.Second,
+			GrpcStatus: "unavailable",
+			Headers:    map[string]string{"x-tenant": "blue", "x-canary": "true"},
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		expected := []*pb.TapByResourceRequest_Match_Http{
+			{Match: &pb.TapByResourceRequest_Match_Http_Status{
+				Status: &pb.TapByResourceRequest_Match_Http_StatusRange{Min: 500, Max: 599},
+			}},
+			{Match: &pb.TapByResourceRequest_Match_Http_MinLatency{MinLatency: ptypes.DurationProto(time.Second)}},
+			{Match: &pb.TapByResourceRequest_Match_Http_GrpcStatus{GrpcStatus: uint32(codes.Unavailable)}},
+			{Match: &pb.TapByResourceRequest_Match_Http_Header_{
+				Header: &pb.TapByResourceRequest_Match_Http_Header{Name: "x-canary", Value: "true"},
+			}},
+			{Match: &pb.TapByResourceRequest_Match_Http_Header_{
+				Header: &pb.TapByResourceRequest_Match_Http_Header{Name: "x-tenant", Value: "blue"},
+			}},
+		}
+		matches := req.GetMatch().GetAll().GetMatches()
+		if len(matches) != len(expected) {
+			t.Fatalf("Expected %d matches, got %d: %v", len(expected), len(matches), matches)
+		}
+		for i, match := range matches {
+			if !proto.Equal(match.GetHttp(), expected[i]) {
+				t.Fatalf("Expected match %d to be %v, got %v", i, expected[i], match.GetHttp())
+			}
+		}
+	})
+
+	t.Run("Returns expected errors on invalid input", func(t *testing.T) {
+		expectations := []struct {
+			params TapRequestParams
+			err    string
+		}{
+			{
+				TapRequestParams{Resource: "deploy/web", Status: "5xx"},
+				"invalid HTTP status \"5xx\": strconv.ParseUint: parsing \"5xx\": invalid syntax",
+			},
+			{
+				TapRequestParams{Resource: "deploy/web", Status: "599-500"},
+				"invalid HTTP status \"599-500\": range is empty",
+			},
+			{
+				TapRequestParams{Resource: "deploy/web", Status: "700"},
+				"invalid HTTP status \"700\": 700 is not between 100 and 599",
+			},
+			{
+				TapRequestParams{Resource: "deploy/web", GrpcStatus: "17"},
+				"unknown gRPC status \"17\"",
+			},
+			{
+				TapRequestParams{Resource: "deploy/web", GrpcStatus: "Broken"},
+				"unknown gRPC status \"Broken\"",
+			},
+			{
+				TapRequestParams{Resource: "deploy/web", MinLatency: -time.Second},
+				"minimum latency must not be negative: -1s",
+			},
+		}
+
+		for _, exp := range expectations {
+			_, err := BuildTapByResourceRequest(exp.params)
+			if err == nil {
+				t.Fatalf("Expected error %q, got nothing", exp.err)
+			}
+			if err.Error() != exp.err {
+				t.Fatalf("Expected error %q, got %q", exp.err, err)
+			}
+		}
+	})
+}
+
 func TestBuildResource(t *testing.T) {
 	type resourceExp struct {
 		namespace string
@@ -2,16 +2,25 @@ package identity
 
 import (
 	"context"
+	cryptotls "crypto/tls"
+	"crypto/x509"
+	"errors"
 	"flag"
 	"fmt"
+	"io/ioutil"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"path/filepath"
+	"strings"
 	"syscall"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 
 	"github.com/golang/protobuf/ptypes"
@@ -31,6 +40,22 @@ import (
 	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 )
 
+const (
+	issuerModeLocal    = "local"
+	issuerModeExternal = "external"
+
+	tokenValidatorTokenReview = "tokenreview"
+	tokenValidatorJWKS        = "jwks"
+
+	// apiServerJWKSPath is where the API server serves the keys it signs
+	// service account tokens with.
+	apiServerJWKSPath = "/openid/v1/jwks"
+
+	// tokenCacheSize bounds the number of validated tokens remembered when
+	// -token-cache-ttl is set; there's roughly one per meshed pod.
+	tokenCacheSize = 10000
+)
+
 // TODO watch trustAnchorsPath for changes
 // TODO watch issuerPath for changes
 // TODO restrict servicetoken audiences (and lifetimes)
@@ -45,6 +70,45 @@ func Main(args []string) {
 	issuerPath := cmd.String("issuer",
 		"/var/run/linkerd/identity/issuer",
 		"path to directory containing issuer credentials")
+	issuerMode := cmd.String("issuer-mode", issuerModeLocal,
+		fmt.Sprintf("how certificates are signed: %q signs them with the issuer credentials, %q forwards CSRs to an external signer",
+			issuerModeLocal, issuerModeExternal))
+	externalSignerURL := cmd.String("external-signer-url", "",
+		"URL of the external signer's sign endpoint (used when -issuer-mode=external)")
+	externalSignerTokenPath := cmd.String("external-signer-token", "",
+		"path to a file holding the token sent to the external signer")
+	externalSignerCAPath := cmd.String("external-signer-ca", "",
+		"path to a PEM file holding the CA certificates used to verify the external signer's TLS certificate")
+	externalSignerTimeout := cmd.Duration("external-signer-timeout", identity.DefaultExternalIssuerTimeout,
+		"maximum time to wait for the external signer to sign a certificate")
+	issuerRenewBefore := cmd.Duration("issuer-renew-before", 0,
+		fmt.Sprintf("renew the issuer certificate this long before it expires, using the trust anchor credentials stored in the %s Secret (disabled if 0)",
+			consts.IdentityTrustAnchorSecretName))
+	issuerRenewLifetime := cmd.Duration("issuer-renew-lifetime", tls.DefaultLifetime,
+		"validity period of renewed issuer certificates")
+	issuerRenewInterval := cmd.Duration("issuer-renew-check-interval", 10*time.Minute,
+		"how often to check whether the issuer certificate needs to be renewed")
+	auditLogPath := cmd.String("audit-log", "",
+		"path to a file to which a JSON record of each issued certificate is appended (disabled if empty)")
+	tokenValidator := cmd.String("token-validator", tokenValidatorTokenReview,
+		fmt.Sprintf("how proxies' service account tokens are validated: %q sends them to the TokenReview API, %q verifies bound tokens locally against the API server's JWKS and sends other tokens to the TokenReview API",
+			tokenValidatorTokenReview, tokenValidatorJWKS))
+	tokenAudiences := cmd.String("token-audiences", "",
+		"comma-separated list of audiences proxies' service account tokens must be scoped to (any audience if empty); proxies present their pod's default token, so one of them must be an audience of the API server")
+	tokenIssuer := cmd.String("token-issuer", "",
+		fmt.Sprintf("issuer of bound service account tokens, checked when -token-validator=%s (any issuer if empty)", tokenValidatorJWKS))
+	jwksURL := cmd.String("jwks-url", "",
+		fmt.Sprintf("URL of the JWKS service account tokens are signed with (the API server's %s if empty)", apiServerJWKSPath))
+	tokenCacheTTL := cmd.Duration("token-cache-ttl", 0,
+		"how long successfully validated tokens are remembered, saving a validation when proxies retry (disabled if 0)")
+	certifyRate := cmd.Float64("certify-rate", 0,
+		"maximum number of certificate requests served per second across all proxies (unlimited if 0)")
+	certifyBurst := cmd.Int("certify-burst", 100,
+		"number of certificate requests that may exceed -certify-rate in a burst")
+	certifyIdentityRate := cmd.Float64("certify-identity-rate", 0,
+		"maximum number of certificates issued per second for each identity (unlimited if 0)")
+	certifyIdentityBurst := cmd.Int("certify-identity-burst", 10,
+		"number of certificates that may be issued for an identity in excess of -certify-identity-rate in a burst")
 
 	var issuerPathCrt string
 	var issuerPathKey string
@@ -53,6 +117,19 @@ func Main(args []string) {
 
 	flags.ConfigureAndParse(cmd, args)
 
+	if *issuerMode != issuerModeLocal && *issuerMode != issuerModeExternal {
+		log.Fatalf("Invalid issuer mode: %s", *issuerMode)
+	}
+	if *issuerMode == issuerModeExternal && *externalSignerURL == "" {
+		log.Fatalf("-external-signer-url must be set when -issuer-mode=%s", issuerModeExternal)
+	}
+	if *tokenValidator != tokenValidatorTokenReview && *tokenValidator != tokenValidatorJWKS {
+		log.Fatalf("Invalid token validator: %s", *tokenValidator)
+	}
+	if *issuerRenewBefore > 0 && *issuerMode != issuerModeLocal {
+		log.Fatalf("-issuer-renew-before can only be set when -issuer-mode=%s", issuerModeLocal)
+	}
+
 	cfg, err := config.Global(consts.MountPathGlobalConfig)
 	if err != nil {
 		log.Fatalf("Failed to load config: %s", err.Error())
@@ -84,6 +161,15 @@ func Main(args []string) {
 		log.Fatalf("Invalid trust domain: %s", err.Error())
 	}
 
+	var spiffeIDs identity.SPIFFEIDs
+	if td := idctx.GetSpiffeTrustDomain(); td != "" {
+		spiffeDom, err := idctl.NewSPIFFEDomain(dom, td)
+		if err != nil {
+			log.Fatalf("Invalid SPIFFE trust domain: %s", err)
+		}
+		spiffeIDs = spiffeDom
+	}
+
 	trustAnchors, err := tls.DecodePEMCertPool(idctx.GetTrustAnchorsPem())
 	if err != nil {
 		log.Fatalf("Failed to read trust anchors: %s", err)
@@ -117,12 +203,14 @@ func Main(args []string) {
 	//
 	// Create and start FS creds watcher
 	//
-	watcher := idctl.NewFsCredsWatcher(*issuerPath, issuerEvent, issuerError)
-	go func() {
-		if err := watcher.StartWatching(ctx); err != nil {
-			log.Fatalf("Failed to start creds watcher: %s", err)
-		}
-	}()
+	if *issuerMode == issuerModeLocal {
+		watcher := idctl.NewFsCredsWatcher(*issuerPath, issuerEvent, issuerError)
+		go func() {
+			if err := watcher.StartWatching(ctx); err != nil {
+				log.Fatalf("Failed to start creds watcher: %s", err)
+			}
+		}()
+	}
 
 	//
 	// Create k8s API
@@ -131,10 +219,44 @@ func Main(args []string) {
 	if err != nil {
 		log.Fatalf("Failed to load kubeconfig: %s: %s", *kubeConfigPath, err)
 	}
-	v, err := idctl.NewK8sTokenValidator(k8sAPI, dom)
+	var audiences []string
+	if *tokenAudiences != "" {
+		audiences = strings.Split(*tokenAudiences, ",")
+	}
+	v, err := idctl.NewK8sTokenValidator(k8sAPI, dom, audiences)
 	if err != nil {
 		log.Fatalf("Failed to initialize identity service: %s", err)
 	}
+	if *tokenValidator == tokenValidatorJWKS {
+		v, err = newJWTValidator(ctx, k8sAPI, dom, *jwksURL, *tokenIssuer, audiences, v)
+		if err != nil {
+			log.Fatalf("Failed to initialize token validator: %s", err)
+		}
+	}
+	if len(audiences) > 0 {
+		if err := checkProxyTokenAudiences(ctx, v, audiences); err != nil {
+			log.Fatalf("Invalid token audiences: %s", err)
+		}
+	}
+	if *tokenCacheTTL > 0 {
+		v, err = identity.NewValidatorCache(v, *tokenCacheTTL, tokenCacheSize)
+		if err != nil {
+			log.Fatalf("Failed to initialize token validator: %s", err)
+		}
+	}
+
+	var limiter *identity.RateLimiter
+	if *certifyRate > 0 || *certifyIdentityRate > 0 {
+		limiter, err = identity.NewRateLimiter(*certifyRate, *certifyBurst, *certifyIdentityRate, *certifyIdentityBurst)
+		if err != nil {
+			log.Fatalf("Invalid rate limits: %s", err)
+		}
+	}
+
+	denylist := idctl.NewConfigMapDenylist(k8sAPI, controllerNS)
+	if !denylist.Start(ctx.Done()) {
+		log.Fatalf("Failed to sync identity denylist")
+	}
 
 	// Create K8s event recorder
 	eventBroadcaster := record.NewBroadcaster()
@@ -152,16 +274,50 @@ func Main(args []string) {
 		recorder.Event(deployment, eventType, reason, message)
 	}
 
+	var audit identity.AuditSink
+	if *auditLogPath != "" {
+		sink, err := identity.NewFileAuditSink(*auditLogPath)
+		if err != nil {
+			log.Fatalf("Failed to open audit log: %s", err)
+		}
+		defer sink.Close()
+		audit = sink
+	}
+
 	//
 	// Create, initialize and run service
 	//
-	svc := identity.NewService(v, trustAnchors, &validity, recordEventFunc, expectedName, issuerPathCrt, issuerPathKey)
-	if err = svc.Initialize(); err != nil {
-		log.Fatalf("Failed to initialize identity service: %s", err)
+	var svc *identity.Service
+	if *issuerMode == issuerModeExternal {
+		client, err := newExternalSignerClient(*externalSignerCAPath, *externalSignerTimeout)
+		if err != nil {
+			log.Fatalf("Failed to configure external signer client: %s", err)
+		}
+		issuer := identity.NewExternalIssuer(*externalSignerURL, *externalSignerTokenPath, trustAnchors, &validity, client)
+		svc = identity.NewServiceWithIssuer(v, denylist, spiffeIDs, audit, limiter, issuer)
+		log.Infof("Forwarding certificate signing requests to %s", *externalSignerURL)
+	} else {
+		svc = identity.NewService(v, denylist, spiffeIDs, audit, limiter, trustAnchors, &validity, recordEventFunc, expectedName, issuerPathCrt, issuerPathKey)
+		if err = svc.Initialize(); err != nil {
+			log.Fatalf("Failed to initialize identity service: %s", err)
+		}
+		go func() {
+			svc.Run(issuerEvent, issuerError)
+		}()
+
+		if *issuerRenewBefore > 0 {
+			if idctx.Scheme != k8s.IdentityIssuerSchemeLinkerd {
+				log.Fatalf("Issuer certificates using the %s scheme cannot be renewed by the identity controller", idctx.Scheme)
+			}
+			renewValidity := tls.Validity{
+				ClockSkewAllowance: validity.ClockSkewAllowance,
+				Lifetime:           *issuerRenewLifetime,
+			}
+			renewer := idctl.NewIssuerRenewer(k8sAPI, controllerNS, expectedName, trustAnchors, renewValidity, *issuerRenewBefore, recordEventFunc)
+			go renewer.Run(ctx, *issuerRenewInterval)
+			log.Infof("Renewing the issuer certificate %s before it expires", *issuerRenewBefore)
+		}
 	}
-	go func() {
-		svc.Run(issuerEvent, issuerError)
-	}()
 
 	//
 	// Bind and serve
@@ -187,3 +343,79 @@ func Main(args []string) {
 	log.Infof("shutting down gRPC server on %s", *addr)
 	srv.GracefulStop()
 }
+
+// checkProxyTokenAudiences makes sure the service account tokens proxies
+// authenticate with are scoped to one of the expected audiences. Proxies
+// present the token Kubernetes mounts into every pod, which is the same kind
+// of token as the identity controller's own, so the latter is validated.
+func checkProxyTokenAudiences(ctx context.Context, v identity.Validator, audiences []string) error {
+	tok, err := ioutil.ReadFile(consts.IdentityServiceAccountTokenPath)
+	if err != nil {
+		log.Warnf("Cannot check that proxies' service account tokens are scoped to the audiences %s: %s", strings.Join(audiences, ", "), err)
+		return nil
+	}
+
+	if _, err := v.Validate(ctx, tok); err != nil {
+		switch err.(type) {
+		case identity.InvalidToken, identity.NotAuthenticated:
+			return fmt.Errorf("proxies authenticate with their pod's default service account token, which is rejected: %s", err)
+		}
+		log.Warnf("Cannot check that proxies' service account tokens are scoped to the audiences %s: %s", strings.Join(audiences, ", "), err)
+	}
+	return nil
+}
+
+// newJWTValidator returns a validator verifying bound service account tokens
+// locally, and handing other tokens to fallback.
+func newJWTValidator(
+	ctx context.Context,
+	k8sAPI *k8s.KubernetesAPI,
+	dom *idctl.TrustDomain,
+	jwksURL, issuer string,
+	audiences []string,
+	fallback identity.Validator,
+) (identity.Validator, error) {
+	var keys *idctl.JWKS
+	if jwksURL == "" {
+		keys = idctl.NewJWKS(func() ([]byte, error) {
+			return k8sAPI.CoreV1().RESTClient().Get().AbsPath(apiServerJWKSPath).DoRaw()
+		})
+	} else {
+		keys = idctl.NewHTTPJWKS(jwksURL, &http.Client{Timeout: 10 * time.Second})
+	}
+
+	factory := informers.NewSharedInformerFactory(k8sAPI, 10*time.Minute)
+	pods := factory.Core().V1().Pods()
+	synced := pods.Informer().HasSynced
+	factory.Start(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), synced) {
+		return nil, errors.New("failed to sync pods")
+	}
+
+	log.Infof("Verifying bound service account tokens against the JWKS")
+	return idctl.NewJWTValidator(dom, keys, issuer, audiences, pods.Lister(), fallback), nil
+}
+
+// newExternalSignerClient returns the HTTP client used to reach the external
+// signer. If caPath is set, the signer's TLS certificate is verified with the
+// CA certificates read from it rather than with the system roots.
+func newExternalSignerClient(caPath string, timeout time.Duration) (*http.Client, error) {
+	client := &http.Client{Timeout: timeout}
+	if caPath == "" {
+		return client, nil
+	}
+
+	pem, err := ioutil.ReadFile(caPath)
+	if err != nil {
+		return nil, err
+	}
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificates found in %s", caPath)
+	}
+	client.Transport = &http.Transport{
+		Proxy:           http.ProxyFromEnvironment,
+		TLSClientConfig: &cryptotls.Config{RootCAs: roots},
+	}
+	return client, nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/linkerd/linkerd2/controller/cmd/identity"
 	proxyinjector "github.com/linkerd/linkerd2/controller/cmd/proxy-injector"
 	publicapi "github.com/linkerd/linkerd2/controller/cmd/public-api"
+	servicemirror "github.com/linkerd/linkerd2/controller/cmd/service-mirror"
 	spvalidator "github.com/linkerd/linkerd2/controller/cmd/sp-validator"
 	"github.com/linkerd/linkerd2/controller/cmd/tap"
 )
@@ -30,6 +31,8 @@ func main() {
 		proxyinjector.Main(os.Args[2:])
 	case "public-api":
 		publicapi.Main(os.Args[2:])
+	case "service-mirror":
+		servicemirror.Main(os.Args[2:])
 	case "sp-validator":
 		spvalidator.Main(os.Args[2:])
 	case "tap":
@@ -3,6 +3,9 @@ package publicapi
 import (
 	"context"
 	"flag"
+	"fmt"
+	"io/ioutil"
+	"net/http"
 	"os"
 	"os/signal"
 	"strings"
@@ -16,7 +19,7 @@ import (
 	"github.com/linkerd/linkerd2/pkg/flags"
 	pkgK8s "github.com/linkerd/linkerd2/pkg/k8s"
 	"github.com/linkerd/linkerd2/pkg/trace"
-	promApi "github.com/prometheus/client_golang/api"
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -27,6 +30,11 @@ func Main(args []string) {
 	addr := cmd.String("addr", ":8085", "address to serve on")
 	kubeConfigPath := cmd.String("kubeconfig", "", "path to kube config")
 	prometheusURL := cmd.String("prometheus-url", "http://127.0.0.1:9090", "prometheus url")
+	prometheusHeaders := headerFlag{}
+	cmd.Var(prometheusHeaders, "prometheus-header", "header to add to prometheus requests, as \"Name: value\"; can be repeated")
+	prometheusBearerTokenFile := cmd.String("prometheus-bearer-token-file", "", "path to a bearer token to authenticate with prometheus")
+	prometheusUsername := cmd.String("prometheus-username", "", "username to authenticate with prometheus using basic auth")
+	prometheusPasswordFile := cmd.String("prometheus-password-file", "", "path to the password to authenticate with prometheus using basic auth")
 	metricsAddr := cmd.String("metrics-addr", ":9995", "address to serve scrapable metrics on")
 	destinationAPIAddr := cmd.String("destination-addr", "127.0.0.1:8086", "address of destination service")
 	controllerNamespace := cmd.String("controller-namespace", "linkerd", "namespace in which Linkerd is installed")
@@ -53,7 +61,22 @@ func Main(args []string) {
 		log.Fatalf("Failed to initialize K8s API: %s", err)
 	}
 
-	prometheusClient, err := promApi.NewClient(promApi.Config{Address: *prometheusURL})
+	prometheusPassword := ""
+	if *prometheusPasswordFile != "" {
+		password, err := ioutil.ReadFile(*prometheusPasswordFile)
+		if err != nil {
+			log.Fatalf("Failed to read the prometheus password: %s", err)
+		}
+		prometheusPassword = strings.TrimSpace(string(password))
+	}
+
+	prometheusClient, err := public.NewPrometheusClient(public.PrometheusClientConfig{
+		Address:         *prometheusURL,
+		Headers:         http.Header(prometheusHeaders),
+		BearerTokenFile: *prometheusBearerTokenFile,
+		Username:        *prometheusUsername,
+		Password:        prometheusPassword,
+	})
 	if err != nil {
 		log.Fatal(err.Error())
 	}
@@ -76,7 +99,7 @@ func Main(args []string) {
 
 	server := public.NewServer(
 		*addr,
-		prometheusClient,
+		public.NewPrometheusSource(promv1.NewAPI(prometheusClient)),
 		destinationClient,
 		k8sAPI,
 		*controllerNamespace,
@@ -98,3 +121,25 @@ func Main(args []string) {
 	log.Infof("shutting down HTTP server on %+v", *addr)
 	server.Shutdown(context.Background())
 }
+
+// headerFlag collects the values of a repeated "Name: value" header flag.
+type headerFlag http.Header
+
+func (h headerFlag) String() string {
+	headers := []string{}
+	for name, values := range h {
+		for _, value := range values {
+			headers = append(headers, name+": "+value)
+		}
+	}
+	return strings.Join(headers, ", ")
+}
+
+func (h headerFlag) Set(header string) error {
+	parts := strings.SplitN(header, ":", 2)
+	if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" {
+		return fmt.Errorf("invalid header \"%s\", expected \"Name: value\"", header)
+	}
+	http.Header(h).Add(strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1]))
+	return nil
+}
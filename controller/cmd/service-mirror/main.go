@@ -0,0 +1,56 @@
+package servicemirror
+
+import (
+	"flag"
+	"os"
+	"os/signal"
+	"syscall"
+
+	servicemirror "github.com/linkerd/linkerd2/controller/service-mirror"
+	"github.com/linkerd/linkerd2/pkg/admin"
+	"github.com/linkerd/linkerd2/pkg/flags"
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// Main executes the service-mirror subcommand
+func Main(args []string) {
+	cmd := flag.NewFlagSet("service-mirror", flag.ExitOnError)
+
+	metricsAddr := cmd.String("metrics-addr", ":9999", "address to serve scrapable metrics on")
+	kubeConfigPath := cmd.String("kubeconfig", "", "path to the local kube config")
+	namespace := cmd.String("namespace", "linkerd", "namespace containing the Secrets with the credentials of the remote clusters")
+	selector := cmd.String("selector", k8s.ExportedServiceLabel+"=true", "label selector of the remote Services to mirror")
+
+	flags.ConfigureAndParse(cmd, args)
+
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
+
+	serviceSelector, err := labels.Parse(*selector)
+	if err != nil {
+		log.Fatalf("Invalid selector %q: %s", *selector, err)
+	}
+
+	k8sAPI, err := k8s.NewAPI(*kubeConfigPath, "", "", 0)
+	if err != nil {
+		log.Fatalf("Failed to initialize K8s API: %s", err)
+	}
+
+	done := make(chan struct{})
+	watcher := servicemirror.NewClusterWatcher(k8sAPI.Interface, *namespace, serviceSelector)
+	stopped := make(chan struct{})
+	go func() {
+		watcher.Run(done)
+		close(stopped)
+	}()
+
+	go admin.StartServer(*metricsAddr)
+
+	<-stop
+
+	log.Info("shutting down service mirror controller")
+	close(done)
+	<-stopped
+}
@@ -16,6 +16,7 @@ import (
 	pkgK8s "github.com/linkerd/linkerd2/pkg/k8s"
 	"github.com/linkerd/linkerd2/pkg/trace"
 	log "github.com/sirupsen/logrus"
+	"k8s.io/client-go/tools/record"
 )
 
 const defaultDomain = "cluster.local"
@@ -32,6 +33,10 @@ func Main(args []string) {
 	tlsCertPath := cmd.String("tls-cert", pkgK8s.MountPathTLSCrtPEM, "path to TLS Cert PEM")
 	tlsKeyPath := cmd.String("tls-key", pkgK8s.MountPathTLSKeyPEM, "path to TLS Key PEM")
 	disableCommonNames := cmd.Bool("disable-common-names", false, "disable checks for Common Names (for development)")
+	clientBufferSize := cmd.Int("client-buffer-size", 1000,
+		"number of tap events buffered for each client; events are dropped for the clients falling further behind")
+	auditSessions := cmd.Int("audit-sessions", 1000, "number of recent tap sessions this replica keeps in memory for `linkerd tap audit` when they aren't recorded as events; the logs are the complete audit trail")
+	auditEvents := cmd.Bool("audit-events", false, "record the start and end of tap sessions, and denied attempts, as Kubernetes events on the tapped resources, from which `linkerd tap audit` lists them")
 
 	traceCollector := flags.AddTraceFlags(cmd)
 
@@ -73,7 +78,7 @@ func Main(args []string) {
 			log.Warnf("failed to initialize tracing: %s", err)
 		}
 	}
-	grpcTapServer := tap.NewGrpcTapServer(*tapPort, *controllerNamespace, trustDomain, k8sAPI)
+	grpcTapServer := tap.NewGrpcTapServer(*tapPort, *controllerNamespace, trustDomain, *clientBufferSize, k8sAPI)
 
 	// TODO: make this configurable for local development
 	cert, err := tls.LoadX509KeyPair(*tlsCertPath, *tlsKeyPath)
@@ -81,7 +86,19 @@ func Main(args []string) {
 		log.Fatal(err.Error())
 	}
 
-	apiServer, apiLis, err := tap.NewAPIServer(*apiServerAddr, cert, k8sAPI, grpcTapServer, *disableCommonNames)
+	// the pod name, which names the IDs of this replica's tap sessions
+	replica, err := os.Hostname()
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
+	var recorder record.EventRecorder
+	if *auditEvents {
+		recorder = tap.NewEventRecorder(k8sAPI.Client)
+	}
+	sessions := tap.NewSessionLog(*auditSessions, replica, k8sAPI, recorder)
+
+	apiServer, apiLis, err := tap.NewAPIServer(*apiServerAddr, cert, k8sAPI, grpcTapServer, sessions, *controllerNamespace, replica, *disableCommonNames)
 	if err != nil {
 		log.Fatal(err.Error())
 	}
@@ -31,6 +31,7 @@ type ServiceProfileSpec struct {
 	Routes       []*RouteSpec   `json:"routes"`
 	RetryBudget  *RetryBudget   `json:"retryBudget,omitempty"`
 	DstOverrides []*WeightedDst `json:"dstOverrides,omitempty"`
+	Objectives   []*Objective   `json:"objectives,omitempty"`
 }
 
 // RouteSpec specifies a Route resource.
@@ -86,6 +87,30 @@ type WeightedDst struct {
 	Weight    resource.Quantity `json:"weight"`
 }
 
+// Objective is a service level objective for the traffic to the service, or to
+// one of its routes.
+type Objective struct {
+	Name string `json:"name"`
+	// Route is the name of the route the objective applies to. The objective
+	// applies to all of the service's traffic if it's empty.
+	Route string `json:"route,omitempty"`
+	// Window is the period over which the objective is evaluated, as a
+	// Prometheus duration (e.g. "30d").
+	Window string `json:"window"`
+	// SuccessRate is the ratio of responses which should be successful.
+	SuccessRate float64           `json:"successRate,omitempty"`
+	Latency     *LatencyObjective `json:"latency,omitempty"`
+}
+
+// LatencyObjective describes the ratio of responses which should be faster
+// than a threshold.
+type LatencyObjective struct {
+	// Threshold is a latency (e.g. "300ms"), which must be one of the bounds of
+	// the proxy's latency histogram buckets.
+	Threshold string  `json:"threshold"`
+	Target    float64 `json:"target"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // ServiceProfileList is a list of ServiceProfile resources.
@@ -24,6 +24,43 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LatencyObjective) DeepCopyInto(out *LatencyObjective) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LatencyObjective.
+func (in *LatencyObjective) DeepCopy() *LatencyObjective {
+	if in == nil {
+		return nil
+	}
+	out := new(LatencyObjective)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Objective) DeepCopyInto(out *Objective) {
+	*out = *in
+	if in.Latency != nil {
+		in, out := &in.Latency, &out.Latency
+		*out = new(LatencyObjective)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Objective.
+func (in *Objective) DeepCopy() *Objective {
+	if in == nil {
+		return nil
+	}
+	out := new(Objective)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Range) DeepCopyInto(out *Range) {
 	*out = *in
@@ -279,6 +316,17 @@ func (in *ServiceProfileSpec) DeepCopyInto(out *ServiceProfileSpec) {
 		*out = new(RetryBudget)
 		**out = **in
 	}
+	if in.Objectives != nil {
+		in, out := &in.Objectives, &out.Objectives
+		*out = make([]*Objective, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Objective)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	return
 }
 
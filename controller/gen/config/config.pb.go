@@ -492,14 +492,17 @@ func (m *AutoInjectContext) XXX_DiscardUnknown() {
 var xxx_messageInfo_AutoInjectContext proto.InternalMessageInfo
 
 type IdentityContext struct {
-	TrustDomain          string             `protobuf:"bytes,1,opt,name=trust_domain,json=trustDomain,proto3" json:"trust_domain,omitempty"`
-	TrustAnchorsPem      string             `protobuf:"bytes,2,opt,name=trust_anchors_pem,json=trustAnchorsPem,proto3" json:"trust_anchors_pem,omitempty"`
-	IssuanceLifetime     *duration.Duration `protobuf:"bytes,3,opt,name=issuance_lifetime,json=issuanceLifetime,proto3" json:"issuance_lifetime,omitempty"`
-	ClockSkewAllowance   *duration.Duration `protobuf:"bytes,4,opt,name=clock_skew_allowance,json=clockSkewAllowance,proto3" json:"clock_skew_allowance,omitempty"`
-	Scheme               string             `protobuf:"bytes,5,opt,name=scheme,proto3" json:"scheme,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+	TrustDomain        string             `protobuf:"bytes,1,opt,name=trust_domain,json=trustDomain,proto3" json:"trust_domain,omitempty"`
+	TrustAnchorsPem    string             `protobuf:"bytes,2,opt,name=trust_anchors_pem,json=trustAnchorsPem,proto3" json:"trust_anchors_pem,omitempty"`
+	IssuanceLifetime   *duration.Duration `protobuf:"bytes,3,opt,name=issuance_lifetime,json=issuanceLifetime,proto3" json:"issuance_lifetime,omitempty"`
+	ClockSkewAllowance *duration.Duration `protobuf:"bytes,4,opt,name=clock_skew_allowance,json=clockSkewAllowance,proto3" json:"clock_skew_allowance,omitempty"`
+	Scheme             string             `protobuf:"bytes,5,opt,name=scheme,proto3" json:"scheme,omitempty"`
+	// When set, issued certificates also carry a SPIFFE ID in this trust
+	// domain as a URI SAN, e.g. spiffe://cluster.local/ns/emojivoto/sa/web.
+	SpiffeTrustDomain    string   `protobuf:"bytes,6,opt,name=spiffe_trust_domain,json=spiffeTrustDomain,proto3" json:"spiffe_trust_domain,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *IdentityContext) Reset()         { *m = IdentityContext{} }
@@ -562,6 +565,13 @@ func (m *IdentityContext) GetScheme() string {
 	return ""
 }
 
+func (m *IdentityContext) GetSpiffeTrustDomain() string {
+	if m != nil {
+		return m.SpiffeTrustDomain
+	}
+	return ""
+}
+
 type LogLevel struct {
 	Level                string   `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -718,69 +728,70 @@ func init() {
 func init() { proto.RegisterFile("config/config.proto", fileDescriptor_cc332a44e926b360) }
 
 var fileDescriptor_cc332a44e926b360 = []byte{
-	// 1012 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x56, 0xdb, 0x6e, 0xdb, 0x46,
-	0x10, 0x85, 0x64, 0x49, 0x96, 0x46, 0x92, 0x2f, 0xeb, 0x1b, 0xed, 0x22, 0xad, 0xcb, 0x22, 0x40,
-	0xd0, 0x16, 0x52, 0x6b, 0x17, 0x49, 0xe0, 0xa7, 0x3a, 0x89, 0x63, 0xa8, 0x71, 0x5b, 0x83, 0x41,
-	0x53, 0xa0, 0x2f, 0x04, 0x45, 0x8e, 0xe8, 0xad, 0x96, 0xbb, 0x0a, 0xb9, 0xb4, 0x9d, 0x8f, 0xe8,
-	0x73, 0xfb, 0xd4, 0x4f, 0xe8, 0xd7, 0xf5, 0x03, 0x8a, 0x9d, 0x5d, 0xba, 0xb6, 0x15, 0xf9, 0x49,
-	0xdc, 0x33, 0xe7, 0x9c, 0x1d, 0x72, 0x66, 0x67, 0x05, 0x1b, 0xb1, 0x92, 0x13, 0x9e, 0x0e, 0xed,
-	0xcf, 0x60, 0x96, 0x2b, 0xad, 0xd8, 0xaa, 0xe0, 0x72, 0x8a, 0x79, 0x72, 0x30, 0xb0, 0xf0, 0xde,
-	0xa7, 0xa9, 0x52, 0xa9, 0xc0, 0x21, 0x85, 0xc7, 0xe5, 0x64, 0x98, 0x94, 0x79, 0xa4, 0xb9, 0x92,
-	0x56, 0xe0, 0xff, 0x55, 0x83, 0xa5, 0x63, 0x21, 0xd8, 0x10, 0x5a, 0xa9, 0x50, 0xe3, 0x48, 0x78,
-	0xb5, 0xfd, 0xda, 0x93, 0xee, 0xc1, 0xce, 0xe0, 0x9e, 0xd3, 0xe0, 0x94, 0xc2, 0x81, 0xa3, 0xb1,
-	0xaf, 0xa1, 0x39, 0xcb, 0xd5, 0xf5, 0x07, 0xaf, 0x4e, 0xfc, 0xed, 0x39, 0xfe, 0xb9, 0x89, 0x06,
-	0x96, 0xc4, 0x0e, 0x60, 0x99, 0xcb, 0x42, 0x47, 0x42, 0x78, 0x4b, 0xc4, 0xf7, 0xe6, 0xf8, 0x23,
-	0x1b, 0x0f, 0x2a, 0xa2, 0xff, 0x6f, 0x1d, 0x5a, 0x76, 0x53, 0xf6, 0x15, 0xac, 0x3b, 0x7a, 0x28,
-	0xa3, 0x0c, 0x8b, 0x59, 0x14, 0x23, 0x25, 0xda, 0x09, 0xd6, 0x5c, 0xe0, 0xa7, 0x0a, 0x67, 0x9f,
-	0x41, 0x37, 0x96, 0x3c, 0x44, 0x19, 0x8d, 0x05, 0x26, 0x94, 0x5f, 0x3b, 0x80, 0x58, 0xf2, 0x13,
-	0x8b, 0x30, 0x0f, 0x96, 0x2f, 0x31, 0x2f, 0xb8, 0x92, 0x94, 0x4c, 0x27, 0xa8, 0x96, 0xec, 0x0d,
-	0xac, 0xf1, 0x04, 0xa5, 0xe6, 0xfa, 0x43, 0x18, 0x2b, 0xa9, 0xf1, 0x5a, 0x7b, 0x0d, 0xca, 0x77,
-	0x7f, 0x3e, 0x5f, 0x47, 0x7c, 0x69, 0x79, 0xc1, 0x2a, 0xbf, 0x0b, 0xb0, 0x77, 0xb0, 0x11, 0x95,
-	0x5a, 0x85, 0x5c, 0xfe, 0x8e, 0xb1, 0xbe, 0xf1, 0x6b, 0x91, 0x9f, 0x3f, 0xe7, 0x77, 0x5c, 0x6a,
-	0x35, 0x22, 0xaa, 0x33, 0x78, 0x51, 0xf7, 0x6a, 0xc1, 0x7a, 0x74, 0x1f, 0x66, 0x4f, 0x61, 0x5b,
-	0x65, 0x5c, 0xff, 0x8a, 0xe3, 0x0b, 0xa5, 0xa6, 0x6f, 0x79, 0x82, 0x27, 0x93, 0x09, 0xc6, 0xba,
-	0xf0, 0x96, 0xe9, 0x55, 0x17, 0x44, 0xd9, 0x63, 0x58, 0x89, 0x45, 0x59, 0x68, 0xcc, 0xc3, 0x44,
-	0x65, 0x11, 0x97, 0x5e, 0x9b, 0xde, 0xbe, 0xef, 0xd0, 0x57, 0x04, 0xfa, 0xff, 0xb4, 0xa0, 0x49,
-	0xb5, 0x63, 0xcf, 0xa0, 0x4b, 0xd5, 0x0b, 0x79, 0x16, 0xa5, 0xe8, 0x1a, 0x63, 0xbe, 0xd0, 0x23,
-	0x13, 0x0d, 0x80, 0xa8, 0xf4, 0xcc, 0xbe, 0x87, 0x35, 0x27, 0x94, 0x5c, 0x3b, 0x75, 0xfd, 0x41,
-	0xf5, 0x8a, 0x55, 0x4b, 0xae, 0xad, 0xc3, 0x73, 0xe8, 0x99, 0xef, 0x95, 0x2b, 0x11, 0xce, 0x54,
-	0xae, 0x5d, 0xd3, 0x6c, 0xcd, 0x37, 0x99, 0xca, 0x75, 0xd0, 0x75, 0x54, 0xb3, 0x60, 0xa7, 0xb0,
-	0xc9, 0x53, 0xa9, 0x72, 0x0c, 0xb9, 0x1c, 0xab, 0x52, 0x26, 0x64, 0x50, 0x78, 0x8d, 0xfd, 0xa5,
-	0xc5, 0x0e, 0xcc, 0x4a, 0x46, 0x56, 0x61, 0xa0, 0x82, 0x8d, 0x60, 0xcb, 0x19, 0xa9, 0x52, 0xdf,
-	0x76, 0x6a, 0x3e, 0xe4, 0xb4, 0x61, 0x35, 0x3f, 0x3b, 0x89, 0xb5, 0x7a, 0x0e, 0xbd, 0xdb, 0xc9,
-	0xb8, 0x16, 0x58, 0xf4, 0x36, 0xfc, 0xff, 0x2c, 0xd8, 0x77, 0x00, 0x51, 0x92, 0x71, 0x69, 0x75,
-	0xcb, 0x0f, 0xe9, 0x3a, 0x44, 0x24, 0xd5, 0x11, 0xf4, 0xef, 0xe4, 0x4c, 0x85, 0x5e, 0x28, 0xec,
-	0xa9, 0x5b, 0xc9, 0xb2, 0x63, 0x68, 0xe7, 0x58, 0xa8, 0x32, 0x8f, 0xd1, 0xeb, 0x90, 0xec, 0xf1,
-	0x9c, 0x2c, 0x70, 0x84, 0x00, 0xdf, 0x97, 0x3c, 0xc7, 0x0c, 0xa5, 0x2e, 0x82, 0x1b, 0x19, 0xfb,
-	0x04, 0x3a, 0xb6, 0xfc, 0x25, 0x4f, 0x3c, 0xd8, 0xaf, 0x3d, 0x59, 0x0a, 0xda, 0x04, 0xfc, 0xc2,
-	0x13, 0xf6, 0x14, 0x3a, 0x42, 0xa5, 0xa1, 0xc0, 0x4b, 0x14, 0x5e, 0x97, 0x36, 0xd8, 0x9d, 0xdb,
-	0xe0, 0x4c, 0xa5, 0x67, 0x86, 0x10, 0xb4, 0x85, 0x7b, 0x62, 0x47, 0xb0, 0x9b, 0xf0, 0xc2, 0x1c,
-	0xe0, 0x10, 0xaf, 0x35, 0xe6, 0x32, 0x12, 0xe1, 0x2c, 0x57, 0x13, 0x2e, 0xb0, 0xf0, 0x7a, 0xd4,
-	0xf8, 0x3b, 0x8e, 0x70, 0xe2, 0xe2, 0xe7, 0x2e, 0xcc, 0xbe, 0x80, 0xbe, 0x4d, 0xa8, 0x3a, 0xf6,
-	0x7d, 0x6a, 0xfc, 0x1e, 0x81, 0xef, 0xdc, 0xd9, 0x7f, 0x06, 0xde, 0xfd, 0xa6, 0xbd, 0xe1, 0xaf,
-	0x10, 0x7f, 0xeb, 0x6e, 0x93, 0x3a, 0xa1, 0x7f, 0x0a, 0x4d, 0xdb, 0xb4, 0x8f, 0x00, 0xac, 0xcc,
-	0xcc, 0x28, 0x37, 0x9e, 0x3a, 0x84, 0x98, 0xe1, 0x64, 0xe6, 0xd2, 0xac, 0x14, 0xa6, 0xa1, 0x05,
-	0x8f, 0xed, 0xdc, 0xec, 0x04, 0x60, 0xa0, 0x73, 0x42, 0xfc, 0x3d, 0x68, 0x50, 0x09, 0x18, 0x34,
-	0xa8, 0x6a, 0xc6, 0xa1, 0x1f, 0xd0, 0xb3, 0xff, 0x77, 0x0d, 0x36, 0x3f, 0xf6, 0xd9, 0x8d, 0x6b,
-	0x8e, 0xef, 0x4b, 0x2c, 0x74, 0x18, 0xcf, 0x4a, 0xb7, 0x2b, 0x38, 0xe8, 0xe5, 0xac, 0x34, 0xc7,
-	0xbe, 0x22, 0x64, 0x98, 0xa9, 0xbc, 0xda, 0xb9, 0xef, 0xd0, 0x1f, 0x09, 0x34, 0x45, 0x13, 0x3c,
-	0xe3, 0xd6, 0xc5, 0x8e, 0xc5, 0x36, 0x01, 0xc6, 0xe3, 0x73, 0xe8, 0xd9, 0xa0, 0x73, 0x68, 0x50,
-	0xbc, 0x4b, 0x98, 0xd5, 0xfb, 0x3b, 0xb0, 0x3e, 0x37, 0xc1, 0x8e, 0xea, 0x5e, 0xcd, 0xff, 0xa3,
-	0x0e, 0xab, 0xf7, 0x66, 0xa5, 0xf1, 0xd3, 0x79, 0x59, 0xe8, 0x6a, 0x10, 0xd9, 0xac, 0xbb, 0x84,
-	0xd9, 0x31, 0xc4, 0xbe, 0x84, 0x75, 0x4b, 0x89, 0x64, 0x7c, 0xa1, 0xf2, 0x22, 0x9c, 0x61, 0xe6,
-	0x32, 0x5f, 0xa5, 0xc0, 0xb1, 0xc5, 0xcf, 0x31, 0x63, 0xaf, 0x61, 0x9d, 0x17, 0x45, 0x19, 0xc9,
-	0x18, 0x43, 0xc1, 0x27, 0xa8, 0x79, 0x86, 0x6e, 0x64, 0xec, 0x0e, 0xec, 0x05, 0x38, 0xa8, 0x2e,
-	0xc0, 0xc1, 0x2b, 0x77, 0x01, 0x06, 0x6b, 0x95, 0xe6, 0xcc, 0x49, 0xd8, 0x1b, 0xd8, 0x8c, 0x85,
-	0x8a, 0xa7, 0x61, 0x31, 0xc5, 0xab, 0x30, 0x12, 0x42, 0x5d, 0x99, 0xb8, 0xbb, 0x02, 0x1e, 0xb0,
-	0x62, 0x24, 0x7b, 0x3b, 0xc5, 0xab, 0xe3, 0x4a, 0xc4, 0xb6, 0xa1, 0x55, 0xc4, 0x17, 0x98, 0xa1,
-	0xd7, 0xa4, 0xac, 0xdd, 0xca, 0xdf, 0x87, 0x76, 0xd5, 0xde, 0x6c, 0x13, 0x9a, 0xf6, 0x20, 0xd8,
-	0x0f, 0x60, 0x17, 0xfe, 0x9f, 0x35, 0x58, 0x76, 0xb7, 0x21, 0x5d, 0x66, 0x82, 0xdf, 0x34, 0xa2,
-	0x6b, 0x9a, 0x58, 0xf0, 0xaa, 0x6d, 0x0f, 0xa1, 0x39, 0x11, 0x51, 0x5a, 0x78, 0x4b, 0x34, 0x96,
-	0x1e, 0x2d, 0xba, 0x57, 0x07, 0xaf, 0x45, 0x94, 0x06, 0x96, 0xbb, 0xf7, 0x0d, 0x34, 0xcc, 0xd2,
-	0x74, 0xda, 0xad, 0x5e, 0xa5, 0x67, 0x93, 0xd3, 0x65, 0x24, 0x4a, 0x74, 0x7b, 0xd9, 0xc5, 0x0f,
-	0x8d, 0x76, 0x6d, 0xad, 0xfe, 0xe2, 0xf0, 0xb7, 0x6f, 0x53, 0xae, 0x2f, 0xca, 0xf1, 0x20, 0x56,
-	0xd9, 0xd0, 0xed, 0x54, 0xfd, 0x1e, 0x0c, 0xdd, 0x1c, 0x16, 0x98, 0x0f, 0x53, 0x94, 0xee, 0x9f,
-	0xc9, 0xb8, 0x45, 0xdf, 0xeb, 0xf0, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x08, 0xa6, 0xfc, 0xc1,
-	0xb1, 0x08, 0x00, 0x00,
+	// 1028 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x56, 0xdd, 0x72, 0x1b, 0x35,
+	0x14, 0x1e, 0x3b, 0xb6, 0x63, 0x1f, 0xdb, 0x4d, 0xac, 0xfc, 0x29, 0x61, 0x0a, 0xc1, 0x4c, 0x67,
+	0x3a, 0xc0, 0xd8, 0x90, 0x30, 0x6d, 0x27, 0x57, 0xa4, 0x6d, 0x9a, 0x09, 0x0d, 0x90, 0xd9, 0x42,
+	0x99, 0xe1, 0x66, 0x67, 0xbd, 0x7b, 0xbc, 0x11, 0xd1, 0x4a, 0xee, 0xae, 0x36, 0x49, 0x9f, 0x04,
+	0xae, 0x78, 0x04, 0xde, 0x80, 0xb7, 0xe2, 0x01, 0x18, 0x1d, 0x69, 0xd3, 0x24, 0x26, 0xe1, 0xca,
+	0xd2, 0x77, 0xbe, 0xef, 0xd3, 0x59, 0xe9, 0xe8, 0xc8, 0xb0, 0x12, 0x6b, 0x35, 0x15, 0xe9, 0xd8,
+	0xfd, 0x8c, 0x66, 0xb9, 0x36, 0x9a, 0x2d, 0x49, 0xa1, 0xce, 0x30, 0x4f, 0x76, 0x46, 0x0e, 0xde,
+	0xfa, 0x38, 0xd5, 0x3a, 0x95, 0x38, 0xa6, 0xf0, 0xa4, 0x9c, 0x8e, 0x93, 0x32, 0x8f, 0x8c, 0xd0,
+	0xca, 0x09, 0x86, 0x7f, 0xd4, 0x60, 0x61, 0x5f, 0x4a, 0x36, 0x86, 0x56, 0x2a, 0xf5, 0x24, 0x92,
+	0xbc, 0xb6, 0x5d, 0x7b, 0xdc, 0xdd, 0xd9, 0x18, 0xdd, 0x72, 0x1a, 0x1d, 0x52, 0x38, 0xf0, 0x34,
+	0xf6, 0x25, 0x34, 0x67, 0xb9, 0xbe, 0x7c, 0xcf, 0xeb, 0xc4, 0x5f, 0x9f, 0xe3, 0x9f, 0xd8, 0x68,
+	0xe0, 0x48, 0x6c, 0x07, 0x16, 0x85, 0x2a, 0x4c, 0x24, 0x25, 0x5f, 0x20, 0x3e, 0x9f, 0xe3, 0x1f,
+	0xb9, 0x78, 0x50, 0x11, 0x87, 0xff, 0xd4, 0xa1, 0xe5, 0x16, 0x65, 0x5f, 0xc0, 0xc0, 0xd3, 0x43,
+	0x15, 0x65, 0x58, 0xcc, 0xa2, 0x18, 0x29, 0xd1, 0x4e, 0xb0, 0xec, 0x03, 0x3f, 0x54, 0x38, 0xfb,
+	0x04, 0xba, 0xb1, 0x12, 0x21, 0xaa, 0x68, 0x22, 0x31, 0xa1, 0xfc, 0xda, 0x01, 0xc4, 0x4a, 0x1c,
+	0x38, 0x84, 0x71, 0x58, 0x3c, 0xc7, 0xbc, 0x10, 0x5a, 0x51, 0x32, 0x9d, 0xa0, 0x9a, 0xb2, 0xd7,
+	0xb0, 0x2c, 0x12, 0x54, 0x46, 0x98, 0xf7, 0x61, 0xac, 0x95, 0xc1, 0x4b, 0xc3, 0x1b, 0x94, 0xef,
+	0xf6, 0x7c, 0xbe, 0x9e, 0xf8, 0xc2, 0xf1, 0x82, 0x25, 0x71, 0x13, 0x60, 0x6f, 0x61, 0x25, 0x2a,
+	0x8d, 0x0e, 0x85, 0xfa, 0x0d, 0x63, 0x73, 0xe5, 0xd7, 0x22, 0xbf, 0xe1, 0x9c, 0xdf, 0x7e, 0x69,
+	0xf4, 0x11, 0x51, 0xbd, 0xc1, 0xf3, 0x3a, 0xaf, 0x05, 0x83, 0xe8, 0x36, 0xcc, 0x9e, 0xc0, 0xba,
+	0xce, 0x84, 0xf9, 0x05, 0x27, 0xa7, 0x5a, 0x9f, 0xbd, 0x11, 0x09, 0x1e, 0x4c, 0xa7, 0x18, 0x9b,
+	0x82, 0x2f, 0xd2, 0xa7, 0xde, 0x11, 0x65, 0x8f, 0xe0, 0x41, 0x2c, 0xcb, 0xc2, 0x60, 0x1e, 0x26,
+	0x3a, 0x8b, 0x84, 0xe2, 0x6d, 0xfa, 0xfa, 0xbe, 0x47, 0x5f, 0x12, 0x38, 0xfc, 0xab, 0x05, 0x4d,
+	0x3a, 0x3b, 0xf6, 0x14, 0xba, 0x74, 0x7a, 0xa1, 0xc8, 0xa2, 0x14, 0x79, 0xed, 0x8e, 0x83, 0x3e,
+	0xb2, 0xd1, 0x00, 0x88, 0x4a, 0x63, 0xf6, 0x2d, 0x2c, 0x7b, 0xa1, 0x12, 0xc6, 0xab, 0xeb, 0xf7,
+	0xaa, 0x1f, 0x38, 0xb5, 0x12, 0xc6, 0x39, 0x3c, 0x83, 0x9e, 0xdd, 0xaf, 0x5c, 0xcb, 0x70, 0xa6,
+	0x73, 0xe3, 0x8b, 0x66, 0x6d, 0xbe, 0xc8, 0x74, 0x6e, 0x82, 0xae, 0xa7, 0xda, 0x09, 0x3b, 0x84,
+	0x55, 0x91, 0x2a, 0x9d, 0x63, 0x28, 0xd4, 0x44, 0x97, 0x2a, 0x21, 0x83, 0x82, 0x37, 0xb6, 0x17,
+	0xee, 0x76, 0x60, 0x4e, 0x72, 0xe4, 0x14, 0x16, 0x2a, 0xd8, 0x11, 0xac, 0x79, 0x23, 0x5d, 0x9a,
+	0xeb, 0x4e, 0xcd, 0xfb, 0x9c, 0x56, 0x9c, 0xe6, 0x47, 0x2f, 0x71, 0x56, 0xcf, 0xa0, 0x77, 0x3d,
+	0x19, 0x5f, 0x02, 0x77, 0x7d, 0x8d, 0xf8, 0x90, 0x05, 0xfb, 0x06, 0x20, 0x4a, 0x32, 0xa1, 0x9c,
+	0x6e, 0xf1, 0x3e, 0x5d, 0x87, 0x88, 0xa4, 0xda, 0x83, 0xfe, 0x8d, 0x9c, 0x79, 0xfb, 0x3e, 0x61,
+	0x4f, 0x5f, 0x4b, 0x96, 0xed, 0x43, 0x3b, 0xc7, 0x42, 0x97, 0x79, 0x8c, 0xbc, 0x43, 0xb2, 0x47,
+	0x73, 0xb2, 0xc0, 0x13, 0x02, 0x7c, 0x57, 0x8a, 0x1c, 0x33, 0x54, 0xa6, 0x08, 0xae, 0x64, 0xec,
+	0x23, 0xe8, 0xb8, 0xe3, 0x2f, 0x45, 0xc2, 0x61, 0xbb, 0xf6, 0x78, 0x21, 0x68, 0x13, 0xf0, 0xb3,
+	0x48, 0xd8, 0x13, 0xe8, 0x48, 0x9d, 0x86, 0x12, 0xcf, 0x51, 0xf2, 0x2e, 0x2d, 0xb0, 0x39, 0xb7,
+	0xc0, 0xb1, 0x4e, 0x8f, 0x2d, 0x21, 0x68, 0x4b, 0x3f, 0x62, 0x7b, 0xb0, 0x99, 0x88, 0xc2, 0x5e,
+	0xe0, 0x10, 0x2f, 0x0d, 0xe6, 0x2a, 0x92, 0xe1, 0x2c, 0xd7, 0x53, 0x21, 0xb1, 0xe0, 0x3d, 0x2a,
+	0xfc, 0x0d, 0x4f, 0x38, 0xf0, 0xf1, 0x13, 0x1f, 0x66, 0x9f, 0x41, 0xdf, 0x25, 0x54, 0x5d, 0xfb,
+	0x3e, 0x15, 0x7e, 0x8f, 0xc0, 0xb7, 0xfe, 0xee, 0x3f, 0x05, 0x7e, 0xbb, 0x68, 0xaf, 0xf8, 0x0f,
+	0x88, 0xbf, 0x76, 0xb3, 0x48, 0xbd, 0x70, 0x78, 0x08, 0x4d, 0x57, 0xb4, 0x0f, 0x01, 0x9c, 0xcc,
+	0xf6, 0x28, 0xdf, 0x9e, 0x3a, 0x84, 0xd8, 0xe6, 0x64, 0xfb, 0xd2, 0xac, 0x94, 0xb6, 0xa0, 0xa5,
+	0x88, 0x5d, 0xdf, 0xec, 0x04, 0x60, 0xa1, 0x13, 0x42, 0x86, 0x5b, 0xd0, 0xa0, 0x23, 0x60, 0xd0,
+	0xa0, 0x53, 0xb3, 0x0e, 0xfd, 0x80, 0xc6, 0xc3, 0x3f, 0x6b, 0xb0, 0xfa, 0x5f, 0xdb, 0x6e, 0x5d,
+	0x73, 0x7c, 0x57, 0x62, 0x61, 0xc2, 0x78, 0x56, 0xfa, 0x55, 0xc1, 0x43, 0x2f, 0x66, 0xa5, 0xbd,
+	0xf6, 0x15, 0x21, 0xc3, 0x4c, 0xe7, 0xd5, 0xca, 0x7d, 0x8f, 0x7e, 0x4f, 0xa0, 0x3d, 0x34, 0x29,
+	0x32, 0xe1, 0x5c, 0x5c, 0x5b, 0x6c, 0x13, 0x60, 0x3d, 0x3e, 0x85, 0x9e, 0x0b, 0x7a, 0x87, 0x06,
+	0xc5, 0xbb, 0x84, 0x39, 0xfd, 0x70, 0x03, 0x06, 0x73, 0x1d, 0x6c, 0xaf, 0xce, 0x6b, 0xc3, 0xbf,
+	0xeb, 0xb0, 0x74, 0xab, 0x57, 0x5a, 0x3f, 0x93, 0x97, 0x85, 0xa9, 0x1a, 0x91, 0xcb, 0xba, 0x4b,
+	0x98, 0x6b, 0x43, 0xec, 0x73, 0x18, 0x38, 0x4a, 0xa4, 0xe2, 0x53, 0x9d, 0x17, 0xe1, 0x0c, 0x33,
+	0x9f, 0xf9, 0x12, 0x05, 0xf6, 0x1d, 0x7e, 0x82, 0x19, 0x7b, 0x05, 0x03, 0x51, 0x14, 0x65, 0xa4,
+	0x62, 0x0c, 0xa5, 0x98, 0xa2, 0x11, 0x19, 0xfa, 0x96, 0xb1, 0x39, 0x72, 0x0f, 0xe0, 0xa8, 0x7a,
+	0x00, 0x47, 0x2f, 0xfd, 0x03, 0x18, 0x2c, 0x57, 0x9a, 0x63, 0x2f, 0x61, 0xaf, 0x61, 0x35, 0x96,
+	0x3a, 0x3e, 0x0b, 0x8b, 0x33, 0xbc, 0x08, 0x23, 0x29, 0xf5, 0x85, 0x8d, 0xf3, 0xc6, 0xff, 0x59,
+	0x31, 0x92, 0xbd, 0x39, 0xc3, 0x8b, 0xfd, 0x4a, 0xc4, 0xd6, 0xa1, 0x55, 0xc4, 0xa7, 0x98, 0x21,
+	0x6f, 0x52, 0xd6, 0x7e, 0xc6, 0x46, 0xb0, 0x52, 0xcc, 0xc4, 0x74, 0x8a, 0xe1, 0x8d, 0x2d, 0x68,
+	0x11, 0x69, 0xe0, 0x42, 0x3f, 0x7d, 0xd8, 0x88, 0xe1, 0x36, 0xb4, 0xab, 0xeb, 0xc0, 0x56, 0xa1,
+	0xe9, 0x2e, 0x8e, 0xdb, 0x30, 0x37, 0x19, 0xfe, 0x5e, 0x83, 0x45, 0xff, 0x7a, 0xd2, 0xe3, 0x27,
+	0xc5, 0x55, 0xe1, 0xfa, 0x22, 0x8b, 0xa5, 0xa8, 0xca, 0x7c, 0x17, 0x9a, 0x53, 0x19, 0xa5, 0x05,
+	0x5f, 0xa0, 0x36, 0xf6, 0xf0, 0xae, 0x77, 0x78, 0xf4, 0x4a, 0x46, 0x69, 0xe0, 0xb8, 0x5b, 0x5f,
+	0x41, 0xc3, 0x4e, 0x6d, 0x65, 0x5e, 0xab, 0x6d, 0x1a, 0xdb, 0x9c, 0xce, 0x23, 0x59, 0xa2, 0x5f,
+	0xcb, 0x4d, 0xbe, 0x6b, 0xb4, 0x6b, 0xcb, 0xf5, 0xe7, 0xbb, 0xbf, 0x7e, 0x9d, 0x0a, 0x73, 0x5a,
+	0x4e, 0x46, 0xb1, 0xce, 0xc6, 0x7e, 0xa5, 0xea, 0x77, 0x67, 0xec, 0xfb, 0xb6, 0xc4, 0x7c, 0x9c,
+	0xa2, 0xf2, 0xff, 0x64, 0x26, 0x2d, 0xda, 0xdf, 0xdd, 0x7f, 0x07, 0x00, 0xeb, 0x3c, 0x59, 0x5f,
+	0xe1, 0x08, 0x00, 0x00,
 }
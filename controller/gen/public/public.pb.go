@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
 	duration "github.com/golang/protobuf/ptypes/duration"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	healthcheck "github.com/linkerd/linkerd2/controller/gen/common/healthcheck"
 	config "github.com/linkerd/linkerd2/controller/gen/config"
 	grpc "google.golang.org/grpc"
@@ -1047,6 +1048,10 @@ type TapByResourceRequest_Match_Http struct {
 	//	*TapByResourceRequest_Match_Http_Method
 	//	*TapByResourceRequest_Match_Http_Authority
 	//	*TapByResourceRequest_Match_Http_Path
+	//	*TapByResourceRequest_Match_Http_Status
+	//	*TapByResourceRequest_Match_Http_MinLatency
+	//	*TapByResourceRequest_Match_Http_GrpcStatus
+	//	*TapByResourceRequest_Match_Http_Header_
 	Match                isTapByResourceRequest_Match_Http_Match `protobuf_oneof:"match"`
 	XXX_NoUnkeyedLiteral struct{}                                `json:"-"`
 	XXX_unrecognized     []byte                                  `json:"-"`
@@ -1098,6 +1103,22 @@ type TapByResourceRequest_Match_Http_Path struct {
 	Path string `protobuf:"bytes,4,opt,name=path,proto3,oneof"`
 }
 
+type TapByResourceRequest_Match_Http_Status struct {
+	Status *TapByResourceRequest_Match_Http_StatusRange `protobuf:"bytes,5,opt,name=status,proto3,oneof"`
+}
+
+type TapByResourceRequest_Match_Http_MinLatency struct {
+	MinLatency *duration.Duration `protobuf:"bytes,6,opt,name=min_latency,json=minLatency,proto3,oneof"`
+}
+
+type TapByResourceRequest_Match_Http_GrpcStatus struct {
+	GrpcStatus uint32 `protobuf:"varint,7,opt,name=grpc_status,json=grpcStatus,proto3,oneof"`
+}
+
+type TapByResourceRequest_Match_Http_Header_ struct {
+	Header *TapByResourceRequest_Match_Http_Header `protobuf:"bytes,8,opt,name=header,proto3,oneof"`
+}
+
 func (*TapByResourceRequest_Match_Http_Scheme) isTapByResourceRequest_Match_Http_Match() {}
 
 func (*TapByResourceRequest_Match_Http_Method) isTapByResourceRequest_Match_Http_Match() {}
@@ -1106,6 +1127,14 @@ func (*TapByResourceRequest_Match_Http_Authority) isTapByResourceRequest_Match_H
 
 func (*TapByResourceRequest_Match_Http_Path) isTapByResourceRequest_Match_Http_Match() {}
 
+func (*TapByResourceRequest_Match_Http_Status) isTapByResourceRequest_Match_Http_Match() {}
+
+func (*TapByResourceRequest_Match_Http_MinLatency) isTapByResourceRequest_Match_Http_Match() {}
+
+func (*TapByResourceRequest_Match_Http_GrpcStatus) isTapByResourceRequest_Match_Http_Match() {}
+
+func (*TapByResourceRequest_Match_Http_Header_) isTapByResourceRequest_Match_Http_Match() {}
+
 func (m *TapByResourceRequest_Match_Http) GetMatch() isTapByResourceRequest_Match_Http_Match {
 	if m != nil {
 		return m.Match
@@ -1141,6 +1170,34 @@ func (m *TapByResourceRequest_Match_Http) GetPath() string {
 	return ""
 }
 
+func (m *TapByResourceRequest_Match_Http) GetStatus() *TapByResourceRequest_Match_Http_StatusRange {
+	if x, ok := m.GetMatch().(*TapByResourceRequest_Match_Http_Status); ok {
+		return x.Status
+	}
+	return nil
+}
+
+func (m *TapByResourceRequest_Match_Http) GetMinLatency() *duration.Duration {
+	if x, ok := m.GetMatch().(*TapByResourceRequest_Match_Http_MinLatency); ok {
+		return x.MinLatency
+	}
+	return nil
+}
+
+func (m *TapByResourceRequest_Match_Http) GetGrpcStatus() uint32 {
+	if x, ok := m.GetMatch().(*TapByResourceRequest_Match_Http_GrpcStatus); ok {
+		return x.GrpcStatus
+	}
+	return 0
+}
+
+func (m *TapByResourceRequest_Match_Http) GetHeader() *TapByResourceRequest_Match_Http_Header {
+	if x, ok := m.GetMatch().(*TapByResourceRequest_Match_Http_Header_); ok {
+		return x.Header
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*TapByResourceRequest_Match_Http) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1148,7 +1205,112 @@ func (*TapByResourceRequest_Match_Http) XXX_OneofWrappers() []interface{} {
 		(*TapByResourceRequest_Match_Http_Method)(nil),
 		(*TapByResourceRequest_Match_Http_Authority)(nil),
 		(*TapByResourceRequest_Match_Http_Path)(nil),
+		(*TapByResourceRequest_Match_Http_Status)(nil),
+		(*TapByResourceRequest_Match_Http_MinLatency)(nil),
+		(*TapByResourceRequest_Match_Http_GrpcStatus)(nil),
+		(*TapByResourceRequest_Match_Http_Header_)(nil),
+	}
+}
+
+// Inclusive range of HTTP status codes.
+type TapByResourceRequest_Match_Http_StatusRange struct {
+	Min                  uint32   `protobuf:"varint,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max                  uint32   `protobuf:"varint,2,opt,name=max,proto3" json:"max,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TapByResourceRequest_Match_Http_StatusRange) Reset() {
+	*m = TapByResourceRequest_Match_Http_StatusRange{}
+}
+func (m *TapByResourceRequest_Match_Http_StatusRange) String() string {
+	return proto.CompactTextString(m)
+}
+func (*TapByResourceRequest_Match_Http_StatusRange) ProtoMessage() {}
+func (*TapByResourceRequest_Match_Http_StatusRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_413a91106d7bcce8, []int{9, 0, 1, 0}
+}
+
+func (m *TapByResourceRequest_Match_Http_StatusRange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TapByResourceRequest_Match_Http_StatusRange.Unmarshal(m, b)
+}
+func (m *TapByResourceRequest_Match_Http_StatusRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TapByResourceRequest_Match_Http_StatusRange.Marshal(b, m, deterministic)
+}
+func (m *TapByResourceRequest_Match_Http_StatusRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TapByResourceRequest_Match_Http_StatusRange.Merge(m, src)
+}
+func (m *TapByResourceRequest_Match_Http_StatusRange) XXX_Size() int {
+	return xxx_messageInfo_TapByResourceRequest_Match_Http_StatusRange.Size(m)
+}
+func (m *TapByResourceRequest_Match_Http_StatusRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_TapByResourceRequest_Match_Http_StatusRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TapByResourceRequest_Match_Http_StatusRange proto.InternalMessageInfo
+
+func (m *TapByResourceRequest_Match_Http_StatusRange) GetMin() uint32 {
+	if m != nil {
+		return m.Min
+	}
+	return 0
+}
+
+func (m *TapByResourceRequest_Match_Http_StatusRange) GetMax() uint32 {
+	if m != nil {
+		return m.Max
+	}
+	return 0
+}
+
+type TapByResourceRequest_Match_Http_Header struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TapByResourceRequest_Match_Http_Header) Reset() {
+	*m = TapByResourceRequest_Match_Http_Header{}
+}
+func (m *TapByResourceRequest_Match_Http_Header) String() string { return proto.CompactTextString(m) }
+func (*TapByResourceRequest_Match_Http_Header) ProtoMessage()    {}
+func (*TapByResourceRequest_Match_Http_Header) Descriptor() ([]byte, []int) {
+	return fileDescriptor_413a91106d7bcce8, []int{9, 0, 1, 1}
+}
+
+func (m *TapByResourceRequest_Match_Http_Header) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TapByResourceRequest_Match_Http_Header.Unmarshal(m, b)
+}
+func (m *TapByResourceRequest_Match_Http_Header) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TapByResourceRequest_Match_Http_Header.Marshal(b, m, deterministic)
+}
+func (m *TapByResourceRequest_Match_Http_Header) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TapByResourceRequest_Match_Http_Header.Merge(m, src)
+}
+func (m *TapByResourceRequest_Match_Http_Header) XXX_Size() int {
+	return xxx_messageInfo_TapByResourceRequest_Match_Http_Header.Size(m)
+}
+func (m *TapByResourceRequest_Match_Http_Header) XXX_DiscardUnknown() {
+	xxx_messageInfo_TapByResourceRequest_Match_Http_Header.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TapByResourceRequest_Match_Http_Header proto.InternalMessageInfo
+
+func (m *TapByResourceRequest_Match_Http_Header) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TapByResourceRequest_Match_Http_Header) GetValue() string {
+	if m != nil {
+		return m.Value
 	}
+	return ""
 }
 
 type TapByResourceRequest_Extract struct {
@@ -1290,8 +1452,10 @@ type TapByResourceRequest_Extract_Http_Headers struct {
 func (m *TapByResourceRequest_Extract_Http_Headers) Reset() {
 	*m = TapByResourceRequest_Extract_Http_Headers{}
 }
-func (m *TapByResourceRequest_Extract_Http_Headers) String() string { return proto.CompactTextString(m) }
-func (*TapByResourceRequest_Extract_Http_Headers) ProtoMessage()    {}
+func (m *TapByResourceRequest_Extract_Http_Headers) String() string {
+	return proto.CompactTextString(m)
+}
+func (*TapByResourceRequest_Extract_Http_Headers) ProtoMessage() {}
 func (*TapByResourceRequest_Extract_Http_Headers) Descriptor() ([]byte, []int) {
 	return fileDescriptor_413a91106d7bcce8, []int{9, 1, 0, 0}
 }
@@ -2796,12 +2960,29 @@ type StatSummaryRequest struct {
 	//	*StatSummaryRequest_None
 	//	*StatSummaryRequest_ToResource
 	//	*StatSummaryRequest_FromResource
-	Outbound             isStatSummaryRequest_Outbound `protobuf_oneof:"outbound"`
-	SkipStats            bool                          `protobuf:"varint,6,opt,name=skip_stats,json=skipStats,proto3" json:"skip_stats,omitempty"`
-	TcpStats             bool                          `protobuf:"varint,7,opt,name=tcp_stats,json=tcpStats,proto3" json:"tcp_stats,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
-	XXX_unrecognized     []byte                        `json:"-"`
-	XXX_sizecache        int32                         `json:"-"`
+	Outbound  isStatSummaryRequest_Outbound `protobuf_oneof:"outbound"`
+	SkipStats bool                          `protobuf:"varint,6,opt,name=skip_stats,json=skipStats,proto3" json:"skip_stats,omitempty"`
+	TcpStats  bool                          `protobuf:"varint,7,opt,name=tcp_stats,json=tcpStats,proto3" json:"tcp_stats,omitempty"`
+	// if set, stats are returned as time series instead of a single value per
+	// resource, see StatTable.PodGroup.Row.time_series
+	TimeRange *TimeRange `protobuf:"bytes,8,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+	// latency quantiles, between 0 and 1, returned in
+	// BasicStats.latency_quantiles on top of the p50, p95 and p99 latencies
+	LatencyQuantiles []float64 `protobuf:"fixed64,9,rep,packed,name=latency_quantiles,json=latencyQuantiles,proto3" json:"latency_quantiles,omitempty"`
+	// true if we want the latency bucket counts in BasicStats.latency_histogram
+	LatencyHistogram bool `protobuf:"varint,10,opt,name=latency_histogram,json=latencyHistogram,proto3" json:"latency_histogram,omitempty"`
+	// true if we want, along with the stats of every resource type, the stats
+	// of each selected namespace in StatSummaryResponse.Ok.namespaces, and
+	// their totals in StatSummaryResponse.Ok.totals; only supported for the
+	// "all" resource type, without a time_range.
+	//
+	// The namespace stats take one set of Prometheus queries grouped by
+	// namespace, and the totals the same queries without grouping, so that
+	// their latencies are computed by Prometheus over all the responses.
+	Rollups              bool     `protobuf:"varint,11,opt,name=rollups,proto3" json:"rollups,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *StatSummaryRequest) Reset()         { *m = StatSummaryRequest{} }
@@ -2907,6 +3088,34 @@ func (m *StatSummaryRequest) GetTcpStats() bool {
 	return false
 }
 
+func (m *StatSummaryRequest) GetTimeRange() *TimeRange {
+	if m != nil {
+		return m.TimeRange
+	}
+	return nil
+}
+
+func (m *StatSummaryRequest) GetLatencyQuantiles() []float64 {
+	if m != nil {
+		return m.LatencyQuantiles
+	}
+	return nil
+}
+
+func (m *StatSummaryRequest) GetLatencyHistogram() bool {
+	if m != nil {
+		return m.LatencyHistogram
+	}
+	return false
+}
+
+func (m *StatSummaryRequest) GetRollups() bool {
+	if m != nil {
+		return m.Rollups
+	}
+	return false
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*StatSummaryRequest) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -2916,6 +3125,63 @@ func (*StatSummaryRequest) XXX_OneofWrappers() []interface{} {
 	}
 }
 
+// TimeRange selects the points in time at which stats are evaluated: every
+// step from start to end, each over the request's time_window.
+type TimeRange struct {
+	Start                *timestamp.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End                  *timestamp.Timestamp `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	Step                 *duration.Duration   `protobuf:"bytes,3,opt,name=step,proto3" json:"step,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *TimeRange) Reset()         { *m = TimeRange{} }
+func (m *TimeRange) String() string { return proto.CompactTextString(m) }
+func (*TimeRange) ProtoMessage()    {}
+func (*TimeRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_413a91106d7bcce8, []int{24}
+}
+
+func (m *TimeRange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TimeRange.Unmarshal(m, b)
+}
+func (m *TimeRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TimeRange.Marshal(b, m, deterministic)
+}
+func (m *TimeRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeRange.Merge(m, src)
+}
+func (m *TimeRange) XXX_Size() int {
+	return xxx_messageInfo_TimeRange.Size(m)
+}
+func (m *TimeRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimeRange proto.InternalMessageInfo
+
+func (m *TimeRange) GetStart() *timestamp.Timestamp {
+	if m != nil {
+		return m.Start
+	}
+	return nil
+}
+
+func (m *TimeRange) GetEnd() *timestamp.Timestamp {
+	if m != nil {
+		return m.End
+	}
+	return nil
+}
+
+func (m *TimeRange) GetStep() *duration.Duration {
+	if m != nil {
+		return m.Step
+	}
+	return nil
+}
+
 type StatSummaryResponse struct {
 	// Types that are valid to be assigned to Response:
 	//	*StatSummaryResponse_Ok_
@@ -2930,7 +3196,7 @@ func (m *StatSummaryResponse) Reset()         { *m = StatSummaryResponse{} }
 func (m *StatSummaryResponse) String() string { return proto.CompactTextString(m) }
 func (*StatSummaryResponse) ProtoMessage()    {}
 func (*StatSummaryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_413a91106d7bcce8, []int{24}
+	return fileDescriptor_413a91106d7bcce8, []int{25}
 }
 
 func (m *StatSummaryResponse) XXX_Unmarshal(b []byte) error {
@@ -2997,17 +3263,24 @@ func (*StatSummaryResponse) XXX_OneofWrappers() []interface{} {
 }
 
 type StatSummaryResponse_Ok struct {
-	StatTables           []*StatTable `protobuf:"bytes,1,rep,name=stat_tables,json=statTables,proto3" json:"stat_tables,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	StatTables []*StatTable `protobuf:"bytes,1,rep,name=stat_tables,json=statTables,proto3" json:"stat_tables,omitempty"`
+	// the totals of the namespaces, set if the request asked for rollups;
+	// its resource has the "all" type and the selected namespace, empty for
+	// the whole cluster
+	Totals *StatTable_PodGroup_Row `protobuf:"bytes,2,opt,name=totals,proto3" json:"totals,omitempty"`
+	// the stats of each selected namespace, set if the request asked for
+	// rollups
+	Namespaces           *StatTable `protobuf:"bytes,3,opt,name=namespaces,proto3" json:"namespaces,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *StatSummaryResponse_Ok) Reset()         { *m = StatSummaryResponse_Ok{} }
 func (m *StatSummaryResponse_Ok) String() string { return proto.CompactTextString(m) }
 func (*StatSummaryResponse_Ok) ProtoMessage()    {}
 func (*StatSummaryResponse_Ok) Descriptor() ([]byte, []int) {
-	return fileDescriptor_413a91106d7bcce8, []int{24, 0}
+	return fileDescriptor_413a91106d7bcce8, []int{25, 0}
 }
 
 func (m *StatSummaryResponse_Ok) XXX_Unmarshal(b []byte) error {
@@ -3035,24 +3308,43 @@ func (m *StatSummaryResponse_Ok) GetStatTables() []*StatTable {
 	return nil
 }
 
+func (m *StatSummaryResponse_Ok) GetTotals() *StatTable_PodGroup_Row {
+	if m != nil {
+		return m.Totals
+	}
+	return nil
+}
+
+func (m *StatSummaryResponse_Ok) GetNamespaces() *StatTable {
+	if m != nil {
+		return m.Namespaces
+	}
+	return nil
+}
+
 type BasicStats struct {
-	SuccessCount         uint64   `protobuf:"varint,1,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`
-	FailureCount         uint64   `protobuf:"varint,2,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
-	LatencyMsP50         uint64   `protobuf:"varint,3,opt,name=latency_ms_p50,json=latencyMsP50,proto3" json:"latency_ms_p50,omitempty"`
-	LatencyMsP95         uint64   `protobuf:"varint,4,opt,name=latency_ms_p95,json=latencyMsP95,proto3" json:"latency_ms_p95,omitempty"`
-	LatencyMsP99         uint64   `protobuf:"varint,5,opt,name=latency_ms_p99,json=latencyMsP99,proto3" json:"latency_ms_p99,omitempty"`
-	ActualSuccessCount   uint64   `protobuf:"varint,6,opt,name=actual_success_count,json=actualSuccessCount,proto3" json:"actual_success_count,omitempty"`
-	ActualFailureCount   uint64   `protobuf:"varint,7,opt,name=actual_failure_count,json=actualFailureCount,proto3" json:"actual_failure_count,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	SuccessCount       uint64 `protobuf:"varint,1,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`
+	FailureCount       uint64 `protobuf:"varint,2,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
+	LatencyMsP50       uint64 `protobuf:"varint,3,opt,name=latency_ms_p50,json=latencyMsP50,proto3" json:"latency_ms_p50,omitempty"`
+	LatencyMsP95       uint64 `protobuf:"varint,4,opt,name=latency_ms_p95,json=latencyMsP95,proto3" json:"latency_ms_p95,omitempty"`
+	LatencyMsP99       uint64 `protobuf:"varint,5,opt,name=latency_ms_p99,json=latencyMsP99,proto3" json:"latency_ms_p99,omitempty"`
+	ActualSuccessCount uint64 `protobuf:"varint,6,opt,name=actual_success_count,json=actualSuccessCount,proto3" json:"actual_success_count,omitempty"`
+	ActualFailureCount uint64 `protobuf:"varint,7,opt,name=actual_failure_count,json=actualFailureCount,proto3" json:"actual_failure_count,omitempty"`
+	// the latencies at the requested quantiles, in increasing quantile order
+	LatencyQuantiles []*LatencyQuantile `protobuf:"bytes,8,rep,name=latency_quantiles,json=latencyQuantiles,proto3" json:"latency_quantiles,omitempty"`
+	// the number of responses in each latency bucket, if requested, in
+	// increasing bucket order
+	LatencyHistogram     []*LatencyBucket `protobuf:"bytes,9,rep,name=latency_histogram,json=latencyHistogram,proto3" json:"latency_histogram,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *BasicStats) Reset()         { *m = BasicStats{} }
 func (m *BasicStats) String() string { return proto.CompactTextString(m) }
 func (*BasicStats) ProtoMessage()    {}
 func (*BasicStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_413a91106d7bcce8, []int{25}
+	return fileDescriptor_413a91106d7bcce8, []int{26}
 }
 
 func (m *BasicStats) XXX_Unmarshal(b []byte) error {
@@ -3122,6 +3414,116 @@ func (m *BasicStats) GetActualFailureCount() uint64 {
 	return 0
 }
 
+func (m *BasicStats) GetLatencyQuantiles() []*LatencyQuantile {
+	if m != nil {
+		return m.LatencyQuantiles
+	}
+	return nil
+}
+
+func (m *BasicStats) GetLatencyHistogram() []*LatencyBucket {
+	if m != nil {
+		return m.LatencyHistogram
+	}
+	return nil
+}
+
+type LatencyQuantile struct {
+	Quantile             float64  `protobuf:"fixed64,1,opt,name=quantile,proto3" json:"quantile,omitempty"`
+	LatencyMs            uint64   `protobuf:"varint,2,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LatencyQuantile) Reset()         { *m = LatencyQuantile{} }
+func (m *LatencyQuantile) String() string { return proto.CompactTextString(m) }
+func (*LatencyQuantile) ProtoMessage()    {}
+func (*LatencyQuantile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_413a91106d7bcce8, []int{27}
+}
+
+func (m *LatencyQuantile) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LatencyQuantile.Unmarshal(m, b)
+}
+func (m *LatencyQuantile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LatencyQuantile.Marshal(b, m, deterministic)
+}
+func (m *LatencyQuantile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LatencyQuantile.Merge(m, src)
+}
+func (m *LatencyQuantile) XXX_Size() int {
+	return xxx_messageInfo_LatencyQuantile.Size(m)
+}
+func (m *LatencyQuantile) XXX_DiscardUnknown() {
+	xxx_messageInfo_LatencyQuantile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LatencyQuantile proto.InternalMessageInfo
+
+func (m *LatencyQuantile) GetQuantile() float64 {
+	if m != nil {
+		return m.Quantile
+	}
+	return 0
+}
+
+func (m *LatencyQuantile) GetLatencyMs() uint64 {
+	if m != nil {
+		return m.LatencyMs
+	}
+	return 0
+}
+
+type LatencyBucket struct {
+	// the upper bound of the bucket, in milliseconds, which may be +Inf; the
+	// counts are cumulative, as in Prometheus histograms
+	LeMs                 float64  `protobuf:"fixed64,1,opt,name=le_ms,json=leMs,proto3" json:"le_ms,omitempty"`
+	Count                uint64   `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LatencyBucket) Reset()         { *m = LatencyBucket{} }
+func (m *LatencyBucket) String() string { return proto.CompactTextString(m) }
+func (*LatencyBucket) ProtoMessage()    {}
+func (*LatencyBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_413a91106d7bcce8, []int{28}
+}
+
+func (m *LatencyBucket) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LatencyBucket.Unmarshal(m, b)
+}
+func (m *LatencyBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LatencyBucket.Marshal(b, m, deterministic)
+}
+func (m *LatencyBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LatencyBucket.Merge(m, src)
+}
+func (m *LatencyBucket) XXX_Size() int {
+	return xxx_messageInfo_LatencyBucket.Size(m)
+}
+func (m *LatencyBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_LatencyBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LatencyBucket proto.InternalMessageInfo
+
+func (m *LatencyBucket) GetLeMs() float64 {
+	if m != nil {
+		return m.LeMs
+	}
+	return 0
+}
+
+func (m *LatencyBucket) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
 type TcpStats struct {
 	// number of currently open connections
 	OpenConnections uint64 `protobuf:"varint,1,opt,name=open_connections,json=openConnections,proto3" json:"open_connections,omitempty"`
@@ -3138,7 +3540,7 @@ func (m *TcpStats) Reset()         { *m = TcpStats{} }
 func (m *TcpStats) String() string { return proto.CompactTextString(m) }
 func (*TcpStats) ProtoMessage()    {}
 func (*TcpStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_413a91106d7bcce8, []int{26}
+	return fileDescriptor_413a91106d7bcce8, []int{29}
 }
 
 func (m *TcpStats) XXX_Unmarshal(b []byte) error {
@@ -3193,7 +3595,7 @@ func (m *TrafficSplitStats) Reset()         { *m = TrafficSplitStats{} }
 func (m *TrafficSplitStats) String() string { return proto.CompactTextString(m) }
 func (*TrafficSplitStats) ProtoMessage()    {}
 func (*TrafficSplitStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_413a91106d7bcce8, []int{27}
+	return fileDescriptor_413a91106d7bcce8, []int{30}
 }
 
 func (m *TrafficSplitStats) XXX_Unmarshal(b []byte) error {
@@ -3248,7 +3650,7 @@ func (m *StatTable) Reset()         { *m = StatTable{} }
 func (m *StatTable) String() string { return proto.CompactTextString(m) }
 func (*StatTable) ProtoMessage()    {}
 func (*StatTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_413a91106d7bcce8, []int{28}
+	return fileDescriptor_413a91106d7bcce8, []int{31}
 }
 
 func (m *StatTable) XXX_Unmarshal(b []byte) error {
@@ -3311,7 +3713,7 @@ func (m *StatTable_PodGroup) Reset()         { *m = StatTable_PodGroup{} }
 func (m *StatTable_PodGroup) String() string { return proto.CompactTextString(m) }
 func (*StatTable_PodGroup) ProtoMessage()    {}
 func (*StatTable_PodGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_413a91106d7bcce8, []int{28, 0}
+	return fileDescriptor_413a91106d7bcce8, []int{31, 0}
 }
 
 func (m *StatTable_PodGroup) XXX_Unmarshal(b []byte) error {
@@ -3354,17 +3756,20 @@ type StatTable_PodGroup_Row struct {
 	TcpStats       *TcpStats          `protobuf:"bytes,8,opt,name=tcp_stats,json=tcpStats,proto3" json:"tcp_stats,omitempty"`
 	TsStats        *TrafficSplitStats `protobuf:"bytes,10,opt,name=ts_stats,json=tsStats,proto3" json:"ts_stats,omitempty"`
 	// Stores a set of errors for each pod name. If a pod has no errors, it may be omitted.
-	ErrorsByPod          map[string]*PodErrors `protobuf:"bytes,7,rep,name=errors_by_pod,json=errorsByPod,proto3" json:"errors_by_pod,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	ErrorsByPod map[string]*PodErrors `protobuf:"bytes,7,rep,name=errors_by_pod,json=errorsByPod,proto3" json:"errors_by_pod,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// set instead of stats when the request has a time_range, with a point
+	// for every step at which there was traffic
+	TimeSeries           []*TimeSeriesPoint `protobuf:"bytes,11,rep,name=time_series,json=timeSeries,proto3" json:"time_series,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
 func (m *StatTable_PodGroup_Row) Reset()         { *m = StatTable_PodGroup_Row{} }
 func (m *StatTable_PodGroup_Row) String() string { return proto.CompactTextString(m) }
 func (*StatTable_PodGroup_Row) ProtoMessage()    {}
 func (*StatTable_PodGroup_Row) Descriptor() ([]byte, []int) {
-	return fileDescriptor_413a91106d7bcce8, []int{28, 0, 0}
+	return fileDescriptor_413a91106d7bcce8, []int{31, 0, 0}
 }
 
 func (m *StatTable_PodGroup_Row) XXX_Unmarshal(b []byte) error {
@@ -3455,27 +3860,81 @@ func (m *StatTable_PodGroup_Row) GetErrorsByPod() map[string]*PodErrors {
 	return nil
 }
 
-type EdgesRequest struct {
-	Selector             *ResourceSelection `protobuf:"bytes,1,opt,name=selector,proto3" json:"selector,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+func (m *StatTable_PodGroup_Row) GetTimeSeries() []*TimeSeriesPoint {
+	if m != nil {
+		return m.TimeSeries
+	}
+	return nil
 }
 
-func (m *EdgesRequest) Reset()         { *m = EdgesRequest{} }
-func (m *EdgesRequest) String() string { return proto.CompactTextString(m) }
-func (*EdgesRequest) ProtoMessage()    {}
-func (*EdgesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_413a91106d7bcce8, []int{29}
+type TimeSeriesPoint struct {
+	Time                 *timestamp.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Stats                *BasicStats          `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *EdgesRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_EdgesRequest.Unmarshal(m, b)
+func (m *TimeSeriesPoint) Reset()         { *m = TimeSeriesPoint{} }
+func (m *TimeSeriesPoint) String() string { return proto.CompactTextString(m) }
+func (*TimeSeriesPoint) ProtoMessage()    {}
+func (*TimeSeriesPoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_413a91106d7bcce8, []int{32}
 }
-func (m *EdgesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_EdgesRequest.Marshal(b, m, deterministic)
+
+func (m *TimeSeriesPoint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TimeSeriesPoint.Unmarshal(m, b)
 }
-func (m *EdgesRequest) XXX_Merge(src proto.Message) {
+func (m *TimeSeriesPoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TimeSeriesPoint.Marshal(b, m, deterministic)
+}
+func (m *TimeSeriesPoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeSeriesPoint.Merge(m, src)
+}
+func (m *TimeSeriesPoint) XXX_Size() int {
+	return xxx_messageInfo_TimeSeriesPoint.Size(m)
+}
+func (m *TimeSeriesPoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeSeriesPoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimeSeriesPoint proto.InternalMessageInfo
+
+func (m *TimeSeriesPoint) GetTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *TimeSeriesPoint) GetStats() *BasicStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+type EdgesRequest struct {
+	Selector             *ResourceSelection `protobuf:"bytes,1,opt,name=selector,proto3" json:"selector,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *EdgesRequest) Reset()         { *m = EdgesRequest{} }
+func (m *EdgesRequest) String() string { return proto.CompactTextString(m) }
+func (*EdgesRequest) ProtoMessage()    {}
+func (*EdgesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_413a91106d7bcce8, []int{33}
+}
+
+func (m *EdgesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EdgesRequest.Unmarshal(m, b)
+}
+func (m *EdgesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EdgesRequest.Marshal(b, m, deterministic)
+}
+func (m *EdgesRequest) XXX_Merge(src proto.Message) {
 	xxx_messageInfo_EdgesRequest.Merge(m, src)
 }
 func (m *EdgesRequest) XXX_Size() int {
@@ -3508,7 +3967,7 @@ func (m *EdgesResponse) Reset()         { *m = EdgesResponse{} }
 func (m *EdgesResponse) String() string { return proto.CompactTextString(m) }
 func (*EdgesResponse) ProtoMessage()    {}
 func (*EdgesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_413a91106d7bcce8, []int{30}
+	return fileDescriptor_413a91106d7bcce8, []int{34}
 }
 
 func (m *EdgesResponse) XXX_Unmarshal(b []byte) error {
@@ -3585,7 +4044,7 @@ func (m *EdgesResponse_Ok) Reset()         { *m = EdgesResponse_Ok{} }
 func (m *EdgesResponse_Ok) String() string { return proto.CompactTextString(m) }
 func (*EdgesResponse_Ok) ProtoMessage()    {}
 func (*EdgesResponse_Ok) Descriptor() ([]byte, []int) {
-	return fileDescriptor_413a91106d7bcce8, []int{30, 0}
+	return fileDescriptor_413a91106d7bcce8, []int{34, 0}
 }
 
 func (m *EdgesResponse_Ok) XXX_Unmarshal(b []byte) error {
@@ -3628,7 +4087,7 @@ func (m *Edge) Reset()         { *m = Edge{} }
 func (m *Edge) String() string { return proto.CompactTextString(m) }
 func (*Edge) ProtoMessage()    {}
 func (*Edge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_413a91106d7bcce8, []int{31}
+	return fileDescriptor_413a91106d7bcce8, []int{35}
 }
 
 func (m *Edge) XXX_Unmarshal(b []byte) error {
@@ -3690,17 +4149,21 @@ type TopRoutesRequest struct {
 	// Types that are valid to be assigned to Outbound:
 	//	*TopRoutesRequest_None
 	//	*TopRoutesRequest_ToResource
-	Outbound             isTopRoutesRequest_Outbound `protobuf_oneof:"outbound"`
-	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
-	XXX_unrecognized     []byte                      `json:"-"`
-	XXX_sizecache        int32                       `json:"-"`
+	Outbound isTopRoutesRequest_Outbound `protobuf_oneof:"outbound"`
+	// see StatSummaryRequest.latency_quantiles
+	LatencyQuantiles []float64 `protobuf:"fixed64,8,rep,packed,name=latency_quantiles,json=latencyQuantiles,proto3" json:"latency_quantiles,omitempty"`
+	// see StatSummaryRequest.latency_histogram
+	LatencyHistogram     bool     `protobuf:"varint,9,opt,name=latency_histogram,json=latencyHistogram,proto3" json:"latency_histogram,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *TopRoutesRequest) Reset()         { *m = TopRoutesRequest{} }
 func (m *TopRoutesRequest) String() string { return proto.CompactTextString(m) }
 func (*TopRoutesRequest) ProtoMessage()    {}
 func (*TopRoutesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_413a91106d7bcce8, []int{32}
+	return fileDescriptor_413a91106d7bcce8, []int{36}
 }
 
 func (m *TopRoutesRequest) XXX_Unmarshal(b []byte) error {
@@ -3772,6 +4235,20 @@ func (m *TopRoutesRequest) GetToResource() *Resource {
 	return nil
 }
 
+func (m *TopRoutesRequest) GetLatencyQuantiles() []float64 {
+	if m != nil {
+		return m.LatencyQuantiles
+	}
+	return nil
+}
+
+func (m *TopRoutesRequest) GetLatencyHistogram() bool {
+	if m != nil {
+		return m.LatencyHistogram
+	}
+	return false
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*TopRoutesRequest) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -3794,7 +4271,7 @@ func (m *TopRoutesResponse) Reset()         { *m = TopRoutesResponse{} }
 func (m *TopRoutesResponse) String() string { return proto.CompactTextString(m) }
 func (*TopRoutesResponse) ProtoMessage()    {}
 func (*TopRoutesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_413a91106d7bcce8, []int{33}
+	return fileDescriptor_413a91106d7bcce8, []int{37}
 }
 
 func (m *TopRoutesResponse) XXX_Unmarshal(b []byte) error {
@@ -3871,7 +4348,7 @@ func (m *TopRoutesResponse_Ok) Reset()         { *m = TopRoutesResponse_Ok{} }
 func (m *TopRoutesResponse_Ok) String() string { return proto.CompactTextString(m) }
 func (*TopRoutesResponse_Ok) ProtoMessage()    {}
 func (*TopRoutesResponse_Ok) Descriptor() ([]byte, []int) {
-	return fileDescriptor_413a91106d7bcce8, []int{33, 0}
+	return fileDescriptor_413a91106d7bcce8, []int{37, 0}
 }
 
 func (m *TopRoutesResponse_Ok) XXX_Unmarshal(b []byte) error {
@@ -3911,7 +4388,7 @@ func (m *RouteTable) Reset()         { *m = RouteTable{} }
 func (m *RouteTable) String() string { return proto.CompactTextString(m) }
 func (*RouteTable) ProtoMessage()    {}
 func (*RouteTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_413a91106d7bcce8, []int{34}
+	return fileDescriptor_413a91106d7bcce8, []int{38}
 }
 
 func (m *RouteTable) XXX_Unmarshal(b []byte) error {
@@ -3960,7 +4437,7 @@ func (m *RouteTable_Row) Reset()         { *m = RouteTable_Row{} }
 func (m *RouteTable_Row) String() string { return proto.CompactTextString(m) }
 func (*RouteTable_Row) ProtoMessage()    {}
 func (*RouteTable_Row) Descriptor() ([]byte, []int) {
-	return fileDescriptor_413a91106d7bcce8, []int{34, 0}
+	return fileDescriptor_413a91106d7bcce8, []int{38, 0}
 }
 
 func (m *RouteTable_Row) XXX_Unmarshal(b []byte) error {
@@ -4009,6 +4486,499 @@ func (m *RouteTable_Row) GetStats() *BasicStats {
 	return nil
 }
 
+type IdentityDenylistResponse struct {
+	// Identities which the identity service refuses to certify.
+	Identities           []string `protobuf:"bytes,1,rep,name=identities,proto3" json:"identities,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IdentityDenylistResponse) Reset()         { *m = IdentityDenylistResponse{} }
+func (m *IdentityDenylistResponse) String() string { return proto.CompactTextString(m) }
+func (*IdentityDenylistResponse) ProtoMessage()    {}
+func (*IdentityDenylistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_413a91106d7bcce8, []int{39}
+}
+
+func (m *IdentityDenylistResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IdentityDenylistResponse.Unmarshal(m, b)
+}
+func (m *IdentityDenylistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IdentityDenylistResponse.Marshal(b, m, deterministic)
+}
+func (m *IdentityDenylistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IdentityDenylistResponse.Merge(m, src)
+}
+func (m *IdentityDenylistResponse) XXX_Size() int {
+	return xxx_messageInfo_IdentityDenylistResponse.Size(m)
+}
+func (m *IdentityDenylistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_IdentityDenylistResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IdentityDenylistResponse proto.InternalMessageInfo
+
+func (m *IdentityDenylistResponse) GetIdentities() []string {
+	if m != nil {
+		return m.Identities
+	}
+	return nil
+}
+
+// SLOStatusRequest selects the service level objectives declared in
+// ServiceProfiles to report the status of. Empty fields select all of them.
+type SLOStatusRequest struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// The name of the ServiceProfile declaring the objectives.
+	Service              string   `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	Objective            string   `protobuf:"bytes,3,opt,name=objective,proto3" json:"objective,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SLOStatusRequest) Reset()         { *m = SLOStatusRequest{} }
+func (m *SLOStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*SLOStatusRequest) ProtoMessage()    {}
+func (*SLOStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_413a91106d7bcce8, []int{40}
+}
+
+func (m *SLOStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SLOStatusRequest.Unmarshal(m, b)
+}
+func (m *SLOStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SLOStatusRequest.Marshal(b, m, deterministic)
+}
+func (m *SLOStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SLOStatusRequest.Merge(m, src)
+}
+func (m *SLOStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_SLOStatusRequest.Size(m)
+}
+func (m *SLOStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SLOStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SLOStatusRequest proto.InternalMessageInfo
+
+func (m *SLOStatusRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *SLOStatusRequest) GetService() string {
+	if m != nil {
+		return m.Service
+	}
+	return ""
+}
+
+func (m *SLOStatusRequest) GetObjective() string {
+	if m != nil {
+		return m.Objective
+	}
+	return ""
+}
+
+type SLOStatusResponse struct {
+	// Types that are valid to be assigned to Response:
+	//	*SLOStatusResponse_Ok_
+	//	*SLOStatusResponse_Error
+	Response             isSLOStatusResponse_Response `protobuf_oneof:"response"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *SLOStatusResponse) Reset()         { *m = SLOStatusResponse{} }
+func (m *SLOStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*SLOStatusResponse) ProtoMessage()    {}
+func (*SLOStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_413a91106d7bcce8, []int{41}
+}
+
+func (m *SLOStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SLOStatusResponse.Unmarshal(m, b)
+}
+func (m *SLOStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SLOStatusResponse.Marshal(b, m, deterministic)
+}
+func (m *SLOStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SLOStatusResponse.Merge(m, src)
+}
+func (m *SLOStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_SLOStatusResponse.Size(m)
+}
+func (m *SLOStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SLOStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SLOStatusResponse proto.InternalMessageInfo
+
+type isSLOStatusResponse_Response interface {
+	isSLOStatusResponse_Response()
+}
+
+type SLOStatusResponse_Ok_ struct {
+	Ok *SLOStatusResponse_Ok `protobuf:"bytes,1,opt,name=ok,proto3,oneof"`
+}
+
+type SLOStatusResponse_Error struct {
+	Error string `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*SLOStatusResponse_Ok_) isSLOStatusResponse_Response() {}
+
+func (*SLOStatusResponse_Error) isSLOStatusResponse_Response() {}
+
+func (m *SLOStatusResponse) GetResponse() isSLOStatusResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *SLOStatusResponse) GetOk() *SLOStatusResponse_Ok {
+	if x, ok := m.GetResponse().(*SLOStatusResponse_Ok_); ok {
+		return x.Ok
+	}
+	return nil
+}
+
+func (m *SLOStatusResponse) GetError() string {
+	if x, ok := m.GetResponse().(*SLOStatusResponse_Error); ok {
+		return x.Error
+	}
+	return ""
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*SLOStatusResponse) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*SLOStatusResponse_Ok_)(nil),
+		(*SLOStatusResponse_Error)(nil),
+	}
+}
+
+type SLOStatusResponse_Ok struct {
+	Statuses             []*SLOStatus `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *SLOStatusResponse_Ok) Reset()         { *m = SLOStatusResponse_Ok{} }
+func (m *SLOStatusResponse_Ok) String() string { return proto.CompactTextString(m) }
+func (*SLOStatusResponse_Ok) ProtoMessage()    {}
+func (*SLOStatusResponse_Ok) Descriptor() ([]byte, []int) {
+	return fileDescriptor_413a91106d7bcce8, []int{41, 0}
+}
+
+func (m *SLOStatusResponse_Ok) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SLOStatusResponse_Ok.Unmarshal(m, b)
+}
+func (m *SLOStatusResponse_Ok) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SLOStatusResponse_Ok.Marshal(b, m, deterministic)
+}
+func (m *SLOStatusResponse_Ok) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SLOStatusResponse_Ok.Merge(m, src)
+}
+func (m *SLOStatusResponse_Ok) XXX_Size() int {
+	return xxx_messageInfo_SLOStatusResponse_Ok.Size(m)
+}
+func (m *SLOStatusResponse_Ok) XXX_DiscardUnknown() {
+	xxx_messageInfo_SLOStatusResponse_Ok.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SLOStatusResponse_Ok proto.InternalMessageInfo
+
+func (m *SLOStatusResponse_Ok) GetStatuses() []*SLOStatus {
+	if m != nil {
+		return m.Statuses
+	}
+	return nil
+}
+
+// SLOStatus is the status of one indicator of a service level objective:
+// either its success rate or its latency.
+type SLOStatus struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Service   string `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	Objective string `protobuf:"bytes,3,opt,name=objective,proto3" json:"objective,omitempty"`
+	Route     string `protobuf:"bytes,4,opt,name=route,proto3" json:"route,omitempty"`
+	// The window the objective is evaluated over, e.g. "30d".
+	Window string `protobuf:"bytes,5,opt,name=window,proto3" json:"window,omitempty"`
+	// Either "success_rate" or "latency".
+	Indicator string `protobuf:"bytes,6,opt,name=indicator,proto3" json:"indicator,omitempty"`
+	// The ratio of good responses the objective aims for.
+	Target float64 `protobuf:"fixed64,7,opt,name=target,proto3" json:"target,omitempty"`
+	// The latency under which responses are good, for latency indicators.
+	LatencyThresholdMs float64 `protobuf:"fixed64,8,opt,name=latency_threshold_ms,json=latencyThresholdMs,proto3" json:"latency_threshold_ms,omitempty"`
+	// Whether any response was observed over the window, without which the
+	// following fields are unset.
+	HasData bool `protobuf:"varint,9,opt,name=has_data,json=hasData,proto3" json:"has_data,omitempty"`
+	// The ratio of good responses over the window.
+	Actual float64 `protobuf:"fixed64,10,opt,name=actual,proto3" json:"actual,omitempty"`
+	// The ratio of the error budget left over the window, negative once
+	// overspent.
+	ErrorBudgetRemaining float64                    `protobuf:"fixed64,11,opt,name=error_budget_remaining,json=errorBudgetRemaining,proto3" json:"error_budget_remaining,omitempty"`
+	BurnRates            []*SLOStatus_BurnRate      `protobuf:"bytes,12,rep,name=burn_rates,json=burnRates,proto3" json:"burn_rates,omitempty"`
+	Alerts               []*SLOStatus_BurnRateAlert `protobuf:"bytes,13,rep,name=alerts,proto3" json:"alerts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *SLOStatus) Reset()         { *m = SLOStatus{} }
+func (m *SLOStatus) String() string { return proto.CompactTextString(m) }
+func (*SLOStatus) ProtoMessage()    {}
+func (*SLOStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_413a91106d7bcce8, []int{42}
+}
+
+func (m *SLOStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SLOStatus.Unmarshal(m, b)
+}
+func (m *SLOStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SLOStatus.Marshal(b, m, deterministic)
+}
+func (m *SLOStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SLOStatus.Merge(m, src)
+}
+func (m *SLOStatus) XXX_Size() int {
+	return xxx_messageInfo_SLOStatus.Size(m)
+}
+func (m *SLOStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_SLOStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SLOStatus proto.InternalMessageInfo
+
+func (m *SLOStatus) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *SLOStatus) GetService() string {
+	if m != nil {
+		return m.Service
+	}
+	return ""
+}
+
+func (m *SLOStatus) GetObjective() string {
+	if m != nil {
+		return m.Objective
+	}
+	return ""
+}
+
+func (m *SLOStatus) GetRoute() string {
+	if m != nil {
+		return m.Route
+	}
+	return ""
+}
+
+func (m *SLOStatus) GetWindow() string {
+	if m != nil {
+		return m.Window
+	}
+	return ""
+}
+
+func (m *SLOStatus) GetIndicator() string {
+	if m != nil {
+		return m.Indicator
+	}
+	return ""
+}
+
+func (m *SLOStatus) GetTarget() float64 {
+	if m != nil {
+		return m.Target
+	}
+	return 0
+}
+
+func (m *SLOStatus) GetLatencyThresholdMs() float64 {
+	if m != nil {
+		return m.LatencyThresholdMs
+	}
+	return 0
+}
+
+func (m *SLOStatus) GetHasData() bool {
+	if m != nil {
+		return m.HasData
+	}
+	return false
+}
+
+func (m *SLOStatus) GetActual() float64 {
+	if m != nil {
+		return m.Actual
+	}
+	return 0
+}
+
+func (m *SLOStatus) GetErrorBudgetRemaining() float64 {
+	if m != nil {
+		return m.ErrorBudgetRemaining
+	}
+	return 0
+}
+
+func (m *SLOStatus) GetBurnRates() []*SLOStatus_BurnRate {
+	if m != nil {
+		return m.BurnRates
+	}
+	return nil
+}
+
+func (m *SLOStatus) GetAlerts() []*SLOStatus_BurnRateAlert {
+	if m != nil {
+		return m.Alerts
+	}
+	return nil
+}
+
+// BurnRate is how many times faster than sustainable the error budget was
+// spent at over window.
+type SLOStatus_BurnRate struct {
+	Window               string   `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"`
+	HasData              bool     `protobuf:"varint,2,opt,name=has_data,json=hasData,proto3" json:"has_data,omitempty"`
+	Rate                 float64  `protobuf:"fixed64,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SLOStatus_BurnRate) Reset()         { *m = SLOStatus_BurnRate{} }
+func (m *SLOStatus_BurnRate) String() string { return proto.CompactTextString(m) }
+func (*SLOStatus_BurnRate) ProtoMessage()    {}
+func (*SLOStatus_BurnRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_413a91106d7bcce8, []int{42, 0}
+}
+
+func (m *SLOStatus_BurnRate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SLOStatus_BurnRate.Unmarshal(m, b)
+}
+func (m *SLOStatus_BurnRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SLOStatus_BurnRate.Marshal(b, m, deterministic)
+}
+func (m *SLOStatus_BurnRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SLOStatus_BurnRate.Merge(m, src)
+}
+func (m *SLOStatus_BurnRate) XXX_Size() int {
+	return xxx_messageInfo_SLOStatus_BurnRate.Size(m)
+}
+func (m *SLOStatus_BurnRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_SLOStatus_BurnRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SLOStatus_BurnRate proto.InternalMessageInfo
+
+func (m *SLOStatus_BurnRate) GetWindow() string {
+	if m != nil {
+		return m.Window
+	}
+	return ""
+}
+
+func (m *SLOStatus_BurnRate) GetHasData() bool {
+	if m != nil {
+		return m.HasData
+	}
+	return false
+}
+
+func (m *SLOStatus_BurnRate) GetRate() float64 {
+	if m != nil {
+		return m.Rate
+	}
+	return 0
+}
+
+// BurnRateAlert fires when the burn rates over both its long and short
+// windows exceed its threshold.
+type SLOStatus_BurnRateAlert struct {
+	LongWindow           string   `protobuf:"bytes,1,opt,name=long_window,json=longWindow,proto3" json:"long_window,omitempty"`
+	ShortWindow          string   `protobuf:"bytes,2,opt,name=short_window,json=shortWindow,proto3" json:"short_window,omitempty"`
+	Threshold            float64  `protobuf:"fixed64,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Severity             string   `protobuf:"bytes,4,opt,name=severity,proto3" json:"severity,omitempty"`
+	Firing               bool     `protobuf:"varint,5,opt,name=firing,proto3" json:"firing,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SLOStatus_BurnRateAlert) Reset()         { *m = SLOStatus_BurnRateAlert{} }
+func (m *SLOStatus_BurnRateAlert) String() string { return proto.CompactTextString(m) }
+func (*SLOStatus_BurnRateAlert) ProtoMessage()    {}
+func (*SLOStatus_BurnRateAlert) Descriptor() ([]byte, []int) {
+	return fileDescriptor_413a91106d7bcce8, []int{42, 1}
+}
+
+func (m *SLOStatus_BurnRateAlert) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SLOStatus_BurnRateAlert.Unmarshal(m, b)
+}
+func (m *SLOStatus_BurnRateAlert) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SLOStatus_BurnRateAlert.Marshal(b, m, deterministic)
+}
+func (m *SLOStatus_BurnRateAlert) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SLOStatus_BurnRateAlert.Merge(m, src)
+}
+func (m *SLOStatus_BurnRateAlert) XXX_Size() int {
+	return xxx_messageInfo_SLOStatus_BurnRateAlert.Size(m)
+}
+func (m *SLOStatus_BurnRateAlert) XXX_DiscardUnknown() {
+	xxx_messageInfo_SLOStatus_BurnRateAlert.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SLOStatus_BurnRateAlert proto.InternalMessageInfo
+
+func (m *SLOStatus_BurnRateAlert) GetLongWindow() string {
+	if m != nil {
+		return m.LongWindow
+	}
+	return ""
+}
+
+func (m *SLOStatus_BurnRateAlert) GetShortWindow() string {
+	if m != nil {
+		return m.ShortWindow
+	}
+	return ""
+}
+
+func (m *SLOStatus_BurnRateAlert) GetThreshold() float64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *SLOStatus_BurnRateAlert) GetSeverity() string {
+	if m != nil {
+		return m.Severity
+	}
+	return ""
+}
+
+func (m *SLOStatus_BurnRateAlert) GetFiring() bool {
+	if m != nil {
+		return m.Firing
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterEnum("linkerd2.public.HttpMethod_Registered", HttpMethod_Registered_name, HttpMethod_Registered_value)
 	proto.RegisterEnum("linkerd2.public.Scheme_Registered", Scheme_Registered_name, Scheme_Registered_value)
@@ -4026,6 +4996,8 @@ func init() {
 	proto.RegisterType((*TapByResourceRequest_Match)(nil), "linkerd2.public.TapByResourceRequest.Match")
 	proto.RegisterType((*TapByResourceRequest_Match_Seq)(nil), "linkerd2.public.TapByResourceRequest.Match.Seq")
 	proto.RegisterType((*TapByResourceRequest_Match_Http)(nil), "linkerd2.public.TapByResourceRequest.Match.Http")
+	proto.RegisterType((*TapByResourceRequest_Match_Http_StatusRange)(nil), "linkerd2.public.TapByResourceRequest.Match.Http.StatusRange")
+	proto.RegisterType((*TapByResourceRequest_Match_Http_Header)(nil), "linkerd2.public.TapByResourceRequest.Match.Http.Header")
 	proto.RegisterType((*TapByResourceRequest_Extract)(nil), "linkerd2.public.TapByResourceRequest.Extract")
 	proto.RegisterType((*TapByResourceRequest_Extract_Http)(nil), "linkerd2.public.TapByResourceRequest.Extract.Http")
 	proto.RegisterType((*TapByResourceRequest_Extract_Http_Headers)(nil), "linkerd2.public.TapByResourceRequest.Extract.Http.Headers")
@@ -4055,15 +5027,19 @@ func init() {
 	proto.RegisterType((*ResourceSelection)(nil), "linkerd2.public.ResourceSelection")
 	proto.RegisterType((*ResourceError)(nil), "linkerd2.public.ResourceError")
 	proto.RegisterType((*StatSummaryRequest)(nil), "linkerd2.public.StatSummaryRequest")
+	proto.RegisterType((*TimeRange)(nil), "linkerd2.public.TimeRange")
 	proto.RegisterType((*StatSummaryResponse)(nil), "linkerd2.public.StatSummaryResponse")
 	proto.RegisterType((*StatSummaryResponse_Ok)(nil), "linkerd2.public.StatSummaryResponse.Ok")
 	proto.RegisterType((*BasicStats)(nil), "linkerd2.public.BasicStats")
+	proto.RegisterType((*LatencyQuantile)(nil), "linkerd2.public.LatencyQuantile")
+	proto.RegisterType((*LatencyBucket)(nil), "linkerd2.public.LatencyBucket")
 	proto.RegisterType((*TcpStats)(nil), "linkerd2.public.TcpStats")
 	proto.RegisterType((*TrafficSplitStats)(nil), "linkerd2.public.TrafficSplitStats")
 	proto.RegisterType((*StatTable)(nil), "linkerd2.public.StatTable")
 	proto.RegisterType((*StatTable_PodGroup)(nil), "linkerd2.public.StatTable.PodGroup")
 	proto.RegisterType((*StatTable_PodGroup_Row)(nil), "linkerd2.public.StatTable.PodGroup.Row")
 	proto.RegisterMapType((map[string]*PodErrors)(nil), "linkerd2.public.StatTable.PodGroup.Row.ErrorsByPodEntry")
+	proto.RegisterType((*TimeSeriesPoint)(nil), "linkerd2.public.TimeSeriesPoint")
 	proto.RegisterType((*EdgesRequest)(nil), "linkerd2.public.EdgesRequest")
 	proto.RegisterType((*EdgesResponse)(nil), "linkerd2.public.EdgesResponse")
 	proto.RegisterType((*EdgesResponse_Ok)(nil), "linkerd2.public.EdgesResponse.Ok")
@@ -4073,219 +5049,275 @@ func init() {
 	proto.RegisterType((*TopRoutesResponse_Ok)(nil), "linkerd2.public.TopRoutesResponse.Ok")
 	proto.RegisterType((*RouteTable)(nil), "linkerd2.public.RouteTable")
 	proto.RegisterType((*RouteTable_Row)(nil), "linkerd2.public.RouteTable.Row")
+	proto.RegisterType((*IdentityDenylistResponse)(nil), "linkerd2.public.IdentityDenylistResponse")
+	proto.RegisterType((*SLOStatusRequest)(nil), "linkerd2.public.SLOStatusRequest")
+	proto.RegisterType((*SLOStatusResponse)(nil), "linkerd2.public.SLOStatusResponse")
+	proto.RegisterType((*SLOStatusResponse_Ok)(nil), "linkerd2.public.SLOStatusResponse.Ok")
+	proto.RegisterType((*SLOStatus)(nil), "linkerd2.public.SLOStatus")
+	proto.RegisterType((*SLOStatus_BurnRate)(nil), "linkerd2.public.SLOStatus.BurnRate")
+	proto.RegisterType((*SLOStatus_BurnRateAlert)(nil), "linkerd2.public.SLOStatus.BurnRateAlert")
 }
 
 func init() { proto.RegisterFile("public.proto", fileDescriptor_413a91106d7bcce8) }
 
 var fileDescriptor_413a91106d7bcce8 = []byte{
-	// 3301 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x1a, 0x4d, 0x6f, 0x23, 0x49,
-	0xd5, 0xdf, 0x1f, 0xcf, 0x4e, 0xe2, 0xa9, 0xc9, 0x0e, 0x5e, 0xef, 0xee, 0x7c, 0xf4, 0x7c, 0x6c,
-	0x98, 0x01, 0x27, 0x93, 0xf9, 0xd8, 0xc9, 0xcc, 0x2e, 0x10, 0x27, 0xde, 0x71, 0x60, 0x26, 0xf1,
-	0xb6, 0x3d, 0xbb, 0x68, 0xb5, 0xc8, 0xea, 0x74, 0x57, 0x9c, 0x26, 0xed, 0xae, 0x9e, 0xee, 0x72,
-	0x32, 0xfe, 0x03, 0x08, 0x09, 0x21, 0x24, 0x24, 0x6e, 0x48, 0x1c, 0x38, 0xb1, 0xe2, 0xcc, 0x05,
-	0x89, 0x03, 0x57, 0xae, 0x48, 0x88, 0xd3, 0x9e, 0x38, 0xad, 0x38, 0xc1, 0x89, 0x03, 0x42, 0xf5,
-	0xd5, 0xee, 0x8e, 0xed, 0x7c, 0xcc, 0x72, 0x80, 0x93, 0xeb, 0xbd, 0x7a, 0xef, 0xd5, 0xab, 0x57,
-	0xef, 0xab, 0xca, 0x0d, 0x65, 0x6f, 0xb8, 0xeb, 0xd8, 0x66, 0xdd, 0xf3, 0x09, 0x25, 0x68, 0xc1,
-	0xb1, 0xdd, 0x03, 0xec, 0x5b, 0xab, 0x75, 0x81, 0xae, 0x5d, 0xee, 0x13, 0xd2, 0x77, 0xf0, 0x32,
-	0x9f, 0xde, 0x1d, 0xee, 0x2d, 0x5b, 0x43, 0xdf, 0xa0, 0x36, 0x71, 0x05, 0x43, 0xad, 0x6a, 0x92,
-	0xc1, 0x80, 0xb8, 0xcb, 0xfb, 0xd8, 0x70, 0xe8, 0xbe, 0xb9, 0x8f, 0xcd, 0x03, 0x39, 0x73, 0xd1,
-	0x24, 0xee, 0x9e, 0xdd, 0x5f, 0x16, 0x3f, 0x02, 0xa9, 0xe5, 0x21, 0xdb, 0x1c, 0x78, 0x74, 0xa4,
-	0xbd, 0x84, 0xd2, 0xc7, 0xd8, 0x0f, 0x6c, 0xe2, 0x6e, 0xb9, 0x7b, 0x04, 0xbd, 0x0d, 0xc5, 0x3e,
-	0x91, 0x88, 0x6a, 0xf2, 0x6a, 0x72, 0xa9, 0xa8, 0x8f, 0x11, 0x6c, 0x76, 0x77, 0x68, 0x3b, 0xd6,
-	0xa6, 0x41, 0x71, 0x35, 0x25, 0x66, 0x43, 0x04, 0xba, 0x05, 0xf3, 0x3e, 0x76, 0xb0, 0x11, 0x60,
-	0x25, 0x20, 0xcd, 0x49, 0x8e, 0x61, 0xb5, 0x7b, 0x70, 0xf1, 0x99, 0x1d, 0xd0, 0x0e, 0xf6, 0x0f,
-	0x6d, 0x13, 0x07, 0x3a, 0x7e, 0x39, 0xc4, 0x01, 0x65, 0xc2, 0x5d, 0x63, 0x80, 0x03, 0xcf, 0x30,
-	0xb1, 0x5a, 0x3a, 0x44, 0x68, 0xcf, 0x60, 0x31, 0xce, 0x14, 0x78, 0xc4, 0x0d, 0x30, 0xba, 0x0f,
-	0x85, 0x40, 0xe2, 0xaa, 0xc9, 0xab, 0xe9, 0xa5, 0xd2, 0x6a, 0xb5, 0x7e, 0xcc, 0x76, 0x75, 0xc9,
-	0xa4, 0x87, 0x94, 0xda, 0x13, 0xc8, 0x4b, 0x24, 0x42, 0x90, 0x61, 0xab, 0xc8, 0x15, 0xf9, 0x38,
-	0xae, 0x4a, 0xea, 0xb8, 0x2a, 0x01, 0x2c, 0x30, 0x55, 0xda, 0xc4, 0x0a, 0x75, 0xbf, 0x3a, 0xa1,
-	0x7b, 0x23, 0x55, 0x4d, 0x46, 0x98, 0xd0, 0xb7, 0x98, 0x9e, 0x0e, 0x36, 0x29, 0xf1, 0xb9, 0xc4,
-	0xd2, 0xaa, 0x36, 0xa1, 0xa7, 0x8e, 0x03, 0x32, 0xf4, 0x4d, 0xdc, 0xe1, 0x84, 0x36, 0x71, 0xf5,
-	0x90, 0x47, 0x7b, 0x1f, 0x2a, 0xe3, 0x45, 0xe5, 0xde, 0x97, 0x20, 0xe3, 0x11, 0x4b, 0xed, 0x7b,
-	0x71, 0x42, 0x5e, 0x9b, 0x58, 0x3a, 0xa7, 0xd0, 0xfe, 0x95, 0x81, 0x74, 0x9b, 0x58, 0x53, 0x37,
-	0xbb, 0x08, 0x59, 0x8f, 0x58, 0x5b, 0x6d, 0xb9, 0x51, 0x01, 0xa0, 0xab, 0x00, 0x16, 0xf6, 0x1c,
-	0x32, 0x1a, 0x60, 0x97, 0x8a, 0x83, 0x6c, 0x25, 0xf4, 0x08, 0x0e, 0x5d, 0x83, 0x92, 0x8f, 0x3d,
-	0xc7, 0x36, 0x8d, 0x5e, 0x80, 0x69, 0x15, 0x14, 0x89, 0x44, 0x76, 0x30, 0x45, 0xef, 0xc1, 0x25,
-	0x09, 0xb1, 0xdd, 0xf4, 0x4c, 0xe2, 0x52, 0x9f, 0x38, 0x0e, 0xf6, 0xab, 0x25, 0x49, 0xfd, 0x46,
-	0x64, 0x7e, 0x23, 0x9c, 0x46, 0xd7, 0xa1, 0x1c, 0x50, 0x83, 0xe2, 0xbd, 0xa1, 0xc3, 0x85, 0x97,
-	0x25, 0x79, 0x49, 0x61, 0x99, 0xf4, 0x2b, 0x00, 0x96, 0x81, 0x07, 0xc4, 0xe5, 0x24, 0x73, 0x92,
-	0xa4, 0x28, 0x70, 0x8c, 0x00, 0x41, 0xfa, 0x87, 0x64, 0xb7, 0x3a, 0x2f, 0x67, 0x18, 0x80, 0x2e,
-	0x41, 0x8e, 0xc9, 0x18, 0x06, 0xd5, 0x0c, 0xdf, 0xae, 0x84, 0x98, 0x15, 0x0c, 0xcb, 0xc2, 0x56,
-	0x35, 0x7b, 0x35, 0xb9, 0x54, 0xd0, 0x05, 0x80, 0x36, 0x60, 0x21, 0xb0, 0x5d, 0x13, 0x3f, 0x33,
-	0x02, 0xaa, 0x63, 0x8f, 0xf8, 0xb4, 0x9a, 0xe3, 0x87, 0xf7, 0x66, 0x5d, 0xc4, 0x63, 0x5d, 0xc5,
-	0x63, 0x7d, 0x53, 0xc6, 0xa3, 0x7e, 0x9c, 0x03, 0xad, 0xc0, 0xc5, 0xf1, 0xce, 0xb7, 0x43, 0x37,
-	0xc9, 0xf3, 0xf5, 0xa7, 0x4d, 0x21, 0x0d, 0xca, 0x12, 0xdd, 0x76, 0x0c, 0x17, 0x57, 0x0b, 0x5c,
-	0xa7, 0x18, 0x0e, 0xdd, 0x85, 0xdc, 0xd0, 0xa3, 0xf6, 0x00, 0x57, 0x8b, 0xa7, 0x69, 0x24, 0x09,
-	0xd1, 0x65, 0x00, 0xcf, 0x27, 0xaf, 0x46, 0x3a, 0x36, 0xac, 0x51, 0x75, 0x81, 0x0b, 0x8d, 0x60,
-	0xd8, 0xb2, 0x1c, 0x52, 0xe1, 0x5b, 0xe1, 0x1a, 0xc6, 0x70, 0x68, 0x09, 0x16, 0x7c, 0xe9, 0xa6,
-	0x8a, 0xec, 0x02, 0x27, 0x3b, 0x8e, 0x6e, 0xe4, 0x21, 0x4b, 0x8e, 0x5c, 0xec, 0x6b, 0x9f, 0xa7,
-	0x00, 0xba, 0x86, 0xa7, 0x62, 0x05, 0x41, 0xda, 0x23, 0x96, 0x70, 0x41, 0x76, 0x2a, 0x1e, 0xb1,
-	0x8e, 0x79, 0x5b, 0x6a, 0x8a, 0xb7, 0x5d, 0x82, 0xdc, 0xc0, 0x78, 0xa5, 0x7b, 0x01, 0xf7, 0xc5,
-	0x94, 0x2e, 0x21, 0x86, 0xa7, 0xa4, 0xcd, 0x0e, 0x86, 0x9d, 0xe7, 0x9c, 0x2e, 0x21, 0xe6, 0xe9,
-	0x94, 0x6c, 0xb5, 0xf9, 0x71, 0x16, 0x75, 0x3e, 0x46, 0x35, 0x28, 0xec, 0xf9, 0x64, 0xd0, 0x56,
-	0xc7, 0x38, 0xa7, 0x87, 0x30, 0x93, 0xc3, 0xc6, 0x5b, 0x6d, 0x79, 0x2e, 0x12, 0xe2, 0xfe, 0x62,
-	0xee, 0xe3, 0x81, 0x38, 0x04, 0xe6, 0x2f, 0x1c, 0xe2, 0xfa, 0x60, 0xba, 0x4f, 0x2c, 0x6e, 0xfe,
-	0xa2, 0x2e, 0x21, 0x96, 0x3a, 0x8c, 0x21, 0xdd, 0x27, 0xbe, 0x4d, 0x47, 0x22, 0x26, 0xf4, 0x31,
-	0x82, 0x69, 0xe5, 0x19, 0x74, 0x5f, 0xb8, 0xbf, 0xce, 0xc7, 0x8f, 0x53, 0xd5, 0x64, 0xa3, 0x00,
-	0x39, 0x6a, 0xf8, 0x7d, 0x4c, 0xb5, 0x1f, 0x15, 0x60, 0xb1, 0x6b, 0x78, 0x8d, 0x91, 0x4a, 0x06,
-	0xca, 0x6c, 0x8f, 0x15, 0x09, 0xb7, 0xdc, 0xd9, 0xd2, 0x87, 0xe4, 0x40, 0xeb, 0x90, 0x1d, 0x18,
-	0xd4, 0xdc, 0x97, 0x99, 0xe7, 0xce, 0x04, 0xeb, 0xb4, 0x15, 0xeb, 0xcf, 0x19, 0x8b, 0x2e, 0x38,
-	0x67, 0xda, 0xff, 0x29, 0xe4, 0xf1, 0x2b, 0xea, 0x1b, 0xa6, 0x38, 0x80, 0xd2, 0xea, 0x37, 0xcf,
-	0x26, 0xbc, 0x29, 0x98, 0x74, 0xc5, 0x5d, 0xfb, 0x5d, 0x06, 0xb2, 0x7c, 0x45, 0xb4, 0x01, 0x69,
-	0xc3, 0x71, 0xe4, 0x36, 0x97, 0xcf, 0xa1, 0x6b, 0xbd, 0x83, 0x5f, 0x32, 0x8f, 0x32, 0x1c, 0x87,
-	0x0b, 0x71, 0x47, 0x72, 0xc3, 0xaf, 0x25, 0xc4, 0x1d, 0xa1, 0x6f, 0x43, 0xda, 0x25, 0x22, 0xfb,
-	0x9d, 0xcf, 0x6a, 0x4c, 0x80, 0x4b, 0x28, 0x6a, 0x41, 0xd9, 0xc2, 0x01, 0xb5, 0x5d, 0x1e, 0x88,
-	0x81, 0x34, 0xd1, 0x19, 0x8e, 0xae, 0x95, 0xd0, 0x63, 0x9c, 0xe8, 0x43, 0xc8, 0xec, 0x53, 0xea,
-	0x71, 0x7f, 0x2e, 0xad, 0xae, 0x9c, 0x67, 0x43, 0x2d, 0x4a, 0xbd, 0x56, 0x42, 0xe7, 0xfc, 0xb5,
-	0x67, 0x90, 0xee, 0xe0, 0x97, 0xa8, 0x09, 0x79, 0x7e, 0xae, 0x61, 0xd5, 0x3c, 0x97, 0x4f, 0x28,
-	0xde, 0xda, 0x08, 0x32, 0x4c, 0x3a, 0xaa, 0x86, 0x51, 0xa2, 0xc2, 0x5a, 0xc5, 0x49, 0x35, 0x8c,
-	0x13, 0x15, 0xd5, 0x2a, 0x52, 0x2e, 0x47, 0x23, 0x45, 0x15, 0x98, 0x48, 0xac, 0x2c, 0xca, 0x58,
-	0xc9, 0xc8, 0x29, 0x0e, 0xb1, 0xac, 0xc2, 0x17, 0x0f, 0x07, 0xb5, 0x3f, 0x27, 0x21, 0x2f, 0xbd,
-	0x09, 0xb5, 0xa4, 0x95, 0x84, 0xef, 0xac, 0x9e, 0xcb, 0x15, 0xe3, 0x76, 0xa2, 0x72, 0x67, 0x1f,
-	0x43, 0x7e, 0x1f, 0x1b, 0x16, 0xf6, 0x03, 0x29, 0xf4, 0xf1, 0xf9, 0x85, 0xd6, 0x5b, 0x42, 0x42,
-	0x2b, 0xa1, 0x2b, 0x61, 0xb5, 0x22, 0xe4, 0x25, 0xb6, 0x51, 0x0c, 0x43, 0x28, 0x32, 0xd4, 0xfe,
-	0x99, 0x04, 0x60, 0xcc, 0xcf, 0x85, 0xb5, 0x5a, 0x00, 0x3e, 0xee, 0xdb, 0x01, 0xc5, 0x3e, 0x16,
-	0xc9, 0x73, 0x7e, 0xf5, 0xd6, 0x84, 0x2a, 0x63, 0x86, 0xba, 0x1e, 0x52, 0x8b, 0xa2, 0xac, 0x20,
-	0x74, 0x03, 0xca, 0x43, 0x37, 0x22, 0x4b, 0x9d, 0x4b, 0x0c, 0xab, 0xb9, 0x00, 0x63, 0x09, 0x28,
-	0x0f, 0xe9, 0xa7, 0xcd, 0x6e, 0x25, 0x81, 0x0a, 0x90, 0x69, 0xef, 0x74, 0xba, 0x95, 0x24, 0x43,
-	0xb5, 0x5f, 0x74, 0x2b, 0x29, 0x04, 0x90, 0xdb, 0x6c, 0x3e, 0x6b, 0x76, 0x9b, 0x95, 0x34, 0x2a,
-	0x42, 0xb6, 0xbd, 0xde, 0xdd, 0x68, 0x55, 0x32, 0xa8, 0x04, 0xf9, 0x9d, 0x76, 0x77, 0x6b, 0x67,
-	0xbb, 0x53, 0xc9, 0x32, 0x60, 0x63, 0x67, 0x7b, 0xbb, 0xb9, 0xd1, 0xad, 0xe4, 0x98, 0x8c, 0x56,
-	0x73, 0x7d, 0xb3, 0x92, 0x67, 0xe4, 0x5d, 0x7d, 0x7d, 0xa3, 0x59, 0x29, 0x34, 0x72, 0x90, 0xa1,
-	0x23, 0x0f, 0x6b, 0xbf, 0x4a, 0x42, 0xae, 0x23, 0x5c, 0x67, 0x73, 0xca, 0x96, 0x27, 0x43, 0x47,
-	0x10, 0x7f, 0xd5, 0xed, 0x5e, 0x8b, 0x6d, 0x97, 0x69, 0xd8, 0xed, 0xb6, 0x2b, 0x09, 0xa6, 0x21,
-	0x1b, 0x75, 0x2a, 0xc9, 0x50, 0xc3, 0xdf, 0x24, 0xc3, 0xa3, 0x43, 0x6b, 0x51, 0xef, 0x60, 0x61,
-	0x74, 0x65, 0xf2, 0x48, 0xc4, 0xbc, 0xfc, 0x1d, 0x3b, 0x80, 0x09, 0x39, 0x81, 0x9a, 0xda, 0x94,
-	0xbd, 0x03, 0xc5, 0x43, 0xc3, 0x19, 0xe2, 0x5e, 0x40, 0xfd, 0x50, 0xe5, 0x02, 0x47, 0x75, 0xa8,
-	0x3f, 0x9e, 0xde, 0xb5, 0x45, 0x97, 0x5d, 0x0e, 0xa7, 0x1b, 0x36, 0x2f, 0xbd, 0x7c, 0xac, 0x75,
-	0xa1, 0xb8, 0xd5, 0x5e, 0xb7, 0x2c, 0x1f, 0x07, 0xac, 0xc5, 0xc9, 0xd8, 0xde, 0xe1, 0x7d, 0xbe,
-	0x4e, 0x9e, 0x39, 0x3a, 0x83, 0xd0, 0x1d, 0x8e, 0x7d, 0x28, 0x33, 0xe5, 0x1b, 0x13, 0xfa, 0x6f,
-	0xb5, 0x0f, 0x1f, 0x4a, 0xe2, 0x87, 0x8d, 0x0c, 0xa4, 0x6c, 0x4f, 0x5b, 0x81, 0x0c, 0xc3, 0xb2,
-	0x9e, 0x69, 0xcf, 0xf6, 0x03, 0x51, 0x91, 0x72, 0xba, 0x00, 0xd8, 0x76, 0x1c, 0x23, 0x10, 0x55,
-	0x3c, 0xa7, 0xf3, 0xb1, 0xf6, 0x0c, 0xa0, 0x6b, 0x7a, 0x4a, 0x91, 0xdb, 0x4c, 0x8a, 0x0c, 0xa7,
-	0xda, 0x94, 0x05, 0x25, 0x9d, 0x9e, 0xb2, 0x3d, 0x5e, 0x31, 0x59, 0xbd, 0x4e, 0xf1, 0x7a, 0xcd,
-	0xc7, 0x9a, 0x05, 0xe9, 0x26, 0x61, 0x62, 0x2a, 0x7d, 0xdf, 0x33, 0x7b, 0xa2, 0x83, 0xeb, 0x99,
-	0xc4, 0x12, 0x36, 0x9c, 0x6b, 0x25, 0xf4, 0x79, 0x36, 0xd3, 0xe1, 0x13, 0x1b, 0xc4, 0xc2, 0x8c,
-	0xd6, 0xc7, 0x01, 0xa6, 0x3d, 0xec, 0xfb, 0xc4, 0x17, 0xb4, 0x29, 0x45, 0xcb, 0x67, 0x9a, 0x6c,
-	0x82, 0xd1, 0x36, 0xb2, 0x90, 0xc6, 0xae, 0xa5, 0xfd, 0x63, 0x01, 0x0a, 0x5d, 0xc3, 0x6b, 0x1e,
-	0xb2, 0xf6, 0xe3, 0x1e, 0xe4, 0x44, 0x7c, 0x4b, 0xb5, 0xdf, 0x9a, 0xcc, 0x02, 0xe1, 0xfe, 0x74,
-	0x49, 0x8a, 0x9e, 0x42, 0x49, 0x8c, 0x7a, 0x03, 0x4c, 0x0d, 0x99, 0xba, 0x6f, 0x4d, 0xcb, 0x1f,
-	0x7c, 0x91, 0x7a, 0xd3, 0xb5, 0x3c, 0x62, 0xbb, 0xf4, 0x39, 0xa6, 0x86, 0x0e, 0x82, 0x95, 0x8d,
-	0xd1, 0x07, 0x50, 0x8a, 0x14, 0x03, 0x79, 0x54, 0x27, 0xaa, 0x10, 0xa5, 0x47, 0x1f, 0x41, 0x25,
-	0x02, 0x0a, 0x65, 0x32, 0xe7, 0x52, 0x66, 0x21, 0xc2, 0xcf, 0x35, 0x6a, 0x00, 0xf8, 0x64, 0x48,
-	0xe5, 0xce, 0xf2, 0x5c, 0xd8, 0xf5, 0xd9, 0xc2, 0x74, 0x46, 0xcb, 0x25, 0x15, 0x7d, 0x35, 0x44,
-	0x1f, 0xc1, 0x02, 0x6f, 0x2d, 0x7b, 0x96, 0xed, 0x8b, 0xaa, 0xc7, 0xbb, 0xb2, 0xf9, 0xd5, 0xa5,
-	0xd9, 0x82, 0xda, 0x8c, 0x61, 0x53, 0xd1, 0xeb, 0xf3, 0x5e, 0x0c, 0x46, 0xf7, 0x65, 0xfe, 0x17,
-	0x15, 0xfb, 0xf2, 0x6c, 0x39, 0xb1, 0x5c, 0xff, 0x8b, 0x24, 0x94, 0xa3, 0xdb, 0x45, 0xdf, 0x85,
-	0x9c, 0x63, 0xec, 0x62, 0x47, 0x45, 0xf5, 0xea, 0xd9, 0xcc, 0x54, 0x7f, 0xc6, 0x99, 0x9a, 0x2e,
-	0xf5, 0x47, 0xba, 0x94, 0x50, 0x5b, 0x83, 0x52, 0x04, 0x8d, 0x2a, 0x90, 0x3e, 0xc0, 0x23, 0x19,
-	0xeb, 0x6c, 0xc8, 0xa2, 0x88, 0x07, 0xab, 0xba, 0x7f, 0x71, 0xe0, 0x71, 0xea, 0x51, 0xb2, 0xf6,
-	0xb3, 0x24, 0x14, 0x43, 0xcb, 0xa1, 0xa7, 0xc7, 0x94, 0x5a, 0x3e, 0x83, 0xb9, 0xff, 0xdb, 0x1a,
-	0xfd, 0xb2, 0x28, 0xcb, 0xe2, 0x0e, 0x94, 0x7d, 0x51, 0xe9, 0x7a, 0xb6, 0x6b, 0xab, 0x9e, 0xf4,
-	0xf6, 0xc9, 0x06, 0xaf, 0xcb, 0xe2, 0xb8, 0xe5, 0xda, 0x94, 0x5d, 0xe6, 0xfc, 0x31, 0x88, 0x74,
-	0x98, 0xf3, 0xe5, 0xbd, 0x56, 0x48, 0x3c, 0xa1, 0x55, 0x8d, 0x49, 0x14, 0x3c, 0x52, 0x64, 0xd9,
-	0x8f, 0xc0, 0x42, 0x49, 0x29, 0x13, 0xbb, 0x96, 0xf4, 0x8a, 0xdb, 0x67, 0x14, 0xd9, 0x74, 0x2d,
-	0xa1, 0x64, 0x08, 0xd6, 0x1e, 0x42, 0xa1, 0x43, 0x7d, 0x6c, 0x0c, 0xb6, 0xf8, 0x55, 0x7a, 0xd7,
-	0x08, 0x64, 0xc6, 0xd1, 0xf9, 0x58, 0x5c, 0x2e, 0xd9, 0x3c, 0xd7, 0x3e, 0xa3, 0x4b, 0xa8, 0xf6,
-	0xf3, 0x14, 0x94, 0x22, 0x7b, 0x47, 0xef, 0x41, 0xca, 0xb6, 0xa4, 0xcd, 0xde, 0x3d, 0x45, 0x1d,
-	0xb5, 0xa0, 0x9e, 0xb2, 0x2d, 0x96, 0x86, 0x22, 0xdd, 0xd4, 0xb4, 0x1c, 0x30, 0xee, 0x00, 0xc2,
-	0x46, 0x6b, 0x39, 0x6c, 0xce, 0x84, 0x01, 0xbe, 0x36, 0xa3, 0x86, 0x86, 0x3d, 0x5b, 0xec, 0x0e,
-	0x93, 0x99, 0x75, 0x87, 0xc9, 0x8e, 0xef, 0x30, 0x68, 0x75, 0x5c, 0x07, 0xc5, 0xfd, 0xb8, 0x3a,
-	0xab, 0x0e, 0x8e, 0x0b, 0xe0, 0xdf, 0x92, 0x50, 0x8e, 0x1e, 0xdf, 0xeb, 0x5b, 0xe5, 0x29, 0x20,
-	0x7e, 0xe7, 0xee, 0xc5, 0x5c, 0x32, 0x75, 0xda, 0xb5, 0xb8, 0xc2, 0x99, 0xa2, 0xe7, 0x72, 0x05,
-	0x4a, 0x2c, 0x21, 0xc8, 0x8a, 0xc2, 0xcd, 0x35, 0xa7, 0x03, 0x43, 0x89, 0x52, 0x12, 0xdd, 0x67,
-	0xe6, 0xac, 0xfb, 0xfc, 0x82, 0x1f, 0x7e, 0xe8, 0x44, 0xff, 0x03, 0xdb, 0xdc, 0x82, 0x8b, 0x4a,
-	0x50, 0x34, 0xe2, 0xd2, 0xa7, 0x49, 0xba, 0x20, 0x25, 0x45, 0xce, 0xec, 0x26, 0xcc, 0x87, 0x42,
-	0x76, 0x47, 0x14, 0x0b, 0xbb, 0x64, 0xf4, 0x30, 0x98, 0x1b, 0x0c, 0x89, 0x6e, 0x41, 0x1a, 0x93,
-	0x40, 0x56, 0xc0, 0xc9, 0x87, 0xaa, 0x26, 0x09, 0x74, 0x46, 0x80, 0xee, 0x43, 0x81, 0xfa, 0x86,
-	0xed, 0x9c, 0xc5, 0x91, 0x42, 0x4a, 0xd6, 0xee, 0x60, 0x66, 0x33, 0xed, 0x11, 0xcc, 0xc7, 0x0b,
-	0x04, 0x6b, 0x3c, 0x5f, 0x6c, 0x7f, 0x6f, 0x7b, 0xe7, 0x93, 0xed, 0x4a, 0x82, 0x01, 0x5b, 0xdb,
-	0x8d, 0x9d, 0x17, 0xdb, 0x9b, 0x95, 0x24, 0x2a, 0x43, 0x61, 0xe7, 0x45, 0x57, 0x40, 0xa9, 0xb1,
-	0x88, 0xab, 0x50, 0x58, 0xf7, 0x6c, 0xde, 0x0c, 0xb0, 0x3c, 0xc8, 0xdb, 0x05, 0x99, 0x1b, 0x05,
-	0xa0, 0x7d, 0x9e, 0x82, 0x62, 0x9b, 0x58, 0x9c, 0x24, 0x40, 0x4f, 0x20, 0xc7, 0xd1, 0x2a, 0x2b,
-	0x5f, 0x9f, 0xf6, 0x0a, 0x27, 0x68, 0xc3, 0x91, 0x2e, 0x59, 0x6a, 0x5f, 0x24, 0xa1, 0xa0, 0x90,
-	0x48, 0x87, 0xa2, 0x49, 0x5c, 0x6a, 0xd8, 0x2e, 0xf6, 0x67, 0x5e, 0x60, 0x26, 0x85, 0xd5, 0x37,
-	0x14, 0x13, 0x07, 0xd9, 0x1d, 0x2a, 0x14, 0x53, 0x3b, 0x84, 0xf9, 0xf8, 0x34, 0xaa, 0x42, 0x7e,
-	0x80, 0x83, 0xc0, 0xe8, 0xab, 0x7e, 0x53, 0x81, 0x2c, 0xea, 0xc7, 0xeb, 0xcb, 0x47, 0xcf, 0x10,
-	0xc1, 0x6c, 0x61, 0x0f, 0x18, 0x97, 0x78, 0xd3, 0x15, 0x00, 0x4b, 0x78, 0x3e, 0x36, 0x02, 0xe2,
-	0xaa, 0xd7, 0x34, 0x01, 0x71, 0x73, 0x72, 0x63, 0xb5, 0xa1, 0xa0, 0x6e, 0x46, 0x27, 0x3f, 0xf0,
-	0xf2, 0x07, 0x9b, 0x91, 0xa7, 0x6a, 0x0e, 0x1f, 0x87, 0x9d, 0x71, 0x7a, 0xdc, 0x19, 0x6b, 0x2f,
-	0xe1, 0xc2, 0xc4, 0x6d, 0x19, 0x3d, 0x80, 0x82, 0x7a, 0x7e, 0x92, 0xa6, 0x7b, 0x73, 0xe6, 0x1d,
-	0x5b, 0x0f, 0x49, 0x99, 0xf7, 0xf2, 0x9a, 0xd8, 0x8b, 0x3d, 0xcd, 0x16, 0xf5, 0x39, 0x8e, 0xed,
-	0xa8, 0xb7, 0xd7, 0xcf, 0x60, 0x4e, 0x31, 0x0b, 0x23, 0xbe, 0xe6, 0x72, 0xa1, 0x3f, 0xa5, 0xa2,
-	0xfe, 0xf4, 0x65, 0x0a, 0x10, 0x4b, 0x2f, 0x9d, 0xe1, 0x60, 0x60, 0xf8, 0x23, 0xf5, 0xde, 0x13,
-	0x7d, 0x30, 0x4e, 0x9e, 0xff, 0xc1, 0x98, 0xe5, 0x32, 0x6a, 0x0f, 0x70, 0xef, 0xc8, 0x76, 0x2d,
-	0x72, 0x24, 0x97, 0x04, 0x86, 0xfa, 0x84, 0x63, 0xd0, 0x37, 0x20, 0xe3, 0x12, 0x57, 0x15, 0x85,
-	0x4b, 0x93, 0x41, 0x39, 0xf0, 0xe8, 0x88, 0xf5, 0x48, 0x8c, 0x0a, 0xbd, 0x0f, 0x25, 0x4a, 0x7a,
-	0xe1, 0xae, 0x33, 0xa7, 0xec, 0x9a, 0x5d, 0xc2, 0x28, 0x09, 0x8f, 0xfe, 0x3b, 0x30, 0xb7, 0xe7,
-	0x93, 0xc1, 0x98, 0x3f, 0x7b, 0x3a, 0x7f, 0x99, 0x71, 0x84, 0x12, 0xde, 0x01, 0x08, 0x0e, 0x6c,
-	0x91, 0x9a, 0x45, 0x6e, 0x28, 0xe8, 0x45, 0x86, 0x61, 0xa6, 0x0b, 0xd0, 0x5b, 0x50, 0xa4, 0xa6,
-	0x9a, 0xcd, 0xf3, 0xd9, 0x02, 0x35, 0xc5, 0x64, 0x03, 0xa0, 0x40, 0x86, 0x74, 0x97, 0x0c, 0x5d,
-	0x4b, 0xfb, 0x4b, 0x12, 0x2e, 0xc6, 0xac, 0x2d, 0xdf, 0xd2, 0xd7, 0x20, 0x45, 0x0e, 0x66, 0x66,
-	0xe5, 0x29, 0x1c, 0xf5, 0x9d, 0x83, 0x56, 0x42, 0x4f, 0x91, 0x03, 0xf4, 0x30, 0x7a, 0xac, 0xd3,
-	0xba, 0xce, 0x98, 0xf3, 0xb4, 0x12, 0xf2, 0xe0, 0x6b, 0xeb, 0x90, 0xda, 0x39, 0x40, 0x4f, 0x80,
-	0x3f, 0x6a, 0xf7, 0xa8, 0xb1, 0xeb, 0x84, 0xaf, 0x31, 0xb5, 0xa9, 0x1a, 0x74, 0x19, 0x89, 0x0e,
-	0x81, 0x1a, 0xf2, 0x9d, 0xa9, 0x44, 0xab, 0xfd, 0x36, 0x05, 0xd0, 0x30, 0x02, 0xdb, 0x14, 0x16,
-	0xb9, 0x0e, 0x73, 0xc1, 0xd0, 0x34, 0x71, 0xc0, 0x6e, 0x46, 0x43, 0x57, 0xb4, 0x68, 0x19, 0xbd,
-	0x2c, 0x91, 0x1b, 0x0c, 0xc7, 0x88, 0xf6, 0x0c, 0xdb, 0x19, 0xfa, 0x58, 0x12, 0x89, 0xbe, 0xa5,
-	0x2c, 0x91, 0x82, 0xe8, 0x06, 0x8b, 0x12, 0x8a, 0x5d, 0x73, 0xd4, 0x1b, 0x04, 0x3d, 0xef, 0xc1,
-	0x0a, 0x77, 0x99, 0x8c, 0x5e, 0x96, 0xd8, 0xe7, 0x41, 0xfb, 0xc1, 0xca, 0x71, 0xaa, 0xb5, 0x07,
-	0xb2, 0x12, 0x44, 0xa8, 0xd6, 0x1e, 0x4c, 0x50, 0xad, 0x71, 0x4f, 0x88, 0x53, 0xad, 0xa1, 0x15,
-	0x58, 0x34, 0x4c, 0x3a, 0x34, 0x9c, 0x5e, 0x7c, 0x0b, 0x39, 0x4e, 0x8b, 0xc4, 0x5c, 0x27, 0xba,
-	0x91, 0x31, 0x47, 0x7c, 0x3f, 0xf9, 0x28, 0xc7, 0x87, 0x91, 0x5d, 0x69, 0x3f, 0x49, 0x42, 0xa1,
-	0x2b, 0x3d, 0x04, 0x7d, 0x1d, 0x2a, 0xc4, 0xc3, 0xfc, 0x1f, 0x0a, 0x57, 0x44, 0x52, 0x20, 0xed,
-	0xb5, 0xc0, 0xf0, 0x1b, 0x63, 0x34, 0x5a, 0x62, 0x37, 0x49, 0xc3, 0x12, 0xd5, 0xae, 0x47, 0x09,
-	0x35, 0x1c, 0x69, 0xb5, 0x79, 0x86, 0xe7, 0xf5, 0xae, 0xcb, 0xb0, 0xe8, 0x36, 0x5c, 0x38, 0xf2,
-	0x6d, 0x8a, 0x63, 0xa4, 0xc2, 0x74, 0x0b, 0x7c, 0x62, 0x4c, 0xab, 0x75, 0xe0, 0x42, 0xd7, 0x37,
-	0xf6, 0xf6, 0x6c, 0xb3, 0xe3, 0x39, 0x36, 0x15, 0x5a, 0x21, 0xc8, 0x18, 0x1e, 0x7e, 0xa5, 0x52,
-	0x22, 0x1b, 0xf3, 0xdb, 0x35, 0x36, 0xf6, 0x54, 0x4a, 0x64, 0x63, 0x96, 0x85, 0x8f, 0xb0, 0xdd,
-	0xdf, 0xa7, 0x2a, 0x0b, 0x0b, 0x48, 0xfb, 0x77, 0x16, 0x8a, 0xa1, 0xdf, 0xa0, 0x06, 0x14, 0x3d,
-	0x62, 0xf5, 0xfa, 0x3e, 0x19, 0xaa, 0xcb, 0xf7, 0xf5, 0xd9, 0x6e, 0xc6, 0xea, 0xcb, 0x53, 0x46,
-	0xda, 0x4a, 0xe8, 0x05, 0x4f, 0x8e, 0x6b, 0xbf, 0xce, 0xf2, 0x82, 0xc5, 0x01, 0xf4, 0x04, 0x32,
-	0x3e, 0x39, 0x52, 0x2e, 0xfb, 0xee, 0x19, 0x64, 0xd5, 0x75, 0x72, 0xa4, 0x73, 0xa6, 0xda, 0x5f,
-	0x33, 0x90, 0xd6, 0xc9, 0xd1, 0xeb, 0xa6, 0xd2, 0x53, 0xb3, 0xdb, 0xf8, 0x7f, 0x9e, 0x62, 0xec,
-	0x7f, 0x9e, 0x25, 0xa8, 0x0c, 0x70, 0xb0, 0x8f, 0xad, 0x1e, 0x33, 0x86, 0x70, 0x12, 0x71, 0x26,
-	0xf3, 0x02, 0xdf, 0x26, 0x96, 0x70, 0xa9, 0xdb, 0x70, 0xc1, 0x1f, 0xba, 0xae, 0xed, 0xf6, 0x23,
-	0xa4, 0xc2, 0xa7, 0x17, 0xe4, 0x44, 0x48, 0xbb, 0x04, 0x15, 0xe6, 0x77, 0x31, 0xa9, 0xc2, 0x59,
-	0xe7, 0x05, 0x3e, 0xa4, 0xbc, 0x0b, 0x59, 0x91, 0xa4, 0xb2, 0x33, 0x1a, 0xf8, 0x71, 0x08, 0xeb,
-	0x82, 0x12, 0x3d, 0x8c, 0xe6, 0xb6, 0xc2, 0x0c, 0x1b, 0x29, 0x57, 0x1e, 0xa7, 0x3d, 0xf4, 0x01,
-	0x14, 0x68, 0x20, 0xd9, 0x60, 0x46, 0x05, 0x99, 0x70, 0x3a, 0x3d, 0x4f, 0x03, 0xc1, 0xfe, 0x19,
-	0xcc, 0x89, 0x36, 0xa5, 0xb7, 0x3b, 0x62, 0xdb, 0xaa, 0xe6, 0xf9, 0x39, 0x3f, 0x3a, 0xe3, 0x39,
-	0xd7, 0x45, 0x9f, 0xd2, 0x18, 0xb1, 0x46, 0x85, 0xdf, 0x3f, 0x4b, 0x78, 0x8c, 0xa9, 0x7d, 0x0a,
-	0x95, 0xe3, 0x04, 0x53, 0x6e, 0xa2, 0x2b, 0xd1, 0x9b, 0xe8, 0xb4, 0xb4, 0x18, 0xf6, 0x43, 0x91,
-	0x5b, 0x2a, 0xeb, 0x3e, 0x78, 0x36, 0xd5, 0xb6, 0xa1, 0xdc, 0xb4, 0xfa, 0xe3, 0xbf, 0x98, 0xbf,
-	0x62, 0x4d, 0xd5, 0x7e, 0x9f, 0x84, 0x39, 0x29, 0x50, 0x96, 0x8d, 0x7b, 0x91, 0xb2, 0x71, 0x6d,
-	0xb2, 0x84, 0x46, 0x69, 0xbf, 0x7a, 0xc1, 0xb8, 0xcb, 0x0b, 0xc6, 0x1d, 0xc8, 0x62, 0x26, 0x57,
-	0xc6, 0xdd, 0x1b, 0x53, 0x57, 0xd5, 0x05, 0x4d, 0xac, 0x40, 0xfc, 0x31, 0x09, 0x19, 0x36, 0x87,
-	0xee, 0x40, 0x3a, 0xf0, 0xcd, 0xd3, 0xc3, 0x8d, 0x51, 0x31, 0x62, 0x2b, 0x18, 0x5f, 0x33, 0x66,
-	0x13, 0x5b, 0x01, 0x65, 0x65, 0xd8, 0x74, 0x6c, 0xec, 0xd2, 0x9e, 0x6d, 0xc9, 0x14, 0x55, 0x10,
-	0x88, 0x2d, 0x8b, 0x4d, 0x06, 0xd8, 0x3f, 0xc4, 0x3e, 0x9b, 0x14, 0x99, 0xaa, 0x20, 0x10, 0x5b,
-	0x16, 0xba, 0x05, 0x0b, 0x2e, 0xe9, 0xd9, 0x16, 0x76, 0xa9, 0x4d, 0x59, 0x71, 0xe8, 0xcb, 0x0b,
-	0xe6, 0x9c, 0x4b, 0xb6, 0x24, 0xf6, 0x79, 0xd0, 0xd7, 0xbe, 0x4c, 0x42, 0xa5, 0x4b, 0x3c, 0xfe,
-	0xc2, 0x11, 0xfc, 0x7f, 0xf4, 0x4a, 0xf9, 0x73, 0xf5, 0x4a, 0xb1, 0x6e, 0xe5, 0x4f, 0x49, 0xb8,
-	0x10, 0xd9, 0xad, 0x74, 0xba, 0xd7, 0xf4, 0x1f, 0x76, 0xf3, 0x24, 0x07, 0x72, 0x0f, 0x37, 0x27,
-	0x53, 0xc1, 0xf1, 0x75, 0x42, 0x87, 0xad, 0xad, 0x71, 0xc7, 0xbb, 0x07, 0x39, 0xfe, 0x78, 0xa7,
-	0x3c, 0x6f, 0x32, 0x77, 0x71, 0x7e, 0xd1, 0xa5, 0x48, 0xd2, 0x98, 0x03, 0xfe, 0x3d, 0x09, 0x30,
-	0x26, 0x41, 0xf7, 0x62, 0xf5, 0xe3, 0xca, 0x09, 0xd2, 0xc6, 0x75, 0x03, 0xd5, 0x22, 0xf5, 0x42,
-	0x9c, 0x53, 0x08, 0xd7, 0x7e, 0x9a, 0x14, 0x35, 0x65, 0x11, 0xb2, 0x7c, 0x75, 0x75, 0x6f, 0xe3,
-	0xc0, 0xe9, 0x87, 0x1c, 0x7b, 0xf6, 0xc8, 0x1d, 0x7f, 0xf6, 0x38, 0x7f, 0xe2, 0x5e, 0xfd, 0x43,
-	0x0e, 0xd2, 0xeb, 0x9e, 0x8d, 0x3e, 0x85, 0x52, 0xa4, 0x81, 0x44, 0xd7, 0x4f, 0x6e, 0x2f, 0xb9,
-	0x4b, 0xd7, 0x6e, 0x9c, 0xa5, 0x07, 0xd5, 0x12, 0xa8, 0x05, 0x59, 0x9e, 0x65, 0xd0, 0x3b, 0xb3,
-	0xb2, 0x8f, 0x90, 0x77, 0xf9, 0xe4, 0xe4, 0xa4, 0x25, 0x50, 0x17, 0x8a, 0xa1, 0x0b, 0xa0, 0x6b,
-	0x27, 0xb9, 0x87, 0x90, 0xa8, 0x9d, 0xee, 0x41, 0x5a, 0x02, 0x7d, 0x04, 0x05, 0xf5, 0xdd, 0x0a,
-	0xba, 0x3a, 0xc1, 0x71, 0xec, 0x3b, 0x9a, 0xda, 0xb5, 0x13, 0x28, 0x42, 0x91, 0x3f, 0x80, 0x72,
-	0xf4, 0x53, 0x20, 0x74, 0x63, 0x2a, 0xd3, 0xb1, 0xcf, 0x8b, 0x6a, 0x37, 0x4f, 0xa1, 0x0a, 0xc5,
-	0x6f, 0x42, 0xba, 0x6b, 0x78, 0xe8, 0xad, 0x69, 0x4f, 0x33, 0x4a, 0xd8, 0x9b, 0x33, 0xdf, 0x6d,
-	0xb4, 0xf4, 0x8f, 0x53, 0xc9, 0x95, 0x24, 0xfa, 0x3e, 0xcc, 0xc5, 0xfe, 0x17, 0x44, 0x37, 0xcf,
-	0xf4, 0xbf, 0xe1, 0x19, 0x24, 0xaf, 0x43, 0x5e, 0x7d, 0x8c, 0x31, 0x23, 0x11, 0xd5, 0xde, 0x9e,
-	0xc0, 0x47, 0xbe, 0xf1, 0xd2, 0x12, 0xc8, 0x81, 0x62, 0x07, 0x3b, 0x7b, 0x1b, 0xfb, 0xd8, 0x3c,
-	0x40, 0x91, 0x3f, 0xec, 0xc5, 0x37, 0x64, 0xf5, 0xe8, 0x37, 0x64, 0x21, 0x9d, 0x52, 0xb0, 0x7e,
-	0x56, 0xf2, 0xd0, 0xa0, 0x8f, 0x20, 0xb7, 0xc1, 0xbf, 0x3d, 0x9b, 0xa9, 0xef, 0x62, 0x54, 0x26,
-	0xff, 0x4a, 0x6d, 0xdd, 0x71, 0xb4, 0x44, 0xe3, 0xde, 0xa7, 0x77, 0xfb, 0x36, 0xdd, 0x1f, 0xee,
-	0xb2, 0xa5, 0x96, 0x25, 0x8d, 0xfa, 0x5d, 0x5d, 0x1e, 0x7f, 0x3a, 0xb3, 0xdc, 0xc7, 0xee, 0xb2,
-	0x10, 0xb9, 0x9b, 0xe3, 0x0f, 0x57, 0xf7, 0xfe, 0x13, 0x00, 0x00, 0xff, 0xff, 0x49, 0xb3, 0x10,
-	0x28, 0x51, 0x27, 0x00, 0x00,
+	// 4087 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x7b, 0xcd, 0x6f, 0x1b, 0x49,
+	0x76, 0xb8, 0x9a, 0xdf, 0x7c, 0xa4, 0x24, 0xba, 0xec, 0xf1, 0x8f, 0xc3, 0xd9, 0xf1, 0x47, 0x7b,
+	0xc6, 0x3f, 0xed, 0x78, 0x97, 0xb2, 0xe5, 0xb1, 0x67, 0xe4, 0x99, 0xcd, 0xae, 0x28, 0x69, 0x2d,
+	0x65, 0x65, 0x89, 0x6e, 0x72, 0x66, 0x82, 0xc1, 0x06, 0x44, 0x8b, 0x5d, 0x22, 0x7b, 0xd5, 0xec,
+	0x6e, 0x77, 0x17, 0x6d, 0xf3, 0x3f, 0x08, 0x10, 0x04, 0x01, 0x02, 0xe4, 0xb2, 0x58, 0x20, 0xa7,
+	0x1c, 0xb2, 0x87, 0xfc, 0x03, 0x01, 0x02, 0x24, 0x40, 0x80, 0x00, 0xb9, 0x26, 0xe7, 0x45, 0x0e,
+	0xc9, 0x29, 0x7b, 0x4a, 0x4e, 0x7b, 0x0a, 0x5e, 0x7d, 0xf4, 0x07, 0x3f, 0x24, 0xca, 0xbb, 0x01,
+	0x92, 0x93, 0xea, 0xbd, 0x7a, 0xef, 0xd5, 0xab, 0xaa, 0xf7, 0x55, 0x8f, 0x2d, 0xa8, 0xfa, 0xe3,
+	0x53, 0xc7, 0xee, 0x37, 0xfd, 0xc0, 0x63, 0x1e, 0x59, 0x77, 0x6c, 0xf7, 0x9c, 0x06, 0xd6, 0x56,
+	0x53, 0xa0, 0x1b, 0xb7, 0x06, 0x9e, 0x37, 0x70, 0xe8, 0x26, 0x9f, 0x3e, 0x1d, 0x9f, 0x6d, 0x5a,
+	0xe3, 0xc0, 0x64, 0xb6, 0xe7, 0x0a, 0x86, 0xc6, 0xed, 0xe9, 0x79, 0x66, 0x8f, 0x68, 0xc8, 0xcc,
+	0x91, 0x2f, 0x09, 0xea, 0x7d, 0x6f, 0x34, 0xf2, 0xdc, 0xcd, 0x21, 0x35, 0x1d, 0x36, 0xec, 0x0f,
+	0x69, 0xff, 0x5c, 0xce, 0x5c, 0xef, 0x7b, 0xee, 0x99, 0x3d, 0xd8, 0x14, 0x7f, 0x04, 0x52, 0x2f,
+	0x42, 0x7e, 0x7f, 0xe4, 0xb3, 0x89, 0xfe, 0x0a, 0x2a, 0x5f, 0xd3, 0x20, 0xb4, 0x3d, 0xf7, 0xd0,
+	0x3d, 0xf3, 0xc8, 0x77, 0xa0, 0x3c, 0xf0, 0x24, 0xa2, 0xae, 0xdd, 0xd1, 0x36, 0xca, 0x46, 0x8c,
+	0xc0, 0xd9, 0xd3, 0xb1, 0xed, 0x58, 0x7b, 0x26, 0xa3, 0xf5, 0x8c, 0x98, 0x8d, 0x10, 0xe4, 0x3e,
+	0xac, 0x05, 0xd4, 0xa1, 0x66, 0x48, 0x95, 0x80, 0x2c, 0x27, 0x99, 0xc2, 0xea, 0x8f, 0xe1, 0xfa,
+	0x91, 0x1d, 0xb2, 0x0e, 0x0d, 0x5e, 0xdb, 0x7d, 0x1a, 0x1a, 0xf4, 0xd5, 0x98, 0x86, 0x0c, 0x85,
+	0xbb, 0xe6, 0x88, 0x86, 0xbe, 0xd9, 0xa7, 0x6a, 0xe9, 0x08, 0xa1, 0x1f, 0xc1, 0x8d, 0x34, 0x53,
+	0xe8, 0x7b, 0x6e, 0x48, 0xc9, 0xa7, 0x50, 0x0a, 0x25, 0xae, 0xae, 0xdd, 0xc9, 0x6e, 0x54, 0xb6,
+	0xea, 0xcd, 0xa9, 0xc3, 0x6d, 0x4a, 0x26, 0x23, 0xa2, 0xd4, 0xbf, 0x80, 0xa2, 0x44, 0x12, 0x02,
+	0x39, 0x5c, 0x45, 0xae, 0xc8, 0xc7, 0x69, 0x55, 0x32, 0xd3, 0xaa, 0x84, 0xb0, 0x8e, 0xaa, 0xb4,
+	0x3d, 0x2b, 0xd2, 0xfd, 0xce, 0x8c, 0xee, 0xad, 0x4c, 0x5d, 0x4b, 0x30, 0x91, 0xdf, 0x43, 0x3d,
+	0x1d, 0xda, 0x67, 0x5e, 0xc0, 0x25, 0x56, 0xb6, 0xf4, 0x19, 0x3d, 0x0d, 0x1a, 0x7a, 0xe3, 0xa0,
+	0x4f, 0x3b, 0x9c, 0xd0, 0xf6, 0x5c, 0x23, 0xe2, 0xd1, 0xbf, 0x84, 0x5a, 0xbc, 0xa8, 0xdc, 0xfb,
+	0x06, 0xe4, 0x7c, 0xcf, 0x52, 0xfb, 0xbe, 0x31, 0x23, 0xaf, 0xed, 0x59, 0x06, 0xa7, 0xd0, 0x7f,
+	0x93, 0x83, 0x6c, 0xdb, 0xb3, 0xe6, 0x6e, 0xf6, 0x06, 0xe4, 0x7d, 0xcf, 0x3a, 0x6c, 0xcb, 0x8d,
+	0x0a, 0x80, 0xdc, 0x01, 0xb0, 0xa8, 0xef, 0x78, 0x93, 0x11, 0x75, 0x99, 0xb8, 0xc8, 0x83, 0x15,
+	0x23, 0x81, 0x23, 0x77, 0xa1, 0x12, 0x50, 0xdf, 0xb1, 0xfb, 0x66, 0x2f, 0xa4, 0xac, 0x0e, 0x8a,
+	0x44, 0x22, 0x3b, 0x94, 0x91, 0xcf, 0xe0, 0xa6, 0x84, 0x70, 0x37, 0xbd, 0xbe, 0xe7, 0xb2, 0xc0,
+	0x73, 0x1c, 0x1a, 0xd4, 0x2b, 0x92, 0xfa, 0xbd, 0xc4, 0xfc, 0x6e, 0x34, 0x4d, 0xee, 0x41, 0x35,
+	0x64, 0x26, 0xa3, 0x67, 0x63, 0x87, 0x0b, 0xaf, 0x4a, 0xf2, 0x8a, 0xc2, 0xa2, 0xf4, 0xdb, 0x00,
+	0x96, 0x49, 0x47, 0x9e, 0xcb, 0x49, 0x56, 0x25, 0x49, 0x59, 0xe0, 0x90, 0x80, 0x40, 0xf6, 0x67,
+	0xde, 0x69, 0x7d, 0x4d, 0xce, 0x20, 0x40, 0x6e, 0x42, 0x01, 0x65, 0x8c, 0xc3, 0x7a, 0x8e, 0x6f,
+	0x57, 0x42, 0x78, 0x0a, 0xa6, 0x65, 0x51, 0xab, 0x9e, 0xbf, 0xa3, 0x6d, 0x94, 0x0c, 0x01, 0x90,
+	0x5d, 0x58, 0x0f, 0x6d, 0xb7, 0x4f, 0x8f, 0xcc, 0x90, 0x19, 0xd4, 0xf7, 0x02, 0x56, 0x2f, 0xf0,
+	0xcb, 0x7b, 0xbf, 0x29, 0x1c, 0xb2, 0xa9, 0x1c, 0xb2, 0xb9, 0x27, 0x1d, 0xd6, 0x98, 0xe6, 0x20,
+	0x0f, 0xe1, 0x7a, 0xbc, 0xf3, 0xe3, 0xc8, 0x4c, 0x8a, 0x7c, 0xfd, 0x79, 0x53, 0x44, 0x87, 0xaa,
+	0x44, 0xb7, 0x1d, 0xd3, 0xa5, 0xf5, 0x12, 0xd7, 0x29, 0x85, 0x23, 0x8f, 0xa0, 0x30, 0xf6, 0x31,
+	0x0a, 0xd4, 0xcb, 0x97, 0x69, 0x24, 0x09, 0xc9, 0x2d, 0x00, 0x3f, 0xf0, 0xde, 0x4e, 0x0c, 0x6a,
+	0x5a, 0x93, 0xfa, 0x3a, 0x17, 0x9a, 0xc0, 0xe0, 0xb2, 0x1c, 0x52, 0xee, 0x5b, 0xe3, 0x1a, 0xa6,
+	0x70, 0x64, 0x03, 0xd6, 0x03, 0x69, 0xa6, 0x8a, 0xec, 0x1a, 0x27, 0x9b, 0x46, 0xb7, 0x8a, 0x90,
+	0xf7, 0xde, 0xb8, 0x34, 0xd0, 0x7f, 0x99, 0x01, 0xe8, 0x9a, 0xbe, 0xf2, 0x15, 0x02, 0x59, 0xdf,
+	0xb3, 0xea, 0x9a, 0xba, 0x15, 0xdf, 0xb3, 0xa6, 0xac, 0x2d, 0x33, 0xc7, 0xda, 0x6e, 0x42, 0x61,
+	0x64, 0xbe, 0x35, 0xfc, 0x90, 0xdb, 0x62, 0xc6, 0x90, 0x10, 0xe2, 0x99, 0xd7, 0xc6, 0x8b, 0xc1,
+	0xfb, 0x5c, 0x35, 0x24, 0x84, 0x96, 0xce, 0xbc, 0xc3, 0x36, 0xbf, 0xce, 0xb2, 0xc1, 0xc7, 0xa4,
+	0x01, 0xa5, 0xb3, 0xc0, 0x1b, 0xb5, 0xd5, 0x35, 0xae, 0x1a, 0x11, 0x8c, 0x72, 0x70, 0x7c, 0xd8,
+	0x96, 0xf7, 0x22, 0x21, 0xc4, 0x87, 0xfd, 0x21, 0x1d, 0x89, 0x4b, 0x28, 0x1b, 0x12, 0xe2, 0xfa,
+	0x50, 0x36, 0xf4, 0x2c, 0x7e, 0xfc, 0x65, 0x43, 0x42, 0x18, 0x3a, 0xcc, 0x31, 0x1b, 0x7a, 0x81,
+	0xcd, 0x26, 0xc2, 0x27, 0x8c, 0x18, 0x81, 0x5a, 0xf9, 0x26, 0x1b, 0x0a, 0xf3, 0x37, 0xf8, 0xf8,
+	0x59, 0xa6, 0xae, 0xb5, 0x4a, 0x50, 0x60, 0x66, 0x30, 0xa0, 0x4c, 0xff, 0x0d, 0xc0, 0x8d, 0xae,
+	0xe9, 0xb7, 0x26, 0x2a, 0x18, 0xa8, 0x63, 0x7b, 0xa6, 0x48, 0xea, 0xda, 0xd2, 0xe1, 0x43, 0x72,
+	0x90, 0x1d, 0xc8, 0x8f, 0x4c, 0xd6, 0x1f, 0xca, 0xc8, 0xf3, 0x60, 0x86, 0x75, 0xde, 0x8a, 0xcd,
+	0x17, 0xc8, 0x62, 0x08, 0xce, 0x85, 0xe7, 0xff, 0x1c, 0x8a, 0xf4, 0x2d, 0x0b, 0xcc, 0xbe, 0xb8,
+	0x80, 0xca, 0xd6, 0xf7, 0x97, 0x13, 0xbe, 0x2f, 0x98, 0x0c, 0xc5, 0xdd, 0xf8, 0x65, 0x11, 0xf2,
+	0x7c, 0x45, 0xb2, 0x0b, 0x59, 0xd3, 0x71, 0xe4, 0x36, 0x37, 0xaf, 0xa0, 0x6b, 0xb3, 0x43, 0x5f,
+	0xa1, 0x45, 0x99, 0x8e, 0xc3, 0x85, 0xb8, 0x93, 0x7a, 0xe6, 0xdd, 0x85, 0xb8, 0x13, 0xf2, 0x43,
+	0xc8, 0xba, 0x9e, 0x88, 0x7e, 0x57, 0x3b, 0x35, 0x14, 0xe0, 0x7a, 0x8c, 0x1c, 0x40, 0xd5, 0xa2,
+	0x21, 0xb3, 0x5d, 0xee, 0x88, 0x61, 0x3d, 0xb7, 0xec, 0xd5, 0x1d, 0xac, 0x18, 0x29, 0x4e, 0xf2,
+	0x63, 0xc8, 0x0d, 0x19, 0xf3, 0xb9, 0x3d, 0x57, 0xb6, 0x1e, 0x5e, 0x65, 0x43, 0x07, 0x8c, 0xf9,
+	0x07, 0x2b, 0x06, 0xe7, 0x6f, 0x1c, 0x41, 0xb6, 0x43, 0x5f, 0x91, 0x7d, 0x28, 0xf2, 0x7b, 0x8d,
+	0xb2, 0xe6, 0x95, 0x6c, 0x42, 0xf1, 0x36, 0xfe, 0x23, 0x0b, 0x39, 0x14, 0x4f, 0xea, 0x91, 0x9b,
+	0x28, 0xbf, 0x96, 0x30, 0xce, 0x48, 0x47, 0x51, 0x6e, 0x2d, 0x61, 0x72, 0x2b, 0xe9, 0x2a, 0x2a,
+	0xc3, 0xc4, 0x28, 0x72, 0x43, 0x3a, 0x4b, 0x4e, 0x4e, 0x71, 0x88, 0x7c, 0x1d, 0x05, 0x70, 0x71,
+	0x14, 0x5f, 0x5e, 0xf5, 0x28, 0x9a, 0x1d, 0xce, 0x6e, 0x98, 0xee, 0x80, 0x72, 0x3d, 0x39, 0x48,
+	0xbe, 0x84, 0xca, 0xc8, 0x76, 0x7b, 0x8e, 0xc9, 0xa8, 0xdb, 0x9f, 0x5c, 0x1a, 0xe6, 0x31, 0x3c,
+	0x8d, 0x6c, 0xf7, 0x48, 0x90, 0x63, 0x32, 0x1c, 0x04, 0x7e, 0xbf, 0x27, 0x55, 0xc3, 0x18, 0xb2,
+	0x8a, 0x24, 0x88, 0x14, 0xeb, 0x91, 0x97, 0x50, 0x18, 0x52, 0xd3, 0xa2, 0x01, 0x8f, 0x24, 0x95,
+	0xad, 0xcf, 0xae, 0xac, 0xf8, 0x01, 0x67, 0x47, 0x9d, 0x85, 0xa0, 0xc6, 0x23, 0xa8, 0x24, 0x36,
+	0x43, 0x6a, 0x90, 0x1d, 0xd9, 0xa2, 0x6c, 0x5b, 0x35, 0x70, 0xc8, 0x31, 0xe6, 0xdb, 0x7a, 0x46,
+	0x62, 0xcc, 0xb7, 0x8d, 0x2d, 0x28, 0x08, 0x31, 0x8b, 0x6a, 0x81, 0xd7, 0xa6, 0x33, 0x56, 0x45,
+	0x8f, 0x00, 0x30, 0x92, 0xf3, 0x0b, 0x8f, 0x06, 0x8d, 0x7f, 0xd6, 0xa0, 0x28, 0x3d, 0x98, 0x1c,
+	0x48, 0xcb, 0x14, 0xfe, 0xba, 0x75, 0x25, 0xf7, 0x4f, 0xdb, 0x26, 0x93, 0xc6, 0xf4, 0x35, 0x14,
+	0xc5, 0x06, 0x43, 0x29, 0xf4, 0xd9, 0xd5, 0x85, 0xca, 0xc3, 0x0a, 0x0f, 0x56, 0x0c, 0x25, 0xac,
+	0x51, 0x86, 0xa2, 0xc4, 0xb6, 0xca, 0x51, 0xd8, 0x4a, 0x0c, 0xf5, 0xff, 0xd2, 0x00, 0x90, 0xf9,
+	0x85, 0x30, 0xd0, 0x03, 0x80, 0x80, 0x0e, 0xec, 0x90, 0xd1, 0x80, 0x8a, 0x84, 0xb5, 0xb6, 0x75,
+	0x7f, 0x46, 0x95, 0x98, 0xa1, 0x69, 0x44, 0xd4, 0xa2, 0x10, 0x52, 0x10, 0xf9, 0x08, 0xaa, 0x63,
+	0x37, 0x21, 0x4b, 0xb9, 0x42, 0x0a, 0xab, 0xbb, 0x00, 0xb1, 0x04, 0x52, 0x84, 0xec, 0xf3, 0xfd,
+	0x6e, 0x6d, 0x85, 0x94, 0x20, 0xd7, 0x3e, 0xe9, 0x74, 0x6b, 0x1a, 0xa2, 0xda, 0x5f, 0x75, 0x6b,
+	0x19, 0x02, 0x50, 0xd8, 0xdb, 0x3f, 0xda, 0xef, 0xee, 0xd7, 0xb2, 0xa4, 0x0c, 0xf9, 0xf6, 0x4e,
+	0x77, 0xf7, 0xa0, 0x96, 0x23, 0x15, 0x28, 0x9e, 0xb4, 0xbb, 0x87, 0x27, 0xc7, 0x9d, 0x5a, 0x1e,
+	0x81, 0xdd, 0x93, 0xe3, 0xe3, 0xfd, 0xdd, 0x6e, 0xad, 0x80, 0x32, 0x0e, 0xf6, 0x77, 0xf6, 0x6a,
+	0x45, 0x24, 0xef, 0x1a, 0x3b, 0xbb, 0xfb, 0xb5, 0x52, 0xab, 0x00, 0x39, 0x36, 0xf1, 0xa9, 0xfe,
+	0x17, 0x1a, 0x14, 0x3a, 0xc2, 0x5b, 0xf7, 0xe6, 0x6c, 0x79, 0x36, 0x5c, 0x09, 0xe2, 0xdf, 0x76,
+	0xbb, 0x77, 0x53, 0xdb, 0x45, 0x0d, 0xbb, 0xdd, 0x76, 0x6d, 0x05, 0x35, 0xc4, 0x51, 0xa7, 0xa6,
+	0x45, 0x1a, 0xfe, 0x95, 0x16, 0x5d, 0x1d, 0xd9, 0x4e, 0x5a, 0x07, 0x86, 0xae, 0xdb, 0xb3, 0x57,
+	0x22, 0xe6, 0xe5, 0xdf, 0xd8, 0x00, 0xfa, 0x17, 0x1a, 0xff, 0x87, 0x50, 0xe6, 0xf6, 0xde, 0x0b,
+	0x59, 0x10, 0xa9, 0x5c, 0xe2, 0xa8, 0x0e, 0x0b, 0xe2, 0xe9, 0x53, 0x5b, 0xbc, 0x6c, 0xaa, 0xd1,
+	0x74, 0xcb, 0xe6, 0xe5, 0x0e, 0x1f, 0xeb, 0x5d, 0x28, 0x1f, 0xb6, 0x77, 0x2c, 0x2b, 0xa0, 0x21,
+	0x96, 0x95, 0x39, 0xdb, 0x7f, 0xfd, 0x29, 0x5f, 0xa7, 0x88, 0x86, 0x8e, 0x10, 0x79, 0xc0, 0xb1,
+	0x4f, 0x65, 0x76, 0x7a, 0x6f, 0x46, 0xff, 0xc3, 0xf6, 0xeb, 0xa7, 0x92, 0xf8, 0x69, 0x2b, 0x07,
+	0x19, 0xdb, 0xd7, 0x1f, 0x42, 0x0e, 0xb1, 0xe8, 0xa1, 0x67, 0x76, 0x10, 0x8a, 0x2a, 0xa0, 0x60,
+	0x08, 0x00, 0xb7, 0xe3, 0x98, 0xa1, 0xa8, 0x9c, 0x0a, 0x06, 0x1f, 0xeb, 0x47, 0x00, 0xdd, 0xbe,
+	0xaf, 0x14, 0xf9, 0x04, 0xa5, 0x48, 0x77, 0x6a, 0xcc, 0x59, 0x50, 0xd2, 0x19, 0x19, 0xdb, 0x47,
+	0x69, 0xbc, 0xd4, 0x15, 0x61, 0x83, 0x8f, 0x75, 0x0b, 0xb2, 0xfb, 0x1e, 0x8a, 0xa9, 0x25, 0xe2,
+	0x5c, 0xaf, 0xef, 0x59, 0xe2, 0x0c, 0x31, 0xd8, 0xad, 0xc5, 0xc1, 0x6e, 0xd7, 0xb3, 0x28, 0xd2,
+	0x06, 0x34, 0xa4, 0xac, 0x47, 0x83, 0xc0, 0x0b, 0x04, 0x6d, 0x46, 0xd1, 0xf2, 0x99, 0x7d, 0x9c,
+	0x40, 0xda, 0x56, 0x1e, 0xb2, 0xd4, 0xb5, 0xf4, 0xff, 0x5c, 0x87, 0x52, 0xd7, 0xf4, 0xf7, 0x5f,
+	0x63, 0xc9, 0xf7, 0x18, 0x0a, 0xc2, 0xbf, 0xa5, 0xda, 0x1f, 0xcc, 0x46, 0x81, 0x68, 0x7f, 0x86,
+	0x24, 0x25, 0xcf, 0xa1, 0x22, 0x46, 0xbd, 0x11, 0x65, 0xa6, 0xcc, 0x11, 0xf7, 0xe7, 0xc5, 0x0f,
+	0xbe, 0x48, 0x73, 0xdf, 0xb5, 0x7c, 0xcf, 0x76, 0xd9, 0x0b, 0xca, 0x4c, 0x03, 0x04, 0x2b, 0x8e,
+	0xc9, 0x0f, 0xa0, 0x92, 0x48, 0xc0, 0xf5, 0xcc, 0xe5, 0x2a, 0x24, 0xe9, 0xc9, 0x4b, 0xa8, 0x25,
+	0x40, 0xa1, 0x4c, 0xee, 0x4a, 0xca, 0xac, 0x27, 0xf8, 0xb9, 0x46, 0x2d, 0x80, 0xc0, 0x1b, 0x33,
+	0xb9, 0xb3, 0x22, 0x17, 0x76, 0x6f, 0xb1, 0x30, 0x03, 0x69, 0xb9, 0xa4, 0x72, 0xa0, 0x86, 0xe4,
+	0x25, 0xac, 0xf3, 0x72, 0xbe, 0x67, 0xd9, 0x81, 0xa8, 0x34, 0x78, 0xa6, 0x5b, 0xdb, 0xda, 0x58,
+	0x2c, 0xa8, 0x8d, 0x0c, 0x7b, 0x8a, 0xde, 0x58, 0xf3, 0x53, 0x30, 0xf9, 0x54, 0xc6, 0x7f, 0x51,
+	0x25, 0xdd, 0x5a, 0x2c, 0x27, 0x15, 0xeb, 0xff, 0x5c, 0x83, 0x6a, 0x72, 0xbb, 0xe4, 0xf7, 0xa1,
+	0xe0, 0x98, 0xa7, 0xd4, 0x51, 0x5e, 0xbd, 0xb5, 0xdc, 0x31, 0x35, 0x8f, 0x38, 0xd3, 0xbe, 0xcb,
+	0x82, 0x89, 0x21, 0x25, 0x34, 0xb6, 0xa1, 0x92, 0x40, 0x63, 0x16, 0x3c, 0xa7, 0x13, 0xe9, 0xeb,
+	0x38, 0x9c, 0x9f, 0xe7, 0x9e, 0x65, 0x3e, 0xd7, 0x1a, 0x7f, 0xaa, 0x41, 0x39, 0x3a, 0x39, 0xf2,
+	0x7c, 0x4a, 0xa9, 0xcd, 0x25, 0x8e, 0xfb, 0x77, 0xad, 0xd1, 0x2f, 0xca, 0x32, 0x2d, 0x9e, 0x40,
+	0x35, 0x10, 0x99, 0xae, 0x67, 0xbb, 0xb6, 0x7a, 0x07, 0x7c, 0x72, 0xf1, 0x81, 0x37, 0x65, 0x72,
+	0x3c, 0x74, 0x6d, 0x86, 0x0f, 0xe8, 0x20, 0x06, 0x89, 0x01, 0xab, 0x81, 0xec, 0x25, 0x08, 0x89,
+	0x17, 0x3c, 0x0f, 0x52, 0x12, 0x05, 0x8f, 0x14, 0x59, 0x0d, 0x12, 0xb0, 0x50, 0x52, 0xca, 0xa4,
+	0xae, 0x55, 0xcf, 0x2e, 0xa9, 0xa4, 0x60, 0xd9, 0x77, 0x2d, 0xa1, 0x64, 0x04, 0x36, 0x9e, 0x42,
+	0xa9, 0xc3, 0x02, 0x6a, 0x8e, 0x0e, 0x79, 0xfb, 0xe2, 0xd4, 0x0c, 0x65, 0xc4, 0x31, 0xf8, 0x58,
+	0x3c, 0xe8, 0x71, 0x9e, 0x6b, 0x9f, 0x33, 0x24, 0xd4, 0xf8, 0xb3, 0x0c, 0x54, 0x12, 0x7b, 0x27,
+	0x9f, 0x41, 0xc6, 0xb6, 0xe4, 0x99, 0xfd, 0xff, 0x4b, 0xd4, 0x51, 0x0b, 0x1a, 0x19, 0xdb, 0xc2,
+	0x30, 0x94, 0x28, 0x60, 0xe7, 0xc5, 0x80, 0xb8, 0x02, 0x88, 0x6a, 0xdb, 0xcd, 0xa8, 0x1e, 0x16,
+	0x07, 0xf0, 0xff, 0x16, 0xe4, 0xd0, 0xa8, 0x4c, 0x4e, 0xbd, 0x1b, 0x73, 0x8b, 0xde, 0x8d, 0xf9,
+	0xf8, 0xdd, 0x48, 0xb6, 0xe2, 0x3c, 0x28, 0x8a, 0xd5, 0xfa, 0xa2, 0x3c, 0x18, 0x27, 0xc0, 0x7f,
+	0xd3, 0xa0, 0x9a, 0xbc, 0xbe, 0x77, 0x3f, 0x95, 0xe7, 0x40, 0x78, 0x9f, 0xa3, 0x97, 0x32, 0xc9,
+	0xcc, 0x65, 0xad, 0x88, 0x1a, 0x67, 0x4a, 0xde, 0xcb, 0x6d, 0xa8, 0x60, 0x40, 0x50, 0x95, 0x73,
+	0x96, 0x5f, 0x2d, 0x20, 0x4a, 0xd6, 0xcd, 0x89, 0x7d, 0xe6, 0x96, 0xdd, 0xe7, 0xaf, 0xf8, 0xe5,
+	0x47, 0x46, 0xf4, 0xbf, 0x60, 0x9b, 0x87, 0x70, 0x5d, 0x09, 0x4a, 0x7a, 0x5c, 0xf6, 0x32, 0x49,
+	0xd7, 0xa4, 0xa4, 0xc4, 0x9d, 0x7d, 0x8c, 0x7d, 0x56, 0x29, 0xe4, 0x74, 0xc2, 0xa8, 0x38, 0x97,
+	0x9c, 0x11, 0x39, 0x73, 0x0b, 0x91, 0xe4, 0x3e, 0x64, 0xa9, 0xa7, 0x5e, 0x49, 0xb3, 0xcd, 0xc1,
+	0x7d, 0x2f, 0x34, 0x90, 0x00, 0x3b, 0xa8, 0x2c, 0x30, 0x6d, 0x67, 0x19, 0x43, 0x8a, 0x28, 0xb1,
+	0xdc, 0xa1, 0x78, 0x66, 0xfa, 0xe7, 0xb0, 0x96, 0x4e, 0x10, 0x58, 0x78, 0x7e, 0x75, 0xfc, 0x93,
+	0xe3, 0x93, 0x6f, 0x8e, 0x6b, 0x2b, 0x08, 0x1c, 0x1e, 0xb7, 0x4e, 0xbe, 0x3a, 0xde, 0xab, 0x69,
+	0xa4, 0x0a, 0xa5, 0x93, 0xaf, 0xba, 0x02, 0xca, 0xc4, 0x22, 0xee, 0x40, 0x69, 0xc7, 0xb7, 0x79,
+	0x31, 0x80, 0x71, 0x90, 0x97, 0x0b, 0x32, 0x36, 0x0a, 0x00, 0x5b, 0x48, 0xe5, 0xb6, 0x67, 0x71,
+	0x92, 0x90, 0x7c, 0x01, 0x05, 0x8e, 0x56, 0x51, 0xf9, 0xde, 0xbc, 0xce, 0xa7, 0xa0, 0x8d, 0x46,
+	0x86, 0x64, 0x69, 0xfc, 0x4a, 0x83, 0x92, 0x42, 0x12, 0x03, 0xca, 0xd8, 0x54, 0x33, 0x6d, 0x97,
+	0x06, 0x0b, 0x1f, 0x30, 0xb3, 0xc2, 0x9a, 0xbb, 0x8a, 0x89, 0x83, 0xf8, 0x6c, 0x8d, 0xc4, 0x34,
+	0x5e, 0xc3, 0x5a, 0x7a, 0x9a, 0xd4, 0xa1, 0x38, 0xa2, 0x61, 0x68, 0x0e, 0x54, 0xbd, 0xa9, 0x40,
+	0xf4, 0xfa, 0x78, 0x7d, 0xd9, 0x68, 0x8e, 0x10, 0x78, 0x16, 0xf6, 0x08, 0xb9, 0x44, 0x1f, 0x5d,
+	0x00, 0x18, 0xf0, 0x02, 0x6a, 0x86, 0x9e, 0xab, 0x3a, 0x98, 0x02, 0xe2, 0xc7, 0xc9, 0x0f, 0xab,
+	0x0d, 0x25, 0xf5, 0x32, 0xba, 0xb8, 0xa9, 0xce, 0x9b, 0x64, 0x13, 0x5f, 0xe5, 0x1c, 0x3e, 0x8e,
+	0x2a, 0xe3, 0x6c, 0x5c, 0x19, 0xeb, 0xaf, 0xe0, 0xda, 0x4c, 0x87, 0x82, 0x3c, 0x81, 0x92, 0x6a,
+	0xf9, 0xc9, 0xa3, 0x7b, 0x7f, 0x61, 0x5f, 0xc3, 0x88, 0x48, 0xd1, 0x7a, 0x79, 0x4e, 0xec, 0xa5,
+	0xda, 0xe1, 0x65, 0x63, 0x95, 0x63, 0x3b, 0x12, 0xa9, 0xff, 0x14, 0x56, 0x15, 0xb3, 0x38, 0xc4,
+	0x77, 0x5c, 0x2e, 0xb2, 0xa7, 0x4c, 0xd2, 0x9e, 0x7e, 0x9e, 0x03, 0x82, 0xe1, 0xa5, 0x33, 0x1e,
+	0x8d, 0xcc, 0x60, 0xa2, 0x7a, 0x6c, 0xc9, 0x26, 0xbd, 0x76, 0xf5, 0x26, 0x3d, 0xc6, 0x32, 0x6c,
+	0xb4, 0xf6, 0xde, 0xd8, 0xae, 0xe5, 0xbd, 0x91, 0x4b, 0x02, 0xa2, 0xbe, 0xe1, 0x18, 0xf2, 0x3d,
+	0xc8, 0xb9, 0x9e, 0xab, 0x92, 0xc2, 0xcd, 0x59, 0xa7, 0xc4, 0xdf, 0x64, 0xb0, 0x46, 0x42, 0x2a,
+	0x6c, 0x49, 0x30, 0xaf, 0x17, 0xed, 0x3a, 0x77, 0xc9, 0xae, 0xf1, 0x11, 0xc6, 0x3c, 0x05, 0x91,
+	0x1f, 0xc1, 0x2a, 0xf6, 0x30, 0x63, 0xfe, 0xfc, 0xe5, 0xfc, 0x55, 0xe4, 0x88, 0x24, 0x7c, 0x08,
+	0x10, 0x9e, 0xdb, 0x22, 0x34, 0x8b, 0xd8, 0x50, 0x32, 0xca, 0x88, 0xc1, 0xa3, 0x0b, 0xc9, 0x07,
+	0x50, 0x66, 0x7d, 0x35, 0x5b, 0xe4, 0xb3, 0x25, 0xd6, 0x97, 0x93, 0xdb, 0xc0, 0xf7, 0xdd, 0x0b,
+	0xb0, 0x33, 0x51, 0x2f, 0x2d, 0x78, 0x77, 0x74, 0xed, 0x11, 0xe5, 0xbd, 0x0b, 0xa3, 0xcc, 0xd4,
+	0x90, 0x3c, 0x80, 0x6b, 0xb2, 0x0b, 0xd3, 0x7b, 0x35, 0x36, 0x5d, 0x66, 0x3b, 0x34, 0xac, 0x97,
+	0xef, 0x64, 0x37, 0x34, 0xa3, 0x26, 0x27, 0x5e, 0x2a, 0x7c, 0x92, 0x78, 0x68, 0x87, 0xcc, 0x1b,
+	0x04, 0xe6, 0x88, 0xf7, 0x5d, 0x4b, 0x11, 0xf1, 0x81, 0xc2, 0xa3, 0x23, 0x62, 0xab, 0x7d, 0xec,
+	0x87, 0xbc, 0x03, 0x5b, 0x32, 0x14, 0xd8, 0x02, 0x28, 0x79, 0x63, 0x76, 0xea, 0x8d, 0x5d, 0x4b,
+	0xff, 0xb9, 0x06, 0xe5, 0x48, 0x31, 0xf2, 0x10, 0xf2, 0x21, 0x33, 0x03, 0x16, 0xbd, 0x9d, 0xa6,
+	0x43, 0x75, 0x57, 0xfd, 0x12, 0x67, 0x08, 0x42, 0xf2, 0x3d, 0xfe, 0x96, 0xa9, 0x67, 0x2e, 0xa5,
+	0x47, 0x32, 0xf2, 0x7d, 0xc8, 0x85, 0x8c, 0xfa, 0x97, 0x67, 0x02, 0x4e, 0xa6, 0xff, 0x4b, 0x06,
+	0xae, 0xa7, 0x2c, 0x57, 0xfe, 0x16, 0xb4, 0x0d, 0x19, 0xef, 0x7c, 0x61, 0x86, 0x9b, 0xc3, 0xd1,
+	0x3c, 0x39, 0x3f, 0x58, 0x31, 0x32, 0xde, 0x39, 0x79, 0x9a, 0x74, 0x91, 0x79, 0x15, 0x7c, 0xca,
+	0x11, 0x0f, 0x56, 0xa4, 0x13, 0x35, 0xfe, 0x56, 0x83, 0xcc, 0xc9, 0x39, 0xf9, 0x02, 0xf8, 0xaf,
+	0x32, 0x3d, 0x66, 0x9e, 0x3a, 0x51, 0x3b, 0xb1, 0x31, 0x57, 0x85, 0x2e, 0x92, 0x18, 0x10, 0xaa,
+	0x61, 0x48, 0x7e, 0x88, 0xed, 0x7b, 0x66, 0x3a, 0x61, 0x3d, 0x73, 0x81, 0xea, 0x9c, 0x18, 0xa3,
+	0xef, 0xf3, 0xc0, 0x1b, 0xfb, 0x4d, 0xc3, 0x7b, 0x63, 0x48, 0x36, 0xf2, 0x0c, 0x20, 0x8a, 0x67,
+	0xa1, 0x3c, 0xc4, 0x0b, 0x17, 0x8f, 0xa9, 0xf1, 0xd2, 0x55, 0xca, 0xd4, 0xff, 0x31, 0x0b, 0xd0,
+	0x32, 0x43, 0xbb, 0x2f, 0xcc, 0xf7, 0x1e, 0xac, 0x86, 0xe3, 0x7e, 0x9f, 0x86, 0xf8, 0xc6, 0x1d,
+	0xbb, 0xe2, 0xf6, 0x73, 0x46, 0x55, 0x22, 0x77, 0x11, 0x87, 0x44, 0x67, 0xa6, 0xed, 0x8c, 0x03,
+	0x2a, 0x89, 0x44, 0x05, 0x5a, 0x95, 0x48, 0x41, 0xf4, 0x11, 0xac, 0x49, 0x3b, 0xec, 0x8d, 0xc2,
+	0x9e, 0xff, 0xe4, 0x21, 0x57, 0x32, 0x67, 0x54, 0x25, 0xf6, 0x45, 0xd8, 0x7e, 0xf2, 0x70, 0x9a,
+	0x6a, 0xfb, 0x49, 0x3d, 0x37, 0x4d, 0xb5, 0xfd, 0x64, 0x86, 0x6a, 0xbb, 0x9e, 0x9f, 0xa1, 0xda,
+	0x26, 0x0f, 0xe1, 0x86, 0xd9, 0x67, 0x63, 0xd3, 0xe9, 0xa5, 0xb7, 0x50, 0xe0, 0xb4, 0x44, 0xcc,
+	0x75, 0x92, 0x1b, 0x89, 0x39, 0xd2, 0xfb, 0x29, 0x26, 0x39, 0x7e, 0x9c, 0xdc, 0xd5, 0x8b, 0x79,
+	0x3e, 0x5a, 0xe2, 0x57, 0x7f, 0x67, 0xe6, 0xf4, 0x8f, 0xd2, 0x4e, 0x3b, 0xc7, 0x8b, 0x7f, 0x32,
+	0xcf, 0x8b, 0xcb, 0x77, 0xb2, 0x73, 0xcd, 0x51, 0x8a, 0x6b, 0x8d, 0xfb, 0xe7, 0x94, 0xcd, 0x7a,
+	0xb9, 0x7e, 0x04, 0xeb, 0x53, 0x2b, 0xe2, 0x2f, 0x3f, 0x4a, 0x4d, 0x7e, 0x93, 0x9a, 0x11, 0xc1,
+	0x18, 0xe5, 0xe2, 0x43, 0x95, 0x57, 0x58, 0x8e, 0x0e, 0x54, 0x7f, 0x06, 0xab, 0xa9, 0x05, 0xc9,
+	0x75, 0xc8, 0x3b, 0x14, 0x49, 0x85, 0xa0, 0x9c, 0x43, 0x5f, 0xf0, 0x9f, 0x0f, 0x93, 0x26, 0x20,
+	0x00, 0xfd, 0x8f, 0x35, 0x28, 0x75, 0x55, 0x44, 0xfc, 0x2e, 0xd4, 0x3c, 0x9f, 0xf2, 0x5f, 0x41,
+	0x5d, 0x91, 0x39, 0x42, 0x69, 0x55, 0xeb, 0x88, 0xdf, 0x8d, 0xd1, 0x64, 0x03, 0x3b, 0x27, 0xa6,
+	0x25, 0xaa, 0xbb, 0x1e, 0xb7, 0x74, 0x29, 0x78, 0x0d, 0xf1, 0xbc, 0xbe, 0xeb, 0x22, 0x96, 0x7c,
+	0x02, 0xd7, 0xde, 0x04, 0x36, 0xa3, 0x29, 0x52, 0x61, 0x60, 0xeb, 0x7c, 0x22, 0xa6, 0xd5, 0x3b,
+	0x70, 0xad, 0x1b, 0x98, 0x67, 0x67, 0x76, 0xbf, 0xe3, 0x3b, 0x36, 0x13, 0x5a, 0x11, 0xc8, 0x99,
+	0x3e, 0x7d, 0xab, 0x4a, 0x00, 0x1c, 0x23, 0xce, 0xa1, 0xe6, 0x99, 0x2a, 0x01, 0x70, 0x8c, 0x55,
+	0xc7, 0x1b, 0x6a, 0x0f, 0x86, 0x4c, 0x55, 0x1d, 0x02, 0xd2, 0xff, 0xae, 0x00, 0xe5, 0xc8, 0xbb,
+	0x48, 0x0b, 0xca, 0xbe, 0x67, 0xf5, 0x06, 0xe8, 0xa6, 0x32, 0x18, 0xdd, 0x5b, 0xc2, 0xa3, 0xb1,
+	0x91, 0xe6, 0xcb, 0x71, 0xe3, 0xdf, 0xf3, 0xbc, 0x40, 0xe3, 0x00, 0xf9, 0x02, 0x72, 0x81, 0xf7,
+	0x46, 0x45, 0x95, 0xa5, 0xa3, 0x03, 0x67, 0x6a, 0xfc, 0x22, 0x0f, 0x59, 0xc3, 0x7b, 0xf3, 0xae,
+	0xa5, 0xc3, 0xa5, 0xd9, 0x3c, 0xfe, 0x2d, 0xb9, 0x9c, 0xfa, 0x2d, 0x79, 0x03, 0x6a, 0x23, 0x1a,
+	0x0e, 0xa9, 0xd5, 0xc3, 0xc3, 0x10, 0x76, 0x21, 0xee, 0x64, 0x4d, 0xe0, 0xdb, 0x9e, 0x25, 0xdc,
+	0xe8, 0x13, 0xb8, 0x16, 0x8c, 0x5d, 0xd7, 0x76, 0x07, 0x09, 0x52, 0xe1, 0xf9, 0xeb, 0x72, 0x22,
+	0xa2, 0xdd, 0x80, 0x1a, 0x7a, 0x67, 0x4a, 0xaa, 0x70, 0xe9, 0x35, 0x81, 0x8f, 0x28, 0x1f, 0xf1,
+	0x94, 0xc5, 0x54, 0xed, 0x3f, 0xfb, 0x60, 0x8d, 0x03, 0x9d, 0x21, 0x28, 0xc9, 0xd3, 0x64, 0x2e,
+	0x2f, 0x2d, 0x38, 0x23, 0x65, 0xca, 0x89, 0x34, 0xff, 0x03, 0x28, 0xb1, 0x50, 0xb2, 0xc1, 0x82,
+	0x8a, 0x69, 0xc6, 0xe8, 0x8c, 0x22, 0x0b, 0x05, 0xfb, 0x4f, 0x61, 0x55, 0x94, 0xe5, 0xbd, 0xd3,
+	0x09, 0x6e, 0xab, 0x5e, 0xe4, 0xf7, 0xfc, 0xf9, 0x92, 0xf7, 0xdc, 0x14, 0x75, 0x79, 0x6b, 0x82,
+	0x85, 0x39, 0xef, 0xb7, 0x54, 0x68, 0x8c, 0x21, 0x3b, 0xf2, 0x02, 0x43, 0x1a, 0xd8, 0x14, 0x53,
+	0xfe, 0xfc, 0xf0, 0x84, 0x09, 0xb9, 0xc3, 0x49, 0xda, 0xd8, 0x55, 0x12, 0x57, 0x2c, 0x10, 0x8d,
+	0x6f, 0xa1, 0x36, 0xbd, 0xc6, 0x9c, 0xe6, 0xcd, 0xc3, 0x64, 0xf3, 0x66, 0x5e, 0xfe, 0x89, 0x9e,
+	0x10, 0x89, 0xc6, 0x0e, 0x16, 0xec, 0x3c, 0x67, 0xea, 0x0c, 0xd6, 0xa7, 0x74, 0x20, 0x4d, 0xc8,
+	0xf1, 0xdf, 0xf6, 0x2f, 0x2f, 0x3a, 0x38, 0x5d, 0x7c, 0xe5, 0x99, 0x65, 0xaf, 0x5c, 0x3f, 0x86,
+	0xea, 0xbe, 0x35, 0xa0, 0xe1, 0xef, 0xa8, 0xf8, 0xd5, 0xff, 0x46, 0x83, 0x55, 0x29, 0x50, 0xd6,
+	0x24, 0x8f, 0x13, 0x35, 0xc9, 0xdd, 0xd9, 0x5a, 0x37, 0x49, 0xfb, 0xdb, 0x57, 0x23, 0x8f, 0x78,
+	0x31, 0xf2, 0x00, 0xf2, 0x14, 0xe5, 0xca, 0x80, 0xf1, 0xde, 0xdc, 0x55, 0x0d, 0x41, 0x93, 0xca,
+	0xff, 0x7f, 0xaf, 0x41, 0x0e, 0xe7, 0xc8, 0x03, 0xc8, 0x86, 0x41, 0xff, 0xf2, 0x38, 0x81, 0x54,
+	0x48, 0x6c, 0x85, 0x71, 0x3f, 0x60, 0x31, 0xb1, 0x15, 0x32, 0xac, 0x97, 0xfb, 0x8e, 0x4d, 0x5d,
+	0xd6, 0xb3, 0x2d, 0x19, 0x5b, 0x4b, 0x02, 0x71, 0x68, 0xe1, 0x24, 0x7e, 0x9d, 0x44, 0x03, 0x9c,
+	0x14, 0x21, 0xb6, 0x24, 0x10, 0x87, 0x16, 0xb9, 0x0f, 0xeb, 0xae, 0xd7, 0xb3, 0x2d, 0xea, 0x32,
+	0x9b, 0x61, 0x9a, 0x1a, 0xc8, 0x4e, 0xd0, 0xaa, 0xeb, 0x1d, 0x4a, 0xec, 0x8b, 0x70, 0xa0, 0xff,
+	0x43, 0x06, 0x6a, 0x5d, 0xcf, 0xe7, 0xad, 0xc8, 0xf0, 0xff, 0xc6, 0xa3, 0xa6, 0x78, 0xb5, 0x47,
+	0xcd, 0x83, 0x45, 0x75, 0xc7, 0xd2, 0x6f, 0x83, 0xf2, 0xfc, 0xb7, 0x41, 0xea, 0x05, 0xf0, 0x4f,
+	0x1a, 0x5c, 0x4b, 0x9c, 0xa3, 0x34, 0xe7, 0x77, 0xb4, 0x4c, 0x6c, 0x3e, 0x79, 0xe7, 0xf2, 0x74,
+	0x3e, 0x9e, 0x8d, 0x3e, 0xd3, 0xeb, 0x44, 0xae, 0xd0, 0xd8, 0xe6, 0x26, 0xfd, 0x18, 0x0a, 0xbc,
+	0x7f, 0xaf, 0x6c, 0x7a, 0xd6, 0xb7, 0x39, 0xbf, 0x28, 0x6f, 0x25, 0x69, 0xca, 0xb4, 0x7f, 0xad,
+	0x01, 0xc4, 0x24, 0xe4, 0x71, 0x2a, 0xa5, 0xde, 0xbe, 0x40, 0x5a, 0x9c, 0x4a, 0xb1, 0x80, 0x8a,
+	0xae, 0x4c, 0x58, 0x40, 0x04, 0x37, 0xfe, 0x44, 0x13, 0x69, 0xf6, 0x06, 0xe4, 0xf9, 0xea, 0xaa,
+	0x75, 0xc3, 0x81, 0xcb, 0xcd, 0x27, 0xd5, 0xf9, 0x2c, 0x4c, 0x77, 0x3e, 0xaf, 0x9e, 0xcb, 0xf4,
+	0x67, 0x50, 0x57, 0x4e, 0xb1, 0x47, 0xdd, 0x89, 0x63, 0x87, 0x2c, 0xba, 0xc3, 0x5b, 0x00, 0xd2,
+	0x8d, 0x6c, 0x79, 0xa0, 0x65, 0x23, 0x81, 0xd1, 0x87, 0x50, 0xeb, 0x1c, 0x9d, 0xc8, 0x1f, 0xd5,
+	0x97, 0xf9, 0x30, 0x11, 0xdf, 0x94, 0xf2, 0xb3, 0x42, 0xb9, 0x37, 0x05, 0x22, 0x9f, 0x77, 0xfa,
+	0x33, 0xf4, 0xa7, 0xd7, 0xaa, 0x9d, 0x12, 0x23, 0xf4, 0xbf, 0xd6, 0xe0, 0x5a, 0x62, 0x29, 0xa9,
+	0xdf, 0x67, 0x89, 0x90, 0x39, 0x6b, 0x2b, 0x33, 0xf4, 0x71, 0xd8, 0xbc, 0x99, 0xea, 0x73, 0xc4,
+	0x61, 0xf1, 0x4b, 0x6e, 0x43, 0x4f, 0xa1, 0x24, 0x6a, 0x93, 0x8b, 0x1e, 0x68, 0x91, 0xf0, 0x88,
+	0x36, 0x65, 0x46, 0xff, 0x9a, 0x87, 0x72, 0x44, 0xf3, 0x3f, 0x73, 0x28, 0xb1, 0x09, 0xe5, 0x92,
+	0x26, 0x84, 0xb5, 0xa7, 0xb0, 0x9e, 0xbc, 0xac, 0x3d, 0x23, 0xcb, 0xb1, 0x5d, 0xcb, 0xee, 0x9b,
+	0x18, 0xda, 0xa4, 0xe5, 0x44, 0x08, 0xe4, 0x92, 0x1f, 0x4c, 0x15, 0x79, 0xa1, 0x2e, 0x21, 0x7c,
+	0xec, 0xa8, 0xa8, 0xc0, 0x86, 0x01, 0x0d, 0x87, 0x9e, 0x63, 0xf5, 0x46, 0xa2, 0xea, 0xd1, 0x0c,
+	0x22, 0xe7, 0xba, 0x6a, 0xea, 0x45, 0x48, 0xde, 0x87, 0xd2, 0xd0, 0x0c, 0x7b, 0x96, 0xc9, 0x4c,
+	0x19, 0x3e, 0x8a, 0x43, 0x33, 0xdc, 0x33, 0x99, 0x89, 0x8b, 0x88, 0xd7, 0x11, 0xaf, 0x7e, 0x34,
+	0x43, 0x42, 0xe4, 0x53, 0xb8, 0x29, 0x7e, 0xf5, 0x3c, 0x1d, 0x5b, 0x03, 0xca, 0x7a, 0x01, 0x1d,
+	0x99, 0x36, 0x96, 0x73, 0xbc, 0xf1, 0xa0, 0x19, 0x37, 0xf8, 0x6c, 0x8b, 0x4f, 0x1a, 0x6a, 0x0e,
+	0x7f, 0xe1, 0x3b, 0x1d, 0x07, 0x6e, 0x2f, 0x30, 0xd1, 0xdd, 0xab, 0x0b, 0x9a, 0x9b, 0xd1, 0x25,
+	0x34, 0x5b, 0xe3, 0xc0, 0x35, 0x4c, 0x46, 0xf1, 0x2b, 0x5c, 0x31, 0x0a, 0xc9, 0x8f, 0xa0, 0x60,
+	0x3a, 0x34, 0x60, 0x61, 0x7d, 0x95, 0xf3, 0x6f, 0x2c, 0xc1, 0xbf, 0x83, 0x0c, 0x86, 0xe4, 0x6b,
+	0xbc, 0x84, 0x92, 0x9a, 0x48, 0x1c, 0xbd, 0x96, 0x3a, 0xfa, 0xe4, 0x91, 0x64, 0xd2, 0x47, 0x42,
+	0x20, 0x87, 0xfa, 0xf3, 0xcb, 0xd5, 0x0c, 0x3e, 0x6e, 0xfc, 0xa5, 0x06, 0xab, 0xa9, 0xc5, 0x30,
+	0x2c, 0x38, 0x9e, 0x3b, 0xe8, 0xa5, 0xa4, 0x03, 0xa2, 0x64, 0x58, 0xb8, 0x0b, 0xd5, 0x70, 0xe8,
+	0x05, 0x2c, 0x1d, 0x38, 0x2a, 0x1c, 0x17, 0x47, 0x8e, 0xe8, 0x06, 0xe5, 0x72, 0x31, 0x02, 0x43,
+	0x56, 0x48, 0x5f, 0xd3, 0xc4, 0x0f, 0x2a, 0x11, 0xcc, 0xbf, 0xf6, 0xb3, 0x03, 0xbc, 0x0e, 0xf1,
+	0xb9, 0xa7, 0x84, 0xb6, 0x7e, 0x5d, 0x84, 0xec, 0x8e, 0x6f, 0x93, 0x6f, 0xa1, 0x92, 0x68, 0x99,
+	0x90, 0x7b, 0x17, 0x37, 0x54, 0x78, 0x98, 0x68, 0x7c, 0xb4, 0x4c, 0xd7, 0x45, 0x5f, 0x21, 0x07,
+	0x90, 0xe7, 0xa5, 0x0f, 0xf9, 0x70, 0x51, 0x49, 0x24, 0xe4, 0xdd, 0xba, 0xb8, 0x62, 0xd2, 0x57,
+	0x48, 0x17, 0xca, 0x51, 0xf6, 0x20, 0x77, 0x2f, 0xca, 0x2c, 0x42, 0xa2, 0x7e, 0x79, 0xf2, 0xd1,
+	0x57, 0xc8, 0x4b, 0x28, 0xa9, 0x2f, 0x8d, 0xc9, 0x9c, 0xb7, 0x7c, 0xfa, 0xcb, 0xe7, 0xc6, 0xdd,
+	0x0b, 0x28, 0x22, 0x91, 0x7f, 0x08, 0xd5, 0xe4, 0xc7, 0xdb, 0xe4, 0xa3, 0xb9, 0x4c, 0x53, 0x1f,
+	0x84, 0x37, 0x3e, 0xbe, 0x84, 0x2a, 0x12, 0xbf, 0x07, 0xd9, 0xae, 0xe9, 0x93, 0x0f, 0xe6, 0xfd,
+	0xb0, 0xa3, 0x84, 0xbd, 0xbf, 0xf0, 0x57, 0x1f, 0x3d, 0xfb, 0x47, 0x19, 0xed, 0xa1, 0x46, 0xfe,
+	0x00, 0x56, 0x53, 0x5f, 0x15, 0x91, 0x8f, 0x97, 0xfa, 0xea, 0x68, 0x09, 0xc9, 0x3b, 0x50, 0x54,
+	0x9f, 0xcf, 0x2e, 0xa8, 0x8e, 0x1a, 0xdf, 0x99, 0xc1, 0x27, 0xbe, 0xca, 0xd7, 0x57, 0x88, 0x03,
+	0xe5, 0x0e, 0x75, 0xce, 0x76, 0xf1, 0xbb, 0x7e, 0x92, 0xf8, 0xc4, 0x52, 0x7c, 0xf5, 0xdf, 0x4c,
+	0x7e, 0xf5, 0x1f, 0xd1, 0x29, 0x05, 0x9b, 0xcb, 0x92, 0x47, 0x07, 0xfa, 0x39, 0x14, 0x76, 0xf9,
+	0x7f, 0x0b, 0x2c, 0xd4, 0xf7, 0x46, 0x52, 0x26, 0x52, 0x36, 0x77, 0x1c, 0x47, 0x5f, 0x21, 0xdf,
+	0x40, 0x6d, 0x3a, 0xf7, 0x2e, 0x94, 0xf1, 0xdd, 0x19, 0xfc, 0xa2, 0xb4, 0x2d, 0x6c, 0x3d, 0x4e,
+	0x3e, 0x77, 0x2f, 0xca, 0x8c, 0x8b, 0x6c, 0x7d, 0x26, 0x79, 0xea, 0x2b, 0xad, 0xc7, 0xdf, 0x3e,
+	0x1a, 0xd8, 0x6c, 0x38, 0x3e, 0xc5, 0x93, 0xd9, 0x94, 0x1c, 0xea, 0xef, 0xd6, 0x66, 0xfc, 0x6d,
+	0xf6, 0xe6, 0x80, 0xba, 0x9b, 0x42, 0xd0, 0x69, 0x81, 0xbf, 0xc2, 0x1e, 0xff, 0xf7, 0x00, 0x9f,
+	0xf1, 0xbd, 0xa5, 0xd3, 0x31, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -4312,6 +5344,8 @@ type ApiClient interface {
 	Version(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*VersionInfo, error)
 	SelfCheck(ctx context.Context, in *healthcheck.SelfCheckRequest, opts ...grpc.CallOption) (*healthcheck.SelfCheckResponse, error)
 	Config(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*config.All, error)
+	IdentityDenylist(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*IdentityDenylistResponse, error)
+	SLOStatus(ctx context.Context, in *SLOStatusRequest, opts ...grpc.CallOption) (*SLOStatusResponse, error)
 }
 
 type apiClient struct {
@@ -4460,6 +5494,24 @@ func (c *apiClient) Config(ctx context.Context, in *Empty, opts ...grpc.CallOpti
 	return out, nil
 }
 
+func (c *apiClient) IdentityDenylist(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*IdentityDenylistResponse, error) {
+	out := new(IdentityDenylistResponse)
+	err := c.cc.Invoke(ctx, "/linkerd2.public.Api/IdentityDenylist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) SLOStatus(ctx context.Context, in *SLOStatusRequest, opts ...grpc.CallOption) (*SLOStatusResponse, error) {
+	out := new(SLOStatusResponse)
+	err := c.cc.Invoke(ctx, "/linkerd2.public.Api/SLOStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ApiServer is the server API for Api service.
 type ApiServer interface {
 	StatSummary(context.Context, *StatSummaryRequest) (*StatSummaryResponse, error)
@@ -4474,6 +5526,8 @@ type ApiServer interface {
 	Version(context.Context, *Empty) (*VersionInfo, error)
 	SelfCheck(context.Context, *healthcheck.SelfCheckRequest) (*healthcheck.SelfCheckResponse, error)
 	Config(context.Context, *Empty) (*config.All, error)
+	IdentityDenylist(context.Context, *Empty) (*IdentityDenylistResponse, error)
+	SLOStatus(context.Context, *SLOStatusRequest) (*SLOStatusResponse, error)
 }
 
 // UnimplementedApiServer can be embedded to have forward compatible implementations.
@@ -4510,6 +5564,12 @@ func (*UnimplementedApiServer) SelfCheck(ctx context.Context, req *healthcheck.S
 func (*UnimplementedApiServer) Config(ctx context.Context, req *Empty) (*config.All, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Config not implemented")
 }
+func (*UnimplementedApiServer) IdentityDenylist(ctx context.Context, req *Empty) (*IdentityDenylistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IdentityDenylist not implemented")
+}
+func (*UnimplementedApiServer) SLOStatus(ctx context.Context, req *SLOStatusRequest) (*SLOStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SLOStatus not implemented")
+}
 
 func RegisterApiServer(s *grpc.Server, srv ApiServer) {
 	s.RegisterService(&_Api_serviceDesc, srv)
@@ -4701,6 +5761,42 @@ func _Api_Config_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Api_IdentityDenylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).IdentityDenylist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkerd2.public.Api/IdentityDenylist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).IdentityDenylist(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_SLOStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SLOStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).SLOStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkerd2.public.Api/SLOStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).SLOStatus(ctx, req.(*SLOStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Api_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "linkerd2.public.Api",
 	HandlerType: (*ApiServer)(nil),
@@ -4737,6 +5833,14 @@ var _Api_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Config",
 			Handler:    _Api_Config_Handler,
 		},
+		{
+			MethodName: "IdentityDenylist",
+			Handler:    _Api_IdentityDenylist_Handler,
+		},
+		{
+			MethodName: "SLOStatus",
+			Handler:    _Api_SLOStatus_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
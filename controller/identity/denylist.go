@@ -0,0 +1,101 @@
+package identity
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/linkerd/linkerd2/pkg/identity"
+	pkgK8s "github.com/linkerd/linkerd2/pkg/k8s"
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/informers"
+	k8s "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+)
+
+const denylistResyncPeriod = 10 * time.Minute
+
+// ConfigMapDenylist implements Denylist by watching the identities listed in
+// the linkerd-identity-denylist ConfigMap. A missing ConfigMap denies nothing.
+type ConfigMapDenylist struct {
+	informers  informers.SharedInformerFactory
+	synced     cache.InformerSynced
+	identities map[string]struct{}
+	sync.RWMutex
+}
+
+// NewConfigMapDenylist creates a ConfigMapDenylist watching the denylist
+// ConfigMap in the given namespace.
+func NewConfigMapDenylist(k8sAPI k8s.Interface, namespace string) *ConfigMapDenylist {
+	factory := informers.NewSharedInformerFactoryWithOptions(
+		k8sAPI,
+		denylistResyncPeriod,
+		informers.WithNamespace(namespace),
+		informers.WithTweakListOptions(func(options *metav1.ListOptions) {
+			options.FieldSelector = fields.OneTermEqualSelector("metadata.name", pkgK8s.IdentityDenylistConfigMapName).String()
+		}),
+	)
+
+	d := &ConfigMapDenylist{
+		informers:  factory,
+		identities: make(map[string]struct{}),
+	}
+
+	informer := factory.Core().V1().ConfigMaps().Informer()
+	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: d.update,
+		UpdateFunc: func(_, obj interface{}) {
+			d.update(obj)
+		},
+		DeleteFunc: func(interface{}) {
+			d.set(make(map[string]struct{}))
+		},
+	})
+	d.synced = informer.HasSynced
+
+	return d
+}
+
+// Start watches the denylist ConfigMap until the stop channel is closed, and
+// blocks until the initial list has been read.
+func (d *ConfigMapDenylist) Start(stop <-chan struct{}) bool {
+	d.informers.Start(stop)
+	return cache.WaitForCacheSync(stop, d.synced)
+}
+
+// Denied returns true if the identity is listed in the denylist.
+func (d *ConfigMapDenylist) Denied(id string) bool {
+	d.RLock()
+	defer d.RUnlock()
+	_, ok := d.identities[id]
+	return ok
+}
+
+// Identities returns the sorted list of denied identities.
+func (d *ConfigMapDenylist) Identities() []string {
+	d.RLock()
+	defer d.RUnlock()
+	ids := make([]string, 0, len(d.identities))
+	for id := range d.identities {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func (d *ConfigMapDenylist) update(obj interface{}) {
+	cm := obj.(*corev1.ConfigMap)
+	d.set(identity.ParseDenylist(cm.Data[pkgK8s.IdentityDenylistKey]))
+}
+
+func (d *ConfigMapDenylist) set(identities map[string]struct{}) {
+	d.Lock()
+	defer d.Unlock()
+	if len(identities) != len(d.identities) {
+		log.Infof("Identity denylist updated: %d identities denied", len(identities))
+	}
+	d.identities = identities
+}
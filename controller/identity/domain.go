@@ -2,6 +2,7 @@ package identity
 
 import (
 	"fmt"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/util/validation"
 )
@@ -34,3 +35,18 @@ func (d *TrustDomain) Identity(typ, nm, ns string) (string, error) {
 	id := fmt.Sprintf("%s.%s.%s.identity.%s.%s", nm, ns, typ, d.controlNS, d.domain)
 	return id, nil
 }
+
+// parseIdentity splits an identity formatted by Identity into its type, name
+// and namespace.
+func (d *TrustDomain) parseIdentity(id string) (typ, nm, ns string, err error) {
+	suffix := fmt.Sprintf(".identity.%s.%s", d.controlNS, d.domain)
+	if !strings.HasSuffix(id, suffix) {
+		return "", "", "", fmt.Errorf("identity '%s' is not in the trust domain", id)
+	}
+
+	labels := strings.Split(strings.TrimSuffix(id, suffix), ".")
+	if len(labels) != 3 {
+		return "", "", "", fmt.Errorf("invalid identity '%s'", id)
+	}
+	return labels[2], labels[0], labels[1], nil
+}
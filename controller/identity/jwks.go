@@ -0,0 +1,180 @@
+package identity
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// jwksMinRefreshInterval limits how often the key set is fetched again when
+// a token is signed with an unknown key, so that bogus tokens can't be used
+// to flood the key set's endpoint.
+const jwksMinRefreshInterval = 30 * time.Second
+
+type (
+	// JWKS holds the public keys of a JSON Web Key Set, such as the one the
+	// Kubernetes API server signs service account tokens with. Keys are
+	// fetched lazily, and fetched again when a token refers to a key which
+	// isn't part of the set, e.g. after the signing key has been rotated.
+	JWKS struct {
+		fetch     func() ([]byte, error)
+		keys      map[string]crypto.PublicKey
+		lastFetch time.Time
+		now       func() time.Time
+		sync.Mutex
+	}
+
+	jsonWebKeySet struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+
+	jsonWebKey struct {
+		Kty string `json:"kty"`
+		Kid string `json:"kid"`
+		Use string `json:"use"`
+		// RSA keys
+		N string `json:"n"`
+		E string `json:"e"`
+		// EC keys
+		Crv string `json:"crv"`
+		X   string `json:"x"`
+		Y   string `json:"y"`
+	}
+)
+
+// NewJWKS creates a JWKS reading the key set with the given function.
+func NewJWKS(fetch func() ([]byte, error)) *JWKS {
+	return &JWKS{fetch: fetch, now: time.Now}
+}
+
+// NewHTTPJWKS creates a JWKS reading the key set from the given URL.
+func NewHTTPJWKS(url string, client *http.Client) *JWKS {
+	return NewJWKS(func() ([]byte, error) {
+		rsp, err := client.Get(url)
+		if err != nil {
+			return nil, err
+		}
+		defer rsp.Body.Close()
+
+		body, err := ioutil.ReadAll(rsp.Body)
+		if err != nil {
+			return nil, err
+		}
+		if rsp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("unexpected status fetching %s: %s", url, rsp.Status)
+		}
+		return body, nil
+	})
+}
+
+// Key returns the public key with the given ID.
+func (j *JWKS) Key(kid string) (crypto.PublicKey, error) {
+	j.Lock()
+	defer j.Unlock()
+
+	if key, ok := j.keys[kid]; ok {
+		return key, nil
+	}
+
+	if j.keys != nil && j.now().Sub(j.lastFetch) < jwksMinRefreshInterval {
+		return nil, fmt.Errorf("unknown key ID: %s", kid)
+	}
+	if err := j.refresh(); err != nil {
+		return nil, err
+	}
+
+	if key, ok := j.keys[kid]; ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("unknown key ID: %s", kid)
+}
+
+func (j *JWKS) refresh() error {
+	j.lastFetch = j.now()
+
+	data, err := j.fetch()
+	if err != nil {
+		return fmt.Errorf("failed to fetch JWKS: %s", err)
+	}
+
+	var set jsonWebKeySet
+	if err := json.Unmarshal(data, &set); err != nil {
+		return fmt.Errorf("failed to parse JWKS: %s", err)
+	}
+
+	keys := make(map[string]crypto.PublicKey)
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		key, err := k.publicKey()
+		if err != nil {
+			log.Warnf("Ignoring JWKS key %s: %s", k.Kid, err)
+			continue
+		}
+		keys[k.Kid] = key
+	}
+	j.keys = keys
+	log.Debugf("Fetched %d JWKS keys", len(keys))
+	return nil
+}
+
+func (k *jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBase64URLInt(k.N)
+		if err != nil {
+			return nil, fmt.Errorf("invalid modulus: %s", err)
+		}
+		e, err := decodeBase64URLInt(k.E)
+		if err != nil {
+			return nil, fmt.Errorf("invalid exponent: %s", err)
+		}
+		if !e.IsInt64() {
+			return nil, fmt.Errorf("exponent too large")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve: %s", k.Crv)
+		}
+		x, err := decodeBase64URLInt(k.X)
+		if err != nil {
+			return nil, fmt.Errorf("invalid x coordinate: %s", err)
+		}
+		y, err := decodeBase64URLInt(k.Y)
+		if err != nil {
+			return nil, fmt.Errorf("invalid y coordinate: %s", err)
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type: %s", k.Kty)
+	}
+}
+
+func decodeBase64URLInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
@@ -0,0 +1,203 @@
+package identity
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/linkerd/linkerd2/pkg/identity"
+	log "github.com/sirupsen/logrus"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	corelisters "k8s.io/client-go/listers/core/v1"
+)
+
+// jwtClockSkewAllowance is the maximum supported clock skew between the API
+// server issuing tokens and the identity controller.
+const jwtClockSkewAllowance = 10 * time.Second
+
+// jwtSigningMethods lists the algorithms the API server may sign service
+// account tokens with.
+var jwtSigningMethods = []string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512"}
+
+// errNotBound is returned while parsing tokens which aren't bound to a pod,
+// such as legacy service account tokens.
+var errNotBound = errors.New("token is not bound to a pod")
+
+// errPodNotCached is returned when checking the claims of tokens bound to a
+// pod which the pod informer doesn't know of, either because it doesn't exist
+// or because the informer lags behind the API server.
+var errPodNotCached = errors.New("token is bound to a pod which isn't cached")
+
+type (
+	// JWTValidator implements Validator for bound service account tokens by
+	// verifying their signature locally against the API server's JWKS, rather
+	// than with a TokenReview. Tokens must be scoped to one of the expected
+	// audiences and bound to a pod which still exists.
+	//
+	// Tokens which aren't bound to a pod, whose signing key can't be fetched,
+	// or which are bound to a pod the pod informer hasn't seen yet, are handed
+	// to the fallback validator if there is one.  The latter happens during
+	// mass restarts, when the informer lags behind the API server.
+	JWTValidator struct {
+		domain    *TrustDomain
+		keys      *JWKS
+		issuer    string
+		audiences []string
+		pods      corelisters.PodLister
+		fallback  identity.Validator
+		now       func() time.Time
+	}
+
+	boundTokenClaims struct {
+		Issuer     string         `json:"iss"`
+		Subject    string         `json:"sub"`
+		Audience   tokenAudiences `json:"aud"`
+		ExpiresAt  int64          `json:"exp"`
+		NotBefore  int64          `json:"nbf"`
+		Kubernetes *struct {
+			Namespace string       `json:"namespace"`
+			Pod       *boundObject `json:"pod"`
+		} `json:"kubernetes.io"`
+	}
+
+	boundObject struct {
+		Name string `json:"name"`
+		UID  string `json:"uid"`
+	}
+
+	// tokenAudiences reads the aud claim, which may either be a string or a
+	// list of strings.
+	tokenAudiences []string
+)
+
+// NewJWTValidator creates a JWTValidator accepting the tokens issued by
+// issuer (if not empty) for one of the given audiences, signed with a key from
+// keys. The pod lister is used to check that the pods tokens are bound to
+// still exist.
+func NewJWTValidator(
+	domain *TrustDomain,
+	keys *JWKS,
+	issuer string,
+	audiences []string,
+	pods corelisters.PodLister,
+	fallback identity.Validator,
+) *JWTValidator {
+	return &JWTValidator{
+		domain:    domain,
+		keys:      keys,
+		issuer:    issuer,
+		audiences: audiences,
+		pods:      pods,
+		fallback:  fallback,
+		now:       time.Now,
+	}
+}
+
+// Validate accepts bound service account tokens and returns a DNS-form
+// linkerd ID.
+func (v *JWTValidator) Validate(ctx context.Context, tok []byte) (string, error) {
+	claims := &boundTokenClaims{}
+	parser := jwt.Parser{ValidMethods: jwtSigningMethods, SkipClaimsValidation: true}
+	_, err := parser.ParseWithClaims(string(tok), claims, func(t *jwt.Token) (interface{}, error) {
+		if claims.Kubernetes == nil || claims.Kubernetes.Pod == nil {
+			return nil, errNotBound
+		}
+		kid, _ := t.Header["kid"].(string)
+		return v.keys.Key(kid)
+	})
+	if err != nil {
+		ve, ok := err.(*jwt.ValidationError)
+		switch {
+		case ok && ve.Errors&jwt.ValidationErrorMalformed != 0:
+			return "", identity.InvalidToken{Reason: fmt.Sprintf("malformed token: %s", err)}
+		case ok && ve.Errors&jwt.ValidationErrorUnverifiable != 0 && v.fallback != nil:
+			log.Debugf("Validating token with the fallback validator: %s", err)
+			return v.fallback.Validate(ctx, tok)
+		case ok && ve.Inner == errNotBound:
+			return "", identity.InvalidToken{Reason: errNotBound.Error()}
+		case ok && ve.Errors&jwt.ValidationErrorUnverifiable != 0:
+			return "", fmt.Errorf("failed to verify token: %s", err)
+		default:
+			log.Infof("Invalid token signature: %s", err)
+			return "", identity.NotAuthenticated{}
+		}
+	}
+
+	if err := v.checkClaims(claims); err != nil {
+		if err != errPodNotCached {
+			return "", err
+		}
+		if v.fallback != nil {
+			log.Debugf("Validating token of %s with the fallback validator: %s", claims.Subject, err)
+			return v.fallback.Validate(ctx, tok)
+		}
+		return "", identity.NotAuthenticated{}
+	}
+
+	return v.domain.usernameIdentity(claims.Subject)
+}
+
+// checkClaims validates the claims of a token whose signature is valid.
+func (v *JWTValidator) checkClaims(claims *boundTokenClaims) error {
+	now := v.now()
+	if claims.ExpiresAt == 0 || !now.Add(-jwtClockSkewAllowance).Before(time.Unix(claims.ExpiresAt, 0)) {
+		log.Infof("Token of %s has expired", claims.Subject)
+		return identity.NotAuthenticated{}
+	}
+	if claims.NotBefore != 0 && now.Add(jwtClockSkewAllowance).Before(time.Unix(claims.NotBefore, 0)) {
+		log.Infof("Token of %s is not valid yet", claims.Subject)
+		return identity.NotAuthenticated{}
+	}
+	if v.issuer != "" && claims.Issuer != v.issuer {
+		log.Infof("Token of %s was issued by %s rather than %s", claims.Subject, claims.Issuer, v.issuer)
+		return identity.NotAuthenticated{}
+	}
+	if len(v.audiences) > 0 && !hasAudience(claims.Audience, v.audiences) {
+		msg := fmt.Sprintf("token is not scoped to any of the audiences %s", strings.Join(v.audiences, ", "))
+		return identity.InvalidToken{Reason: msg}
+	}
+
+	// Tokens must not outlive the pod they were issued to.
+	ns, bound := claims.Kubernetes.Namespace, claims.Kubernetes.Pod
+	pod, err := v.pods.Pods(ns).Get(bound.Name)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			log.Infof("Token of %s is bound to pod %s/%s, which isn't cached", claims.Subject, ns, bound.Name)
+			return errPodNotCached
+		}
+		return err
+	}
+	if string(pod.UID) != bound.UID {
+		log.Infof("Token of %s is bound to pod %s/%s with UID %s, but UID %s is cached", claims.Subject, ns, bound.Name, bound.UID, pod.UID)
+		return errPodNotCached
+	}
+	if pod.DeletionTimestamp != nil && pod.DeletionTimestamp.Time.Before(now) {
+		log.Infof("Token of %s is bound to pod %s/%s, which has been deleted", claims.Subject, ns, bound.Name)
+		return identity.NotAuthenticated{}
+	}
+	return nil
+}
+
+// Valid always returns nil: JWTValidator validates claims itself, with its
+// own clock.
+func (boundTokenClaims) Valid() error {
+	return nil
+}
+
+func (a *tokenAudiences) UnmarshalJSON(data []byte) error {
+	var aud string
+	if err := json.Unmarshal(data, &aud); err == nil {
+		*a = tokenAudiences{aud}
+		return nil
+	}
+	var auds []string
+	if err := json.Unmarshal(data, &auds); err != nil {
+		return err
+	}
+	*a = auds
+	return nil
+}
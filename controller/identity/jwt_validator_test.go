@@ -0,0 +1,266 @@
+package identity
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/linkerd/linkerd2/pkg/identity"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	testTokenIssuer   = "https://kubernetes.default.svc"
+	testTokenAudience = "identity.l5d.io"
+)
+
+type fakeValidator struct {
+	identity string
+	err      error
+}
+
+func (fv *fakeValidator) Validate(context.Context, []byte) (string, error) {
+	return fv.identity, fv.err
+}
+
+// newTestJWKSServer serves the public key of the given RSA key as a JWKS, the
+// way the API server's /openid/v1/jwks endpoint does.
+func newTestJWKSServer(t *testing.T, kid string, key *rsa.PrivateKey, fetches *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*fetches++
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []map[string]string{{
+				"kty": "RSA",
+				"kid": kid,
+				"use": "sig",
+				"alg": "RS256",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			}},
+		})
+	}))
+}
+
+func newTestPodLister(pods ...*corev1.Pod) corelisters.PodLister {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, pod := range pods {
+		indexer.Add(pod)
+	}
+	return corelisters.NewPodLister(indexer)
+}
+
+func signTestToken(t *testing.T, kid string, key *rsa.PrivateKey, claims jwt.MapClaims) []byte {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = kid
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatalf("Failed to sign token: %s", err)
+	}
+	return []byte(signed)
+}
+
+func TestJWTValidator(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %s", err)
+	}
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %s", err)
+	}
+	fetches := 0
+	srv := newTestJWKSServer(t, "key-1", key, &fetches)
+	defer srv.Close()
+
+	dom, err := NewTrustDomain("linkerd", "cluster.local")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	now := time.Now()
+	deleted := metav1.NewTime(now.Add(-time.Minute))
+	pods := newTestPodLister(
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "foo-1", Namespace: "ns", UID: types.UID("uid-1")}},
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "foo-2", Namespace: "ns", UID: types.UID("uid-2"), DeletionTimestamp: &deleted}},
+	)
+
+	boundClaims := func(pod, uid string) jwt.MapClaims {
+		return jwt.MapClaims{
+			"iss": testTokenIssuer,
+			"sub": "system:serviceaccount:ns:foo",
+			"aud": []string{testTokenAudience},
+			"exp": now.Add(time.Hour).Unix(),
+			"nbf": now.Unix(),
+			"kubernetes.io": map[string]interface{}{
+				"namespace":      "ns",
+				"pod":            map[string]string{"name": pod, "uid": uid},
+				"serviceaccount": map[string]string{"name": "foo", "uid": "sa-uid"},
+			},
+		}
+	}
+	withClaim := func(claims jwt.MapClaims, name string, value interface{}) jwt.MapClaims {
+		claims[name] = value
+		return claims
+	}
+
+	legacyFallback := &fakeValidator{identity: "bar.ns.serviceaccount.identity.linkerd.cluster.local"}
+	rejectingFallback := &fakeValidator{err: identity.NotAuthenticated{}}
+	acceptingFallback := &fakeValidator{identity: "foo.ns.serviceaccount.identity.linkerd.cluster.local"}
+
+	for _, tt := range []struct {
+		description      string
+		token            []byte
+		fallback         *fakeValidator
+		noFallback       bool
+		expectedIdentity string
+		expectedErr      error
+	}{
+		{
+			description:      "accepts a bound token",
+			token:            signTestToken(t, "key-1", key, boundClaims("foo-1", "uid-1")),
+			expectedIdentity: "foo.ns.serviceaccount.identity.linkerd.cluster.local",
+		},
+		{
+			description:      "accepts a bound token with a single audience",
+			token:            signTestToken(t, "key-1", key, withClaim(boundClaims("foo-1", "uid-1"), "aud", testTokenAudience)),
+			expectedIdentity: "foo.ns.serviceaccount.identity.linkerd.cluster.local",
+		},
+		{
+			description: "rejects tokens scoped to another audience",
+			token:       signTestToken(t, "key-1", key, withClaim(boundClaims("foo-1", "uid-1"), "aud", []string{"vault"})),
+			expectedErr: identity.InvalidToken{Reason: "token is not scoped to any of the audiences " + testTokenAudience},
+		},
+		{
+			description: "rejects expired tokens",
+			token:       signTestToken(t, "key-1", key, withClaim(boundClaims("foo-1", "uid-1"), "exp", now.Add(-time.Hour).Unix())),
+			expectedErr: identity.NotAuthenticated{},
+		},
+		{
+			description: "rejects tokens issued by another issuer",
+			token:       signTestToken(t, "key-1", key, withClaim(boundClaims("foo-1", "uid-1"), "iss", "https://example.com")),
+			expectedErr: identity.NotAuthenticated{},
+		},
+		{
+			description: "rejects tokens with an invalid signature",
+			token:       signTestToken(t, "key-1", otherKey, boundClaims("foo-1", "uid-1")),
+			expectedErr: identity.NotAuthenticated{},
+		},
+		{
+			description: "rejects tokens bound to a pod which doesn't exist",
+			token:       signTestToken(t, "key-1", key, boundClaims("foo-3", "uid-3")),
+			fallback:    rejectingFallback,
+			expectedErr: identity.NotAuthenticated{},
+		},
+		{
+			description:      "hands tokens bound to a pod which isn't cached yet to the fallback validator",
+			token:            signTestToken(t, "key-1", key, boundClaims("foo-3", "uid-3")),
+			fallback:         acceptingFallback,
+			expectedIdentity: "foo.ns.serviceaccount.identity.linkerd.cluster.local",
+		},
+		{
+			description: "rejects tokens bound to a pod which isn't cached without a fallback validator",
+			token:       signTestToken(t, "key-1", key, boundClaims("foo-3", "uid-3")),
+			noFallback:  true,
+			expectedErr: identity.NotAuthenticated{},
+		},
+		{
+			description: "rejects tokens bound to a pod which has been replaced",
+			token:       signTestToken(t, "key-1", key, boundClaims("foo-1", "uid-0")),
+			fallback:    rejectingFallback,
+			expectedErr: identity.NotAuthenticated{},
+		},
+		{
+			description:      "hands tokens bound to a pod recreated under the same name to the fallback validator",
+			token:            signTestToken(t, "key-1", key, boundClaims("foo-1", "uid-0")),
+			fallback:         acceptingFallback,
+			expectedIdentity: "foo.ns.serviceaccount.identity.linkerd.cluster.local",
+		},
+		{
+			description: "rejects tokens bound to a pod which has been deleted",
+			token:       signTestToken(t, "key-1", key, boundClaims("foo-2", "uid-2")),
+			expectedErr: identity.NotAuthenticated{},
+		},
+		{
+			description:      "hands legacy tokens to the fallback validator",
+			token:            signTestToken(t, "legacy", otherKey, jwt.MapClaims{"iss": "kubernetes/serviceaccount", "sub": "system:serviceaccount:ns:bar"}),
+			expectedIdentity: "bar.ns.serviceaccount.identity.linkerd.cluster.local",
+		},
+		{
+			description: "rejects malformed tokens",
+			token:       []byte("not-a-jwt"),
+			expectedErr: identity.InvalidToken{Reason: "malformed token: token contains an invalid number of segments"},
+		},
+	} {
+		tt := tt // pin
+		t.Run(tt.description, func(t *testing.T) {
+			var fallback identity.Validator = legacyFallback
+			if tt.fallback != nil {
+				fallback = tt.fallback
+			}
+			if tt.noFallback {
+				fallback = nil
+			}
+			v := NewJWTValidator(dom, NewHTTPJWKS(srv.URL, srv.Client()), testTokenIssuer, []string{testTokenAudience}, pods, fallback)
+			v.now = func() time.Time { return now }
+
+			id, err := v.Validate(context.Background(), tt.token)
+			if err != tt.expectedErr {
+				t.Fatalf("Expected error %v, got %v", tt.expectedErr, err)
+			}
+			if id != tt.expectedIdentity {
+				t.Fatalf("Expected identity %q, got %q", tt.expectedIdentity, id)
+			}
+		})
+	}
+}
+
+func TestJWKSRefresh(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %s", err)
+	}
+	fetches := 0
+	srv := newTestJWKSServer(t, "key-1", key, &fetches)
+	defer srv.Close()
+
+	now := time.Now()
+	keys := NewHTTPJWKS(srv.URL, srv.Client())
+	keys.now = func() time.Time { return now }
+
+	if _, err := keys.Key("key-1"); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if _, err := keys.Key("key-1"); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if fetches != 1 {
+		t.Fatalf("Expected the JWKS to be fetched once, got %d fetches", fetches)
+	}
+
+	// Unknown keys don't trigger a fetch more often than jwksMinRefreshInterval.
+	if _, err := keys.Key("key-2"); err == nil {
+		t.Fatalf("Expected an error for an unknown key")
+	}
+	if fetches != 1 {
+		t.Fatalf("Expected the JWKS not to be fetched again, got %d fetches", fetches)
+	}
+
+	now = now.Add(jwksMinRefreshInterval)
+	if _, err := keys.Key("key-2"); err == nil {
+		t.Fatalf("Expected an error for an unknown key")
+	}
+	if fetches != 2 {
+		t.Fatalf("Expected the JWKS to be fetched again, got %d fetches", fetches)
+	}
+}
@@ -0,0 +1,162 @@
+package identity
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"time"
+
+	pkgK8s "github.com/linkerd/linkerd2/pkg/k8s"
+	"github.com/linkerd/linkerd2/pkg/tls"
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8s "k8s.io/client-go/kubernetes"
+)
+
+const (
+	eventTypeRenewed       = "IssuerRenewed"
+	eventTypeRenewalFailed = "IssuerRenewalFailed"
+)
+
+// IssuerRenewer mints a new issuer certificate, signed with the trust anchor
+// credentials stored in the linkerd-identity-trust-anchor Secret, when the
+// current one is about to expire. The new certificate is written to the
+// linkerd-identity-issuer Secret, from where it is picked up by the
+// FsCredsWatcher once the kubelet has updated the mounted volume.
+type IssuerRenewer struct {
+	k8sAPI       k8s.Interface
+	namespace    string
+	issuerName   string
+	trustAnchors *x509.CertPool
+	validity     tls.Validity
+	renewBefore  time.Duration
+	recordEvent  func(eventType, reason, message string)
+	now          func() time.Time
+}
+
+// NewIssuerRenewer creates an IssuerRenewer which renews the issuer
+// certificate renewBefore its expiry. Renewed certificates are named
+// issuerName, are valid for validity and must chain up to trustAnchors.
+func NewIssuerRenewer(
+	k8sAPI k8s.Interface,
+	namespace, issuerName string,
+	trustAnchors *x509.CertPool,
+	validity tls.Validity,
+	renewBefore time.Duration,
+	recordEvent func(eventType, reason, message string),
+) *IssuerRenewer {
+	return &IssuerRenewer{
+		k8sAPI:       k8sAPI,
+		namespace:    namespace,
+		issuerName:   issuerName,
+		trustAnchors: trustAnchors,
+		validity:     validity,
+		renewBefore:  renewBefore,
+		recordEvent:  recordEvent,
+		now:          time.Now,
+	}
+}
+
+// Run checks the issuer certificate every interval, renewing it when needed,
+// until the context is canceled.
+func (r *IssuerRenewer) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := r.RenewIfNeeded(); err != nil {
+			message := fmt.Sprintf("Failed to renew issuer certificate: %s", err)
+			log.Warn(message)
+			r.recordEvent(corev1.EventTypeWarning, eventTypeRenewalFailed, message)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// RenewIfNeeded renews the issuer certificate if it expires within
+// renewBefore. It returns true if the certificate has been renewed.
+func (r *IssuerRenewer) RenewIfNeeded() (bool, error) {
+	secrets := r.k8sAPI.CoreV1().Secrets(r.namespace)
+	secret, err := secrets.Get(pkgK8s.IdentityIssuerSecretName, metav1.GetOptions{})
+	if err != nil {
+		return false, err
+	}
+
+	current, err := tls.DecodePEMCrt(string(secret.Data[pkgK8s.IdentityIssuerCrtName]))
+	if err != nil {
+		return false, fmt.Errorf("failed to read issuer certificate: %s", err)
+	}
+
+	expiry := current.Certificate.NotAfter
+	if annotation, ok := secret.Annotations[pkgK8s.IdentityIssuerExpiryAnnotation]; ok {
+		t, err := time.Parse(time.RFC3339, annotation)
+		if err != nil {
+			log.Warnf("Invalid %s annotation on %s: %s", pkgK8s.IdentityIssuerExpiryAnnotation, pkgK8s.IdentityIssuerSecretName, err)
+		} else if t.Before(expiry) {
+			expiry = t
+		}
+	}
+
+	if r.now().Add(r.renewBefore).Before(expiry) {
+		log.Debugf("Issuer certificate expires on %s, not renewing it yet", expiry.Format(time.RFC3339))
+		return false, nil
+	}
+
+	issuer, err := r.generateIssuer()
+	if err != nil {
+		return false, err
+	}
+
+	crt := issuer.Cred.Crt.Certificate
+	if secret.Annotations == nil {
+		secret.Annotations = map[string]string{}
+	}
+	secret.Annotations[pkgK8s.IdentityIssuerExpiryAnnotation] = crt.NotAfter.Format(time.RFC3339)
+	secret.Data[pkgK8s.IdentityIssuerCrtName] = []byte(issuer.Cred.Crt.EncodeCertificatePEM())
+	secret.Data[pkgK8s.IdentityIssuerKeyName] = []byte(issuer.Cred.EncodePrivateKeyPEM())
+	if _, err := secrets.Update(secret); err != nil {
+		return false, err
+	}
+
+	message := fmt.Sprintf("Renewed issuer certificate expiring on %s; the new one expires on %s", expiry.Format(time.RFC3339), crt.NotAfter.Format(time.RFC3339))
+	log.Info(message)
+	r.recordEvent(corev1.EventTypeNormal, eventTypeRenewed, message)
+	return true, nil
+}
+
+// generateIssuer signs a new issuer certificate with the trust anchor
+// credentials.
+func (r *IssuerRenewer) generateIssuer() (*tls.CA, error) {
+	secret, err := r.k8sAPI.CoreV1().Secrets(r.namespace).Get(pkgK8s.IdentityTrustAnchorSecretName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read trust anchor credentials from the %s Secret, which must hold the %s and %s keys: %s",
+			pkgK8s.IdentityTrustAnchorSecretName, pkgK8s.IdentityIssuerCrtName, pkgK8s.IdentityIssuerKeyName, err)
+	}
+
+	root, err := tls.ValidateAndCreateCreds(
+		string(secret.Data[pkgK8s.IdentityIssuerCrtName]),
+		string(secret.Data[pkgK8s.IdentityIssuerKeyName]),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read trust anchor credentials: %s", err)
+	}
+	if !root.Certificate.IsCA {
+		return nil, fmt.Errorf("%s does not hold a CA certificate", pkgK8s.IdentityTrustAnchorSecretName)
+	}
+
+	issuer, err := tls.NewCA(*root, r.validity).GenerateCA(r.issuerName, r.validity, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := issuer.Cred.Crt.Verify(r.trustAnchors, ""); err != nil {
+		return nil, fmt.Errorf("renewed issuer certificate does not chain up to the trust anchors: %s", err)
+	}
+	return issuer, nil
+}
@@ -0,0 +1,142 @@
+package identity
+
+import (
+	"encoding/base64"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	"github.com/linkerd/linkerd2/pkg/tls"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type recordedEvent struct {
+	eventType, reason string
+}
+
+func secretYAML(name string, expiry time.Time, cred *tls.Cred) string {
+	return fmt.Sprintf(`
+kind: Secret
+apiVersion: v1
+metadata:
+  name: %s
+  namespace: linkerd
+  annotations:
+    %s: %s
+data:
+  %s: %s
+  %s: %s`, name,
+		k8s.IdentityIssuerExpiryAnnotation, expiry.Format(time.RFC3339),
+		k8s.IdentityIssuerCrtName, base64.StdEncoding.EncodeToString([]byte(cred.Crt.EncodeCertificatePEM())),
+		k8s.IdentityIssuerKeyName, base64.StdEncoding.EncodeToString([]byte(cred.EncodePrivateKeyPEM())))
+}
+
+func TestIssuerRenewer(t *testing.T) {
+	root, err := tls.GenerateRootCAWithDefaults("identity.linkerd.cluster.local")
+	if err != nil {
+		t.Fatalf("Failed to generate root CA: %s", err)
+	}
+	otherRoot, err := tls.GenerateRootCAWithDefaults("identity.linkerd.cluster.local")
+	if err != nil {
+		t.Fatalf("Failed to generate root CA: %s", err)
+	}
+	issuer, err := root.GenerateCA("identity.linkerd.cluster.local", tls.Validity{}, 0)
+	if err != nil {
+		t.Fatalf("Failed to generate issuer CA: %s", err)
+	}
+	expiry := issuer.Cred.Crt.Certificate.NotAfter
+
+	testCases := []struct {
+		description    string
+		now            time.Time
+		trustAnchor    *tls.CA
+		expectedRenew  bool
+		expectedErr    bool
+		expectedEvents []recordedEvent
+	}{
+		{
+			description:   "doesn't renew the issuer before renewBefore",
+			now:           expiry.Add(-48 * time.Hour),
+			trustAnchor:   root,
+			expectedRenew: false,
+		},
+		{
+			description:    "renews the issuer within renewBefore",
+			now:            expiry.Add(-12 * time.Hour),
+			trustAnchor:    root,
+			expectedRenew:  true,
+			expectedEvents: []recordedEvent{{"Normal", eventTypeRenewed}},
+		},
+		{
+			description: "refuses to renew the issuer with a trust anchor that isn't trusted",
+			now:         expiry.Add(-12 * time.Hour),
+			trustAnchor: otherRoot,
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.description, func(t *testing.T) {
+			k8sAPI, err := k8s.NewFakeAPI(
+				secretYAML(k8s.IdentityIssuerSecretName, expiry, &issuer.Cred),
+				secretYAML(k8s.IdentityTrustAnchorSecretName, tc.trustAnchor.Cred.Crt.Certificate.NotAfter, &tc.trustAnchor.Cred),
+			)
+			if err != nil {
+				t.Fatalf("NewFakeAPI returned an error: %s", err)
+			}
+
+			events := []recordedEvent{}
+			validity := tls.Validity{Lifetime: 30 * 24 * time.Hour}
+			renewer := NewIssuerRenewer(k8sAPI, "linkerd", "identity.linkerd.cluster.local", root.Cred.Crt.CertPool(), validity, 24*time.Hour,
+				func(eventType, reason, message string) {
+					events = append(events, recordedEvent{eventType, reason})
+				})
+			renewer.now = func() time.Time { return tc.now }
+
+			renewed, err := renewer.RenewIfNeeded()
+			if (err != nil) != tc.expectedErr {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if renewed != tc.expectedRenew {
+				t.Fatalf("Expected renewed to be %t, got %t", tc.expectedRenew, renewed)
+			}
+			if len(events) != len(tc.expectedEvents) || (len(events) > 0 && events[0] != tc.expectedEvents[0]) {
+				t.Fatalf("Expected events %v, got %v", tc.expectedEvents, events)
+			}
+
+			secret, err := k8sAPI.CoreV1().Secrets("linkerd").Get(k8s.IdentityIssuerSecretName, metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			crt, err := tls.DecodePEMCrt(string(secret.Data[k8s.IdentityIssuerCrtName]))
+			if err != nil {
+				t.Fatalf("Failed to read issuer certificate: %s", err)
+			}
+			if !tc.expectedRenew {
+				if !crt.Certificate.Equal(issuer.Cred.Crt.Certificate) {
+					t.Fatalf("Expected the issuer certificate to be left untouched")
+				}
+				return
+			}
+
+			if crt.Certificate.Equal(issuer.Cred.Crt.Certificate) {
+				t.Fatalf("Expected the issuer certificate to be renewed")
+			}
+			if err := crt.Verify(root.Cred.Crt.CertPool(), ""); err != nil {
+				t.Fatalf("Renewed issuer doesn't chain up to the trust anchor: %s", err)
+			}
+			if crt.Certificate.SerialNumber.Cmp(issuer.Cred.Crt.Certificate.SerialNumber) == 0 {
+				t.Fatalf("Expected a new serial number, got %s", crt.Certificate.SerialNumber)
+			}
+			if _, err := tls.ValidateAndCreateCreds(string(secret.Data[k8s.IdentityIssuerCrtName]), string(secret.Data[k8s.IdentityIssuerKeyName])); err != nil {
+				t.Fatalf("Renewed issuer key doesn't match its certificate: %s", err)
+			}
+			expectedAnnotation := crt.Certificate.NotAfter.Format(time.RFC3339)
+			if annotation := secret.Annotations[k8s.IdentityIssuerExpiryAnnotation]; annotation != expectedAnnotation {
+				t.Fatalf("Expected expiry annotation %s, got %s", expectedAnnotation, annotation)
+			}
+		})
+	}
+}
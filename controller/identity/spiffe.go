@@ -0,0 +1,40 @@
+package identity
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/linkerd/linkerd2/pkg/identity"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// SPIFFEDomain maps identities in a TrustDomain to SPIFFE IDs in a SPIFFE
+// trust domain.
+type SPIFFEDomain struct {
+	dom         *TrustDomain
+	trustDomain string
+}
+
+// NewSPIFFEDomain creates a new SPIFFEDomain issuing SPIFFE IDs in the given
+// SPIFFE trust domain.
+func NewSPIFFEDomain(dom *TrustDomain, trustDomain string) (*SPIFFEDomain, error) {
+	if errs := validation.IsDNS1123Subdomain(trustDomain); len(errs) > 0 {
+		return nil, fmt.Errorf("invalid SPIFFE trust domain '%s': %s", trustDomain, errs[0])
+	}
+
+	return &SPIFFEDomain{dom, trustDomain}, nil
+}
+
+// SPIFFEID returns the SPIFFE ID of the service account an identity has been
+// issued to.
+func (d *SPIFFEDomain) SPIFFEID(id string) (*url.URL, error) {
+	typ, nm, ns, err := d.dom.parseIdentity(id)
+	if err != nil {
+		return nil, err
+	}
+	if typ != "serviceaccount" {
+		return nil, fmt.Errorf("identity '%s' is not a service account", id)
+	}
+
+	return identity.ServiceAccountSPIFFEID(d.trustDomain, ns, nm), nil
+}
@@ -0,0 +1,49 @@
+package identity
+
+import (
+	"testing"
+)
+
+func TestSPIFFEID(t *testing.T) {
+	dom, err := NewTrustDomain("linkerd", "cluster.local")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	spiffeDom, err := NewSPIFFEDomain(dom, "example.org")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	for _, tt := range []struct {
+		identity    string
+		expectedID  string
+		expectedErr bool
+	}{
+		{"foo.ns.serviceaccount.identity.linkerd.cluster.local", "spiffe://example.org/ns/ns/sa/foo", false},
+		{"foo.ns.user.identity.linkerd.cluster.local", "", true},
+		{"foo.ns.serviceaccount.identity.linkerd.example.com", "", true},
+		{"foo.serviceaccount.identity.linkerd.cluster.local", "", true},
+		{"bar.foo.ns.serviceaccount.identity.linkerd.cluster.local", "", true},
+	} {
+		tt := tt // pin
+		t.Run(tt.identity, func(t *testing.T) {
+			id, err := spiffeDom.SPIFFEID(tt.identity)
+			if (err != nil) != tt.expectedErr {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if err == nil && id.String() != tt.expectedID {
+				t.Fatalf("Expected SPIFFE ID %s, got %s", tt.expectedID, id)
+			}
+		})
+	}
+}
+
+func TestNewSPIFFEDomain(t *testing.T) {
+	dom, err := NewTrustDomain("linkerd", "cluster.local")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if _, err := NewSPIFFEDomain(dom, "not a domain"); err == nil {
+		t.Fatalf("Expected an error, got nothing")
+	}
+}
@@ -16,12 +16,15 @@ import (
 
 // K8sTokenValidator implements Validator for Kubernetes bearer tokens.
 type K8sTokenValidator struct {
-	authn  kauthn.AuthenticationV1Interface
-	domain *TrustDomain
+	authn     kauthn.AuthenticationV1Interface
+	domain    *TrustDomain
+	audiences []string
 }
 
 // NewK8sTokenValidator takes a kubernetes client and trust domain to create a
-// K8sTokenValidator.
+// K8sTokenValidator. If audiences are given, only tokens scoped to one of them
+// are accepted; the API server also rejects bound tokens whose pod has been
+// deleted.
 //
 // The kubernetes client is used immediately to validate that the client has
 // sufficient privileges to perform token reviews. An error is returned if this
@@ -29,19 +32,22 @@ type K8sTokenValidator struct {
 func NewK8sTokenValidator(
 	k8s k8s.Interface,
 	domain *TrustDomain,
+	audiences []string,
 ) (identity.Validator, error) {
 	if err := checkAccess(k8s.AuthorizationV1()); err != nil {
 		return nil, err
 	}
 
 	authn := k8s.AuthenticationV1()
-	return &K8sTokenValidator{authn, domain}, nil
+	return &K8sTokenValidator{authn, domain, audiences}, nil
 }
 
 // Validate accepts kubernetes bearer tokens and returns a DNS-form linkerd ID.
 func (k *K8sTokenValidator) Validate(_ context.Context, tok []byte) (string, error) {
-	// TODO: Set/check `audience`
-	tr := kauthnApi.TokenReview{Spec: kauthnApi.TokenReviewSpec{Token: string(tok)}}
+	tr := kauthnApi.TokenReview{Spec: kauthnApi.TokenReviewSpec{
+		Token:     string(tok),
+		Audiences: k.audiences,
+	}}
 	rvw, err := k.authn.TokenReviews().Create(&tr)
 	if err != nil {
 		return "", err
@@ -53,11 +59,22 @@ func (k *K8sTokenValidator) Validate(_ context.Context, tok []byte) (string, err
 	if !rvw.Status.Authenticated {
 		return "", identity.NotAuthenticated{}
 	}
+	// The API server returns the requested audiences the token is scoped to.
+	if len(k.audiences) > 0 && !hasAudience(rvw.Status.Audiences, k.audiences) {
+		msg := fmt.Sprintf("token is not scoped to any of the audiences %s", strings.Join(k.audiences, ", "))
+		return "", identity.InvalidToken{Reason: msg}
+	}
 
 	// Determine the identity associated with the token's userinfo.
-	uns := strings.Split(rvw.Status.User.Username, ":")
+	return k.domain.usernameIdentity(rvw.Status.User.Username)
+}
+
+// usernameIdentity formats the identity of a Kubernetes user, which must be
+// of the form system:TYPE:NS:SA.
+func (d *TrustDomain) usernameIdentity(username string) (string, error) {
+	uns := strings.Split(username, ":")
 	if len(uns) != 4 || uns[0] != "system" {
-		msg := fmt.Sprintf("Username must be in form system:TYPE:NS:SA: %s", rvw.Status.User.Username)
+		msg := fmt.Sprintf("Username must be in form system:TYPE:NS:SA: %s", username)
 		return "", identity.InvalidToken{Reason: msg}
 	}
 	uns = uns[1:]
@@ -67,7 +84,19 @@ func (k *K8sTokenValidator) Validate(_ context.Context, tok []byte) (string, err
 		}
 	}
 
-	return k.domain.Identity(uns[0], uns[2], uns[1])
+	return d.Identity(uns[0], uns[2], uns[1])
+}
+
+// hasAudience returns true if any of the token's audiences is expected.
+func hasAudience(audiences, expected []string) bool {
+	for _, aud := range audiences {
+		for _, e := range expected {
+			if aud == e {
+				return true
+			}
+		}
+	}
+	return false
 }
 
 func checkAccess(authz kauthz.AuthorizationV1Interface) error {
@@ -0,0 +1,69 @@
+package identity
+
+import (
+	"context"
+	"testing"
+
+	"github.com/linkerd/linkerd2/pkg/identity"
+	kauthnApi "k8s.io/api/authentication/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestK8sTokenValidatorAudiences(t *testing.T) {
+	dom, err := NewTrustDomain("linkerd", "cluster.local")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	for _, tt := range []struct {
+		description      string
+		audiences        []string
+		reviewAudiences  []string
+		expectedIdentity string
+		expectedErr      error
+	}{
+		{
+			description:      "accepts any token without audiences",
+			expectedIdentity: "foo.ns.serviceaccount.identity.linkerd.cluster.local",
+		},
+		{
+			description:      "accepts tokens scoped to one of the audiences",
+			audiences:        []string{"identity.l5d.io", "other"},
+			reviewAudiences:  []string{"identity.l5d.io"},
+			expectedIdentity: "foo.ns.serviceaccount.identity.linkerd.cluster.local",
+		},
+		{
+			description: "rejects tokens which aren't scoped to the audiences",
+			audiences:   []string{"identity.l5d.io"},
+			expectedErr: identity.InvalidToken{Reason: "token is not scoped to any of the audiences identity.l5d.io"},
+		},
+	} {
+		tt := tt // pin
+		t.Run(tt.description, func(t *testing.T) {
+			client := fake.NewSimpleClientset()
+			client.PrependReactor("create", "tokenreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				review := action.(k8stesting.CreateAction).GetObject().(*kauthnApi.TokenReview)
+				if len(review.Spec.Audiences) != len(tt.audiences) {
+					t.Errorf("Expected audiences %v to be requested, got %v", tt.audiences, review.Spec.Audiences)
+				}
+				review.Status = kauthnApi.TokenReviewStatus{
+					Authenticated: true,
+					User:          kauthnApi.UserInfo{Username: "system:serviceaccount:ns:foo"},
+					Audiences:     tt.reviewAudiences,
+				}
+				return true, review, nil
+			})
+
+			v := &K8sTokenValidator{client.AuthenticationV1(), dom, tt.audiences}
+			id, err := v.Validate(context.Background(), []byte("token"))
+			if err != tt.expectedErr {
+				t.Fatalf("Expected error %v, got %v", tt.expectedErr, err)
+			}
+			if id != tt.expectedIdentity {
+				t.Fatalf("Expected identity %q, got %q", tt.expectedIdentity, id)
+			}
+		})
+	}
+}
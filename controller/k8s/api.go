@@ -26,6 +26,8 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
 	"k8s.io/client-go/informers"
 	arinformers "k8s.io/client-go/informers/admissionregistration/v1beta1"
 	appv1informers "k8s.io/client-go/informers/apps/v1"
@@ -58,6 +60,13 @@ const (
 	Svc
 	TS
 	Node
+	ES // endpoint slice
+)
+
+const (
+	podIPIndex        = "podIP"
+	serviceIPIndex    = "clusterIP"
+	externalNameIndex = "externalName"
 )
 
 // API provides shared informers for all Kubernetes objects
@@ -80,11 +89,13 @@ type API struct {
 	svc      coreinformers.ServiceInformer
 	ts       tsinformers.TrafficSplitInformer
 	node     coreinformers.NodeInformer
+	es       informers.GenericInformer
 
-	syncChecks        []cache.InformerSynced
-	sharedInformers   informers.SharedInformerFactory
-	spSharedInformers sp.SharedInformerFactory
-	tsSharedInformers ts.SharedInformerFactory
+	syncChecks             []cache.InformerSynced
+	sharedInformers        informers.SharedInformerFactory
+	spSharedInformers      sp.SharedInformerFactory
+	tsSharedInformers      ts.SharedInformerFactory
+	dynamicSharedInformers dynamicinformer.DynamicSharedInformerFactory
 }
 
 // InitializeAPI creates Kubernetes clients and returns an initialized API wrapper.
@@ -130,7 +141,25 @@ func InitializeAPI(kubeConfig string, resources ...APIResource) (*API, error) {
 			break
 		}
 	}
-	return NewAPI(k8sClient, spClient, tsClient, resources...), nil
+
+	// EndpointSlices
+	var dynamicClient dynamic.Interface
+	for _, res := range resources {
+		if res == ES {
+			err := endpointSlicesAccess(k8sClient)
+			if err != nil {
+				return nil, err
+			}
+
+			dynamicClient, err = NewDynamicClient(kubeConfig)
+			if err != nil {
+				return nil, err
+			}
+
+			break
+		}
+	}
+	return NewAPI(k8sClient, spClient, tsClient, dynamicClient, resources...), nil
 }
 
 // NewAPI takes a Kubernetes client and returns an initialized API.
@@ -138,6 +167,7 @@ func NewAPI(
 	k8sClient kubernetes.Interface,
 	spClient spclient.Interface,
 	tsClient tsclient.Interface,
+	dynamicClient dynamic.Interface,
 	resources ...APIResource,
 ) *API {
 	sharedInformers := informers.NewSharedInformerFactory(k8sClient, 10*time.Minute)
@@ -152,12 +182,18 @@ func NewAPI(
 		tsSharedInformers = ts.NewSharedInformerFactory(tsClient, 10*time.Minute)
 	}
 
+	var dynamicSharedInformers dynamicinformer.DynamicSharedInformerFactory
+	if dynamicClient != nil {
+		dynamicSharedInformers = dynamicinformer.NewDynamicSharedInformerFactory(dynamicClient, 10*time.Minute)
+	}
+
 	api := &API{
-		Client:            k8sClient,
-		syncChecks:        make([]cache.InformerSynced, 0),
-		sharedInformers:   sharedInformers,
-		spSharedInformers: spSharedInformers,
-		tsSharedInformers: tsSharedInformers,
+		Client:                 k8sClient,
+		syncChecks:             make([]cache.InformerSynced, 0),
+		sharedInformers:        sharedInformers,
+		spSharedInformers:      spSharedInformers,
+		tsSharedInformers:      tsSharedInformers,
+		dynamicSharedInformers: dynamicSharedInformers,
 	}
 
 	for _, resource := range resources {
@@ -188,6 +224,7 @@ func NewAPI(
 			api.syncChecks = append(api.syncChecks, api.ns.Informer().HasSynced)
 		case Pod:
 			api.pod = sharedInformers.Core().V1().Pods()
+			api.pod.Informer().AddIndexers(cache.Indexers{podIPIndex: indexPodByIP})
 			api.syncChecks = append(api.syncChecks, api.pod.Informer().HasSynced)
 		case RC:
 			api.rc = sharedInformers.Core().V1().ReplicationControllers()
@@ -203,6 +240,10 @@ func NewAPI(
 			api.syncChecks = append(api.syncChecks, api.ss.Informer().HasSynced)
 		case Svc:
 			api.svc = sharedInformers.Core().V1().Services()
+			api.svc.Informer().AddIndexers(cache.Indexers{
+				serviceIPIndex:    indexServiceByIP,
+				externalNameIndex: indexServiceByExternalName,
+			})
 			api.syncChecks = append(api.syncChecks, api.svc.Informer().HasSynced)
 		case TS:
 			api.ts = tsSharedInformers.Split().V1alpha1().TrafficSplits()
@@ -210,6 +251,9 @@ func NewAPI(
 		case Node:
 			api.node = sharedInformers.Core().V1().Nodes()
 			api.syncChecks = append(api.syncChecks, api.node.Informer().HasSynced)
+		case ES:
+			api.es = dynamicSharedInformers.ForResource(EndpointSliceGVR)
+			api.syncChecks = append(api.syncChecks, api.es.Informer().HasSynced)
 		}
 	}
 
@@ -221,6 +265,9 @@ func (api *API) Sync() {
 	api.sharedInformers.Start(nil)
 	api.spSharedInformers.Start(nil)
 	api.tsSharedInformers.Start(nil)
+	if api.dynamicSharedInformers != nil {
+		api.dynamicSharedInformers.Start(nil)
+	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
 	defer cancel()
@@ -359,6 +406,15 @@ func (api *API) Node() coreinformers.NodeInformer {
 	return api.node
 }
 
+// ES provides access to a shared informer and lister for EndpointSlices. The
+// objects it returns can be decoded with ToEndpointSlice.
+func (api *API) ES() informers.GenericInformer {
+	if api.es == nil {
+		panic("ES informer not configured")
+	}
+	return api.es
+}
+
 // CJ provides access to a shared informer and lister for CronJobs.
 func (api *API) CJ() batchv1beta1informers.CronJobInformer {
 	if api.cj == nil {
@@ -556,7 +612,7 @@ func (api *API) GetPodsFor(obj runtime.Object, includeFailed bool) ([]*corev1.Po
 
 	allPods := []*corev1.Pod{}
 	for _, pod := range pods {
-		if isPendingOrRunning(pod) || (includeFailed && isFailed(pod)) {
+		if IsPendingOrRunning(pod) || (includeFailed && isFailed(pod)) {
 			if ownerUID == "" || isOwner(ownerUID, pod.GetOwnerReferences()) {
 				allPods = append(allPods, pod)
 			}
@@ -703,7 +759,7 @@ func (api *API) getPods(namespace, name string) ([]runtime.Object, error) {
 
 	objects := []runtime.Object{}
 	for _, pod := range pods {
-		if !isPendingOrRunning(pod) {
+		if !IsPendingOrRunning(pod) {
 			continue
 		}
 		objects = append(objects, pod)
@@ -1010,6 +1066,90 @@ func (api *API) GetServiceProfileFor(svc *corev1.Service, clientNs, clusterDomai
 	}
 }
 
+// GetPodsByIP returns the pods whose pod IP matches the given IP address. Pods
+// running on the host network are never returned, since they share their IP
+// with the node and cannot be told apart from each other.
+func (api *API) GetPodsByIP(ip string) ([]*corev1.Pod, error) {
+	objs, err := api.Pod().Informer().GetIndexer().ByIndex(podIPIndex, ip)
+	if err != nil {
+		return nil, err
+	}
+
+	pods := []*corev1.Pod{}
+	for _, obj := range objs {
+		pods = append(pods, obj.(*corev1.Pod))
+	}
+	return pods, nil
+}
+
+// GetServicesByIP returns the services whose cluster IP matches the given IP
+// address. Headless services have no cluster IP and are never returned.
+func (api *API) GetServicesByIP(ip string) ([]*corev1.Service, error) {
+	objs, err := api.Svc().Informer().GetIndexer().ByIndex(serviceIPIndex, ip)
+	if err != nil {
+		return nil, err
+	}
+
+	services := []*corev1.Service{}
+	for _, obj := range objs {
+		services = append(services, obj.(*corev1.Service))
+	}
+	return services, nil
+}
+
+// GetServicesByExternalName returns the ExternalName services which alias the
+// given host name.  Host names are compared case-insensitively and without any
+// trailing dot.
+func (api *API) GetServicesByExternalName(host string) ([]*corev1.Service, error) {
+	objs, err := api.Svc().Informer().GetIndexer().ByIndex(externalNameIndex, normalizeHost(host))
+	if err != nil {
+		return nil, err
+	}
+
+	services := []*corev1.Service{}
+	for _, obj := range objs {
+		services = append(services, obj.(*corev1.Service))
+	}
+	return services, nil
+}
+
+func indexPodByIP(obj interface{}) ([]string, error) {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return nil, fmt.Errorf("object is not a pod: %v", obj)
+	}
+	if pod.Spec.HostNetwork || pod.Status.PodIP == "" {
+		return []string{}, nil
+	}
+	return []string{pod.Status.PodIP}, nil
+}
+
+func indexServiceByIP(obj interface{}) ([]string, error) {
+	svc, ok := obj.(*corev1.Service)
+	if !ok {
+		return nil, fmt.Errorf("object is not a service: %v", obj)
+	}
+	if svc.Spec.ClusterIP == "" || svc.Spec.ClusterIP == corev1.ClusterIPNone {
+		return []string{}, nil
+	}
+	return []string{svc.Spec.ClusterIP}, nil
+}
+
+func indexServiceByExternalName(obj interface{}) ([]string, error) {
+	svc, ok := obj.(*corev1.Service)
+	if !ok {
+		return nil, fmt.Errorf("object is not a service: %v", obj)
+	}
+	if svc.Spec.Type != corev1.ServiceTypeExternalName || svc.Spec.ExternalName == "" {
+		return []string{}, nil
+	}
+	return []string{normalizeHost(svc.Spec.ExternalName)}, nil
+}
+
+func normalizeHost(host string) string {
+	return strings.ToLower(strings.TrimSuffix(host, "."))
+}
+
 func hasOverlap(as, bs []*corev1.Pod) bool {
 	for _, a := range as {
 		for _, b := range bs {
@@ -1021,7 +1161,9 @@ func hasOverlap(as, bs []*corev1.Pod) bool {
 	return false
 }
 
-func isPendingOrRunning(pod *corev1.Pod) bool {
+// IsPendingOrRunning returns true if the pod is pending or running, and not
+// being deleted.
+func IsPendingOrRunning(pod *corev1.Pod) bool {
 	pending := pod.Status.Phase == corev1.PodPending
 	running := pod.Status.Phase == corev1.PodRunning
 	terminating := pod.DeletionTimestamp != nil
@@ -1329,3 +1329,331 @@ spec:
 
 	})
 }
+
+func TestGetPodsByIP(t *testing.T) {
+	t.Run("GetPodsByIP", func(t *testing.T) {
+		expectations := []struct {
+			ip            string
+			k8sResResults []string // expected results from GetPodsByIP
+			k8sResMisc    []string // additional k8s objects for seeding the k8s client
+		}{
+			// A pod with a matching pod IP is returned.
+			{
+				ip: "172.17.0.12",
+				k8sResResults: []string{`
+apiVersion: v1
+kind: Pod
+metadata:
+  name: my-pod
+  namespace: emojivoto
+status:
+  phase: Running
+  podIP: 172.17.0.12`,
+				},
+				k8sResMisc: []string{`
+apiVersion: v1
+kind: Pod
+metadata:
+  name: other-pod
+  namespace: emojivoto
+status:
+  phase: Running
+  podIP: 172.17.0.13`,
+				},
+			},
+			// Pods on the host network are never returned.
+			{
+				ip:            "172.17.0.14",
+				k8sResResults: []string{},
+				k8sResMisc: []string{`
+apiVersion: v1
+kind: Pod
+metadata:
+  name: host-pod
+  namespace: emojivoto
+spec:
+  hostNetwork: true
+status:
+  phase: Running
+  podIP: 172.17.0.14`,
+				},
+			},
+		}
+
+		for _, exp := range expectations {
+			api, k8sResults, err := newAPI(true, exp.k8sResResults, exp.k8sResMisc...)
+			if err != nil {
+				t.Fatalf("newAPI error: %s", err)
+			}
+
+			k8sResultPods := []*corev1.Pod{}
+			for _, obj := range k8sResults {
+				k8sResultPods = append(k8sResultPods, obj.(*corev1.Pod))
+			}
+
+			pods, err := api.GetPodsByIP(exp.ip)
+			if err != nil {
+				t.Fatalf("api.GetPodsByIP() unexpected error: %s", err)
+			}
+
+			sort.Sort(byPod(k8sResultPods))
+			sort.Sort(byPod(pods))
+			if !reflect.DeepEqual(pods, k8sResultPods) {
+				t.Fatalf("Expected: %+v, Got: %+v", k8sResultPods, pods)
+			}
+		}
+	})
+}
+
+func TestGetServicesByIP(t *testing.T) {
+	t.Run("GetServicesByIP", func(t *testing.T) {
+		expectations := []struct {
+			ip            string
+			k8sResResults []string // expected results from GetServicesByIP
+			k8sResMisc    []string // additional k8s objects for seeding the k8s client
+		}{
+			// A service with a matching cluster IP is returned.
+			{
+				ip: "10.96.0.10",
+				k8sResResults: []string{`
+apiVersion: v1
+kind: Service
+metadata:
+  name: my-svc
+  namespace: emojivoto
+spec:
+  type: ClusterIP
+  clusterIP: 10.96.0.10`,
+				},
+				k8sResMisc: []string{`
+apiVersion: v1
+kind: Service
+metadata:
+  name: other-svc
+  namespace: emojivoto
+spec:
+  type: ClusterIP
+  clusterIP: 10.96.0.11`,
+				},
+			},
+			// Headless services are never returned.
+			{
+				ip:            "None",
+				k8sResResults: []string{},
+				k8sResMisc: []string{`
+apiVersion: v1
+kind: Service
+metadata:
+  name: headless-svc
+  namespace: emojivoto
+spec:
+  type: ClusterIP
+  clusterIP: None`,
+				},
+			},
+		}
+
+		for _, exp := range expectations {
+			api, k8sResults, err := newAPI(true, exp.k8sResResults, exp.k8sResMisc...)
+			if err != nil {
+				t.Fatalf("newAPI error: %s", err)
+			}
+
+			k8sResultServices := []*corev1.Service{}
+			for _, obj := range k8sResults {
+				k8sResultServices = append(k8sResultServices, obj.(*corev1.Service))
+			}
+
+			services, err := api.GetServicesByIP(exp.ip)
+			if err != nil {
+				t.Fatalf("api.GetServicesByIP() unexpected error: %s", err)
+			}
+
+			sort.Sort(byService(k8sResultServices))
+			sort.Sort(byService(services))
+			if !reflect.DeepEqual(services, k8sResultServices) {
+				t.Fatalf("Expected: %+v, Got: %+v", k8sResultServices, services)
+			}
+		}
+	})
+}
+
+func TestGetServicesByExternalName(t *testing.T) {
+	t.Run("GetServicesByExternalName", func(t *testing.T) {
+		expectations := []struct {
+			host          string
+			k8sResResults []string // expected results from GetServicesByExternalName
+			k8sResMisc    []string // additional k8s objects for seeding the k8s client
+		}{
+			// An ExternalName service aliasing the host is returned.
+			{
+				host: "API.example.com.",
+				k8sResResults: []string{`
+apiVersion: v1
+kind: Service
+metadata:
+  name: my-svc
+  namespace: emojivoto
+spec:
+  type: ExternalName
+  externalName: api.example.com`,
+				},
+				k8sResMisc: []string{`
+apiVersion: v1
+kind: Service
+metadata:
+  name: other-svc
+  namespace: emojivoto
+spec:
+  type: ExternalName
+  externalName: web.example.com`,
+				},
+			},
+			// Services of other types are never returned.
+			{
+				host:          "api.example.com",
+				k8sResResults: []string{},
+				k8sResMisc: []string{`
+apiVersion: v1
+kind: Service
+metadata:
+  name: my-svc
+  namespace: emojivoto
+spec:
+  type: ClusterIP
+  clusterIP: 10.96.0.10
+  externalName: api.example.com`,
+				},
+			},
+		}
+
+		for _, exp := range expectations {
+			api, k8sResults, err := newAPI(true, exp.k8sResResults, exp.k8sResMisc...)
+			if err != nil {
+				t.Fatalf("newAPI error: %s", err)
+			}
+
+			k8sResultServices := []*corev1.Service{}
+			for _, obj := range k8sResults {
+				k8sResultServices = append(k8sResultServices, obj.(*corev1.Service))
+			}
+
+			services, err := api.GetServicesByExternalName(exp.host)
+			if err != nil {
+				t.Fatalf("api.GetServicesByExternalName() unexpected error: %s", err)
+			}
+
+			sort.Sort(byService(k8sResultServices))
+			sort.Sort(byService(services))
+			if !reflect.DeepEqual(services, k8sResultServices) {
+				t.Fatalf("Expected: %+v, Got: %+v", k8sResultServices, services)
+			}
+		}
+	})
+}
+
+func TestGetEndpointSlicesFor(t *testing.T) {
+	api, _, err := newAPI(true, []string{}, `
+apiVersion: discovery.k8s.io/v1alpha1
+kind: EndpointSlice
+metadata:
+  name: my-svc-abcde
+  namespace: emojivoto
+  labels:
+    kubernetes.io/service-name: my-svc
+endpoints:
+- addresses:
+  - 172.17.0.12
+  conditions:
+    ready: true
+  targetRef:
+    kind: Pod
+    name: my-pod
+    namespace: emojivoto
+ports:
+- name: http
+  port: 8080`,
+		`
+apiVersion: discovery.k8s.io/v1alpha1
+kind: EndpointSlice
+metadata:
+  name: other-svc-abcde
+  namespace: emojivoto
+  labels:
+    kubernetes.io/service-name: other-svc
+endpoints:
+- addresses:
+  - 172.17.0.13`,
+	)
+	if err != nil {
+		t.Fatalf("newAPI error: %s", err)
+	}
+
+	slices, err := api.GetEndpointSlicesFor("emojivoto", "my-svc")
+	if err != nil {
+		t.Fatalf("api.GetEndpointSlicesFor() unexpected error: %s", err)
+	}
+
+	if len(slices) != 1 {
+		t.Fatalf("Expected 1 endpoint slice, got %d: %+v", len(slices), slices)
+	}
+	slice := slices[0]
+	if slice.Name != "my-svc-abcde" || slice.ServiceName() != "my-svc" {
+		t.Fatalf("Unexpected endpoint slice: %+v", slice)
+	}
+	if len(slice.Endpoints) != 1 || slice.Endpoints[0].Addresses[0] != "172.17.0.12" || !slice.Endpoints[0].IsReady() {
+		t.Fatalf("Unexpected endpoints: %+v", slice.Endpoints)
+	}
+	if len(slice.Ports) != 1 || *slice.Ports[0].Name != "http" || *slice.Ports[0].Port != 8080 {
+		t.Fatalf("Unexpected ports: %+v", slice.Ports)
+	}
+}
+
+func TestEndpointSlicesAccess(t *testing.T) {
+	testCases := []struct {
+		name     string
+		configs  []string
+		expected string
+	}{
+		{
+			name:     "Rejects clusters without the EndpointSlice API",
+			expected: "EndpointSlice API discovery.k8s.io/v1alpha1 not served by the cluster: GroupVersion \"discovery.k8s.io/v1alpha1\" not found",
+		},
+		{
+			name: "Rejects clusters serving another version of the EndpointSlice API",
+			configs: []string{`
+apiVersion: v1
+kind: APIResourceList
+groupVersion: discovery.k8s.io/v1alpha1
+resources: []`},
+			expected: "EndpointSlice API discovery.k8s.io/v1alpha1 not served by the cluster",
+		},
+		{
+			name: "Checks access to EndpointSlices",
+			configs: []string{`
+apiVersion: v1
+kind: APIResourceList
+groupVersion: discovery.k8s.io/v1alpha1
+resources:
+- name: endpointslices
+  kind: EndpointSlice
+  namespaced: true`},
+			expected: "not authorized to access endpointslices.discovery.k8s.io",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.name, func(t *testing.T) {
+			k8sClient, _, _, _, _, err := k8s.NewFakeClientSets(tc.configs...)
+			if err != nil {
+				t.Fatalf("NewFakeClientSets returned an error: %s", err)
+			}
+
+			err = endpointSlicesAccess(k8sClient)
+			if err == nil || err.Error() != tc.expected {
+				t.Fatalf("Expected error: %s, got: %v", tc.expected, err)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	spclient "github.com/linkerd/linkerd2/controller/gen/client/clientset/versioned"
 	"github.com/linkerd/linkerd2/pkg/k8s"
 	"github.com/linkerd/linkerd2/pkg/prometheus"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/rest"
 
 	// Load all the auth plugins for the cloud providers.
@@ -43,3 +44,14 @@ func NewTsClientSet(kubeConfig string) (*tsclient.Clientset, error) {
 
 	return tsclient.NewForConfig(config)
 }
+
+// NewDynamicClient returns a Kubernetes dynamic client for the given
+// configuration, used to watch resources that have no typed client.
+func NewDynamicClient(kubeConfig string) (dynamic.Interface, error) {
+	config, err := newConfig(kubeConfig, "dynamic")
+	if err != nil {
+		return nil, err
+	}
+
+	return dynamic.NewForConfig(config)
+}
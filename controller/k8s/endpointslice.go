@@ -0,0 +1,133 @@
+package k8s
+
+import (
+	"fmt"
+
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+)
+
+// EndpointSliceServiceNameLabel is the label which the EndpointSlice
+// controller sets on every EndpointSlice to reference the owning Service.
+const EndpointSliceServiceNameLabel = "kubernetes.io/service-name"
+
+// EndpointSliceGVR identifies the EndpointSlice resource.  EndpointSlices are
+// not part of the client-go version this project builds against, so they are
+// watched through the dynamic client and decoded into the types below.  Only
+// clusters serving this version are supported, which endpointSlicesAccess
+// checks on startup.
+var EndpointSliceGVR = schema.GroupVersionResource{
+	Group:    "discovery.k8s.io",
+	Version:  "v1alpha1",
+	Resource: "endpointslices",
+}
+
+type (
+	// EndpointSlice is a subset of the endpoints backing a Service.  It mirrors
+	// the discovery.k8s.io/v1alpha1 EndpointSlice resource.
+	EndpointSlice struct {
+		metav1.TypeMeta   `json:",inline"`
+		metav1.ObjectMeta `json:"metadata,omitempty"`
+
+		Endpoints []SliceEndpoint `json:"endpoints"`
+		Ports     []EndpointPort  `json:"ports"`
+	}
+
+	// SliceEndpoint is a single logical backend in an EndpointSlice.
+	SliceEndpoint struct {
+		Addresses  []string                `json:"addresses"`
+		Conditions EndpointConditions      `json:"conditions,omitempty"`
+		Hostname   *string                 `json:"hostname,omitempty"`
+		TargetRef  *corev1.ObjectReference `json:"targetRef,omitempty"`
+		Topology   map[string]string       `json:"topology,omitempty"`
+	}
+
+	// EndpointConditions is the current state of a SliceEndpoint.
+	EndpointConditions struct {
+		Ready *bool `json:"ready,omitempty"`
+	}
+
+	// EndpointPort is a port exposed by all the endpoints of an EndpointSlice.
+	EndpointPort struct {
+		Name     *string          `json:"name,omitempty"`
+		Protocol *corev1.Protocol `json:"protocol,omitempty"`
+		Port     *int32           `json:"port,omitempty"`
+	}
+)
+
+// ServiceName returns the name of the Service the EndpointSlice belongs to, or
+// an empty string if it isn't labeled with one.
+func (es *EndpointSlice) ServiceName() string {
+	return es.Labels[EndpointSliceServiceNameLabel]
+}
+
+// IsReady returns true unless the endpoint is explicitly not ready.
+func (e *SliceEndpoint) IsReady() bool {
+	return e.Conditions.Ready == nil || *e.Conditions.Ready
+}
+
+// ToEndpointSlice converts an object from the EndpointSlice informer into an
+// EndpointSlice.  Tombstones of deleted EndpointSlices are unwrapped.
+func ToEndpointSlice(obj interface{}) (*EndpointSlice, error) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("object is not an EndpointSlice: %v", obj)
+	}
+
+	slice := &EndpointSlice{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), slice)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode EndpointSlice %s/%s: %s", u.GetNamespace(), u.GetName(), err)
+	}
+	return slice, nil
+}
+
+// endpointSlicesAccess checks whether the cluster serves the version of the
+// EndpointSlice API decoded by this package, and the client is authorized to
+// list EndpointSlices.
+func endpointSlicesAccess(k8sClient kubernetes.Interface) error {
+	groupVersion := EndpointSliceGVR.GroupVersion().String()
+	res, err := k8sClient.Discovery().ServerResourcesForGroupVersion(groupVersion)
+	if err != nil {
+		return fmt.Errorf("EndpointSlice API %s not served by the cluster: %s", groupVersion, err)
+	}
+
+	for _, apiRes := range res.APIResources {
+		if apiRes.Name == EndpointSliceGVR.Resource {
+			return k8s.ResourceAuthz(k8sClient, "", "list", EndpointSliceGVR.Group, EndpointSliceGVR.Version, EndpointSliceGVR.Resource, "")
+		}
+	}
+
+	return fmt.Errorf("EndpointSlice API %s not served by the cluster", groupVersion)
+}
+
+// GetEndpointSlicesFor returns all the EndpointSlices belonging to the given
+// Service.
+func (api *API) GetEndpointSlicesFor(namespace, service string) ([]*EndpointSlice, error) {
+	selector := labels.Set{EndpointSliceServiceNameLabel: service}.AsSelector()
+	objs, err := api.ES().Lister().ByNamespace(namespace).List(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	slices := []*EndpointSlice{}
+	for _, obj := range objs {
+		slice, err := ToEndpointSlice(obj)
+		if err != nil {
+			return nil, err
+		}
+		slices = append(slices, slice)
+	}
+	return slices, nil
+}
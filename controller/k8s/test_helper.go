@@ -3,11 +3,21 @@ package k8s
 import (
 	"github.com/linkerd/linkerd2/pkg/k8s"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"sigs.k8s.io/yaml"
 )
 
 // NewFakeAPI provides a mock Kubernetes API for testing.
 func NewFakeAPI(configs ...string) (*API, error) {
-	clientSet, _, _, spClientSet, tsClientSet, err := k8s.NewFakeClientSets(configs...)
+	typedConfigs, dynamicObjs, err := splitDynamicConfigs(configs)
+	if err != nil {
+		return nil, err
+	}
+
+	clientSet, _, _, spClientSet, tsClientSet, err := k8s.NewFakeClientSets(typedConfigs...)
 	if err != nil {
 		return nil, err
 	}
@@ -16,6 +26,7 @@ func NewFakeAPI(configs ...string) (*API, error) {
 		clientSet,
 		spClientSet,
 		tsClientSet,
+		dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), dynamicObjs...),
 		CJ,
 		CM,
 		Deploy,
@@ -32,9 +43,39 @@ func NewFakeAPI(configs ...string) (*API, error) {
 		Svc,
 		TS,
 		Node,
+		ES,
 	), nil
 }
 
+// splitDynamicConfigs separates the configs of resources which are only
+// available through the dynamic client, such as EndpointSlices, from the
+// configs of resources with typed clients.
+func splitDynamicConfigs(configs []string) ([]string, []runtime.Object, error) {
+	typedConfigs := []string{}
+	dynamicObjs := []runtime.Object{}
+	for _, config := range configs {
+		var typeMeta metav1.TypeMeta
+		if err := yaml.Unmarshal([]byte(config), &typeMeta); err != nil {
+			return nil, nil, err
+		}
+		if typeMeta.Kind != "EndpointSlice" {
+			typedConfigs = append(typedConfigs, config)
+			continue
+		}
+
+		json, err := yaml.YAMLToJSON([]byte(config))
+		if err != nil {
+			return nil, nil, err
+		}
+		obj, err := runtime.Decode(unstructured.UnstructuredJSONScheme, json)
+		if err != nil {
+			return nil, nil, err
+		}
+		dynamicObjs = append(dynamicObjs, obj)
+	}
+	return typedConfigs, dynamicObjs, nil
+}
+
 type byPod []*corev1.Pod
 
 func (bp byPod) Len() int           { return len(bp) }
@@ -0,0 +1,207 @@
+package servicemirror
+
+import (
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	logging "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+const secretResyncPeriod = 10 * time.Minute
+
+type (
+	// ClusterWatcher watches the Secrets holding the credentials of remote
+	// clusters and runs a RemoteClusterServiceWatcher for each of them.  When
+	// a Secret is deleted, the Services mirrored from its cluster are deleted
+	// as well.
+	ClusterWatcher struct {
+		localClient kubernetes.Interface
+		selector    labels.Selector
+		informers   informers.SharedInformerFactory
+		// newRemoteAPI builds a client for a remote cluster from its
+		// kubeconfig.  It can be replaced in tests.
+		newRemoteAPI func(kubeconfig []byte) (*k8s.KubernetesAPI, error)
+
+		watchers map[string]*RemoteClusterServiceWatcher
+		log      *logging.Entry
+		// This mutex protects modification of the watchers map.
+		sync.Mutex
+	}
+
+	// remoteClusterConfig is the configuration of a remote cluster, read
+	// from its Secret.
+	remoteClusterConfig struct {
+		clusterName    string
+		gatewayAddress string
+		kubeconfig     []byte
+	}
+)
+
+// NewClusterWatcher returns a ClusterWatcher which reads remote cluster
+// credentials from the Secrets in the given namespace, and mirrors the remote
+// Services selected by the given selector.
+func NewClusterWatcher(localClient kubernetes.Interface, namespace string, selector labels.Selector) *ClusterWatcher {
+	cw := &ClusterWatcher{
+		localClient: localClient,
+		selector:    selector,
+		informers: informers.NewSharedInformerFactoryWithOptions(
+			localClient,
+			secretResyncPeriod,
+			informers.WithNamespace(namespace),
+		),
+		newRemoteAPI: newRemoteAPI,
+		watchers:     make(map[string]*RemoteClusterServiceWatcher),
+		log: logging.WithFields(logging.Fields{
+			"component": "cluster-watcher",
+		}),
+	}
+
+	cw.informers.Core().V1().Secrets().Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    cw.addSecret,
+		DeleteFunc: cw.deleteSecret,
+		UpdateFunc: cw.updateSecret,
+	})
+
+	return cw
+}
+
+// Run watches the remote cluster Secrets until the stop channel is closed.
+// Remote clusters are then no longer watched, but the Services mirrored from
+// them are kept.
+func (cw *ClusterWatcher) Run(stop <-chan struct{}) {
+	cw.informers.Start(stop)
+	<-stop
+
+	cw.Lock()
+	defer cw.Unlock()
+	for key, watcher := range cw.watchers {
+		watcher.Stop(false)
+		delete(cw.watchers, key)
+	}
+}
+
+func (cw *ClusterWatcher) addSecret(obj interface{}) {
+	secret := obj.(*corev1.Secret)
+	if secret.Type != k8s.MirrorSecretType {
+		return
+	}
+
+	config, err := parseRemoteClusterSecret(secret)
+	if err != nil {
+		cw.log.Errorf("Invalid remote cluster secret %s/%s: %s", secret.Namespace, secret.Name, err)
+		return
+	}
+
+	remoteAPI, err := cw.newRemoteAPI(config.kubeconfig)
+	if err != nil {
+		cw.log.Errorf("Failed to create client for remote cluster %s: %s", config.clusterName, err)
+		return
+	}
+
+	cw.Lock()
+	defer cw.Unlock()
+
+	key := secretKey(secret)
+	if watcher, ok := cw.watchers[key]; ok {
+		watcher.Stop(false)
+	}
+	watcher := NewRemoteClusterServiceWatcher(cw.localClient, remoteAPI, config.clusterName, config.gatewayAddress, cw.selector)
+	cw.watchers[key] = watcher
+	watcher.Start()
+}
+
+func (cw *ClusterWatcher) updateSecret(oldObj, newObj interface{}) {
+	oldSecret := oldObj.(*corev1.Secret)
+	newSecret := newObj.(*corev1.Secret)
+	if oldSecret.ResourceVersion == newSecret.ResourceVersion {
+		// Periodic resyncs of unchanged Secrets don't need the remote
+		// cluster to be watched again.
+		return
+	}
+
+	// If the cluster was renamed, the Services mirrored under its old name
+	// are deleted.
+	oldConfig, err := parseRemoteClusterSecret(oldSecret)
+	newConfig, newErr := parseRemoteClusterSecret(newSecret)
+	if err == nil && (newErr != nil || newSecret.Type != k8s.MirrorSecretType || oldConfig.clusterName != newConfig.clusterName) {
+		cw.deleteSecret(oldSecret)
+	}
+	cw.addSecret(newSecret)
+}
+
+func (cw *ClusterWatcher) deleteSecret(obj interface{}) {
+	secret, ok := obj.(*corev1.Secret)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			cw.log.Errorf("Couldn't get object from DeletedFinalStateUnknown %#v", obj)
+			return
+		}
+		secret, ok = tombstone.Obj.(*corev1.Secret)
+		if !ok {
+			cw.log.Errorf("DeletedFinalStateUnknown contained object that is not a Secret %#v", obj)
+			return
+		}
+	}
+
+	cw.Lock()
+	defer cw.Unlock()
+
+	key := secretKey(secret)
+	if watcher, ok := cw.watchers[key]; ok {
+		watcher.Stop(true)
+		delete(cw.watchers, key)
+	}
+}
+
+// parseRemoteClusterSecret reads the configuration of a remote cluster from
+// its Secret.
+func parseRemoteClusterSecret(secret *corev1.Secret) (*remoteClusterConfig, error) {
+	if secret.Type != k8s.MirrorSecretType {
+		return nil, fmt.Errorf("secret is of type %s, expected %s", secret.Type, k8s.MirrorSecretType)
+	}
+
+	clusterName := secret.Annotations[k8s.RemoteClusterNameAnnotation]
+	if clusterName == "" {
+		return nil, fmt.Errorf("missing annotation %s", k8s.RemoteClusterNameAnnotation)
+	}
+
+	gatewayAddress := secret.Annotations[k8s.RemoteGatewayAddressAnnotation]
+	if ip := net.ParseIP(gatewayAddress); ip == nil || ip.To4() == nil {
+		return nil, fmt.Errorf("annotation %s must be an IPv4 address, got %q", k8s.RemoteGatewayAddressAnnotation, gatewayAddress)
+	}
+
+	kubeconfig, ok := secret.Data[k8s.MirrorSecretKubeconfigKey]
+	if !ok || len(kubeconfig) == 0 {
+		return nil, fmt.Errorf("missing key %s", k8s.MirrorSecretKubeconfigKey)
+	}
+
+	return &remoteClusterConfig{
+		clusterName:    clusterName,
+		gatewayAddress: gatewayAddress,
+		kubeconfig:     kubeconfig,
+	}, nil
+}
+
+// newRemoteAPI returns a client for the remote cluster described by the given
+// kubeconfig.
+func newRemoteAPI(kubeconfig []byte) (*k8s.KubernetesAPI, error) {
+	config, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	return k8s.NewAPIForConfig(config, "", 0)
+}
+
+func secretKey(secret *corev1.Secret) string {
+	return secret.Namespace + "/" + secret.Name
+}
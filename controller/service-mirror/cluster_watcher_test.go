@@ -0,0 +1,136 @@
+package servicemirror
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+func remoteClusterSecret(annotations map[string]string, data map[string][]byte) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "remote-cluster",
+			Namespace:   "linkerd",
+			Annotations: annotations,
+		},
+		Type: k8s.MirrorSecretType,
+		Data: data,
+	}
+}
+
+func TestParseRemoteClusterSecret(t *testing.T) {
+	validAnnotations := map[string]string{
+		k8s.RemoteClusterNameAnnotation:    "remote",
+		k8s.RemoteGatewayAddressAnnotation: "192.0.2.1",
+	}
+	validData := map[string][]byte{
+		k8s.MirrorSecretKubeconfigKey: []byte("kubeconfig"),
+	}
+
+	t.Run("Parses a valid secret", func(t *testing.T) {
+		config, err := parseRemoteClusterSecret(remoteClusterSecret(validAnnotations, validData))
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		expected := &remoteClusterConfig{
+			clusterName:    "remote",
+			gatewayAddress: "192.0.2.1",
+			kubeconfig:     []byte("kubeconfig"),
+		}
+		if !reflect.DeepEqual(config, expected) {
+			t.Fatalf("Expected %+v, got %+v", expected, config)
+		}
+	})
+
+	for _, tt := range []struct {
+		description string
+		secret      *corev1.Secret
+	}{
+		{
+			description: "a secret of another type",
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Annotations: validAnnotations},
+				Type:       corev1.SecretTypeOpaque,
+				Data:       validData,
+			},
+		},
+		{
+			description: "a secret without a cluster name",
+			secret: remoteClusterSecret(map[string]string{
+				k8s.RemoteGatewayAddressAnnotation: "192.0.2.1",
+			}, validData),
+		},
+		{
+			description: "a secret with an invalid gateway address",
+			secret: remoteClusterSecret(map[string]string{
+				k8s.RemoteClusterNameAnnotation:    "remote",
+				k8s.RemoteGatewayAddressAnnotation: "gateway.example.com",
+			}, validData),
+		},
+		{
+			description: "a secret without a kubeconfig",
+			secret:      remoteClusterSecret(validAnnotations, map[string][]byte{}),
+		},
+	} {
+		tt := tt // pin
+		t.Run("Rejects "+tt.description, func(t *testing.T) {
+			if _, err := parseRemoteClusterSecret(tt.secret); err == nil {
+				t.Fatalf("Expected an error, got nothing")
+			}
+		})
+	}
+}
+
+func TestClusterWatcher(t *testing.T) {
+	localAPI, err := k8s.NewFakeAPI(`
+apiVersion: v1
+kind: Service
+metadata:
+  name: web-remote
+  namespace: emojivoto
+  labels:
+    mirror.linkerd.io/mirrored-service: "true"
+    mirror.linkerd.io/cluster-name: remote
+  annotations:
+    mirror.linkerd.io/remote-svc-name: web`)
+	if err != nil {
+		t.Fatalf("NewFakeAPI returned an error: %s", err)
+	}
+	remoteAPI, err := k8s.NewFakeAPI()
+	if err != nil {
+		t.Fatalf("NewFakeAPI returned an error: %s", err)
+	}
+
+	selector := labels.SelectorFromSet(labels.Set{k8s.ExportedServiceLabel: "true"})
+	cw := NewClusterWatcher(localAPI.Interface, "linkerd", selector)
+	cw.newRemoteAPI = func([]byte) (*k8s.KubernetesAPI, error) {
+		return remoteAPI, nil
+	}
+
+	secret := remoteClusterSecret(map[string]string{
+		k8s.RemoteClusterNameAnnotation:    "remote",
+		k8s.RemoteGatewayAddressAnnotation: "192.0.2.1",
+	}, map[string][]byte{
+		k8s.MirrorSecretKubeconfigKey: []byte("kubeconfig"),
+	})
+
+	cw.addSecret(secret)
+	if _, ok := cw.watchers["linkerd/remote-cluster"]; !ok {
+		t.Fatalf("Expected the remote cluster to be watched")
+	}
+
+	cw.deleteSecret(secret)
+	if len(cw.watchers) != 0 {
+		t.Fatalf("Expected the remote cluster to no longer be watched, got %v", cw.watchers)
+	}
+
+	_, err = localAPI.CoreV1().Services("emojivoto").Get("web-remote", metav1.GetOptions{})
+	if !kerrors.IsNotFound(err) {
+		t.Fatalf("Expected mirrored service to be deleted, got %v", err)
+	}
+}
@@ -0,0 +1,419 @@
+package servicemirror
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	logging "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+)
+
+const (
+	remoteResyncPeriod = 10 * time.Minute
+	maxRetries         = 5
+)
+
+// RemoteClusterServiceWatcher watches the exported Services of a remote
+// cluster and mirrors each of them into the local cluster as a Service and
+// Endpoints pointing at the remote cluster's gateway.  Mirrored resources are
+// named <service>-<cluster> and are created in the same namespace as the remote
+// Service, which is created if needed.  They are deleted once the remote
+// Service is deleted or stops being exported, along with the namespace if it
+// was created for them and is left empty.
+type RemoteClusterServiceWatcher struct {
+	clusterName    string
+	gatewayAddress string
+	localClient    kubernetes.Interface
+
+	remoteInformers informers.SharedInformerFactory
+	remoteServices  corelisters.ServiceLister
+	remoteSynced    cache.InformerSynced
+
+	queue      workqueue.RateLimitingInterface
+	stop       chan struct{}
+	workerDone chan struct{}
+	log        *logging.Entry
+}
+
+// NewRemoteClusterServiceWatcher returns a RemoteClusterServiceWatcher which
+// mirrors the Services of the remote cluster selected by the given selector.
+// It doesn't watch the remote cluster until it is started.
+func NewRemoteClusterServiceWatcher(
+	localClient kubernetes.Interface,
+	remoteAPI *k8s.KubernetesAPI,
+	clusterName string,
+	gatewayAddress string,
+	selector labels.Selector,
+) *RemoteClusterServiceWatcher {
+	remoteInformers := informers.NewSharedInformerFactoryWithOptions(
+		remoteAPI.Interface,
+		remoteResyncPeriod,
+		informers.WithTweakListOptions(func(options *metav1.ListOptions) {
+			options.LabelSelector = selector.String()
+		}),
+	)
+	serviceInformer := remoteInformers.Core().V1().Services()
+
+	rcw := &RemoteClusterServiceWatcher{
+		clusterName:     clusterName,
+		gatewayAddress:  gatewayAddress,
+		localClient:     localClient,
+		remoteInformers: remoteInformers,
+		remoteServices:  serviceInformer.Lister(),
+		remoteSynced:    serviceInformer.Informer().HasSynced,
+		queue:           workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "service-mirror-"+clusterName),
+		stop:            make(chan struct{}),
+		workerDone:      make(chan struct{}),
+		log: logging.WithFields(logging.Fields{
+			"component": "remote-cluster-watcher",
+			"cluster":   clusterName,
+		}),
+	}
+
+	serviceInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    rcw.enqueue,
+		DeleteFunc: rcw.enqueue,
+		UpdateFunc: func(_, obj interface{}) { rcw.enqueue(obj) },
+	})
+
+	return rcw
+}
+
+// Start begins watching the remote cluster.  Once the remote Services have
+// been synced, mirrors whose remote Service no longer exists are garbage
+// collected and all the remote Services are mirrored.
+func (rcw *RemoteClusterServiceWatcher) Start() {
+	rcw.log.Info("Starting to watch remote cluster")
+	rcw.remoteInformers.Start(rcw.stop)
+
+	go func() {
+		defer close(rcw.workerDone)
+		if !cache.WaitForCacheSync(rcw.stop, rcw.remoteSynced) {
+			rcw.log.Error("Failed to sync remote services")
+			return
+		}
+		if err := rcw.enqueueMirrors(); err != nil {
+			rcw.log.Errorf("Failed to list mirrored services: %s", err)
+		}
+		for rcw.processNextItem() {
+		}
+	}()
+}
+
+// Stop stops watching the remote cluster and waits for any pending update to
+// complete.  The watcher must have been started.  If cleanup is set, all the
+// resources mirrored from the remote cluster are then deleted.
+func (rcw *RemoteClusterServiceWatcher) Stop(cleanup bool) {
+	rcw.log.Info("Stopping to watch remote cluster")
+	close(rcw.stop)
+	rcw.queue.ShutDown()
+	<-rcw.workerDone
+
+	if cleanup {
+		if err := rcw.deleteAllMirrors(); err != nil {
+			rcw.log.Errorf("Failed to delete mirrored services: %s", err)
+		}
+	}
+}
+
+func (rcw *RemoteClusterServiceWatcher) enqueue(obj interface{}) {
+	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+	if err != nil {
+		rcw.log.Errorf("Failed to get key for %#v: %s", obj, err)
+		return
+	}
+	rcw.queue.Add(key)
+}
+
+// enqueueMirrors enqueues the remote Service of every existing mirror, so
+// that mirrors of Services deleted while the remote cluster wasn't being
+// watched are garbage collected.
+func (rcw *RemoteClusterServiceWatcher) enqueueMirrors() error {
+	mirrors, err := rcw.localClient.CoreV1().Services(metav1.NamespaceAll).List(metav1.ListOptions{
+		LabelSelector: rcw.mirrorSelector().String(),
+	})
+	if err != nil {
+		return err
+	}
+	for _, mirror := range mirrors.Items {
+		remoteName := mirror.Annotations[k8s.RemoteServiceAnnotation]
+		if remoteName == "" {
+			continue
+		}
+		rcw.queue.Add(mirror.Namespace + "/" + remoteName)
+	}
+	return nil
+}
+
+func (rcw *RemoteClusterServiceWatcher) processNextItem() bool {
+	key, quit := rcw.queue.Get()
+	if quit {
+		return false
+	}
+	defer rcw.queue.Done(key)
+
+	err := rcw.reconcile(key.(string))
+	switch {
+	case err == nil:
+		rcw.queue.Forget(key)
+	case rcw.queue.NumRequeues(key) < maxRetries:
+		rcw.log.Warnf("Failed to mirror %s, retrying: %s", key, err)
+		rcw.queue.AddRateLimited(key)
+	default:
+		rcw.log.Errorf("Failed to mirror %s, giving up: %s", key, err)
+		rcw.queue.Forget(key)
+	}
+	return true
+}
+
+// reconcile brings the mirror of the given remote Service in line with the
+// remote Service, creating, updating or deleting it as needed.
+func (rcw *RemoteClusterServiceWatcher) reconcile(key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+
+	remote, err := rcw.remoteServices.Services(namespace).Get(name)
+	if kerrors.IsNotFound(err) {
+		return rcw.deleteMirror(namespace, name)
+	}
+	if err != nil {
+		return err
+	}
+	return rcw.mirror(remote)
+}
+
+// mirror creates or updates the local Service and Endpoints mirroring the
+// given remote Service.
+func (rcw *RemoteClusterServiceWatcher) mirror(remote *corev1.Service) error {
+	if err := rcw.ensureNamespace(remote.Namespace); err != nil {
+		return err
+	}
+
+	services := rcw.localClient.CoreV1().Services(remote.Namespace)
+	service := rcw.mirrorService(remote)
+	existing, err := services.Get(service.Name, metav1.GetOptions{})
+	switch {
+	case kerrors.IsNotFound(err):
+		rcw.log.Infof("Mirroring service %s/%s as %s", remote.Namespace, remote.Name, service.Name)
+		if _, err := services.Create(service); err != nil {
+			return err
+		}
+	case err != nil:
+		return err
+	default:
+		if !rcw.isMirror(existing.Labels) {
+			return fmt.Errorf("service %s/%s already exists and is not mirrored from cluster %s", existing.Namespace, existing.Name, rcw.clusterName)
+		}
+		existing.Labels = service.Labels
+		existing.Annotations = service.Annotations
+		existing.Spec.Ports = service.Spec.Ports
+		if _, err := services.Update(existing); err != nil {
+			return err
+		}
+	}
+
+	endpoints := rcw.localClient.CoreV1().Endpoints(remote.Namespace)
+	endpoint := rcw.mirrorEndpoints(remote)
+	existingEndpoints, err := endpoints.Get(endpoint.Name, metav1.GetOptions{})
+	switch {
+	case kerrors.IsNotFound(err):
+		_, err = endpoints.Create(endpoint)
+		return err
+	case err != nil:
+		return err
+	default:
+		if !rcw.isMirror(existingEndpoints.Labels) {
+			return fmt.Errorf("endpoints %s/%s already exist and are not mirrored from cluster %s", existingEndpoints.Namespace, existingEndpoints.Name, rcw.clusterName)
+		}
+		existingEndpoints.Labels = endpoint.Labels
+		existingEndpoints.Subsets = endpoint.Subsets
+		_, err = endpoints.Update(existingEndpoints)
+		return err
+	}
+}
+
+// deleteMirror deletes the local Service and Endpoints mirroring the given
+// remote Service, if they exist.
+func (rcw *RemoteClusterServiceWatcher) deleteMirror(namespace, remoteName string) error {
+	name := rcw.mirrorName(remoteName)
+
+	existing, err := rcw.localClient.CoreV1().Services(namespace).Get(name, metav1.GetOptions{})
+	if kerrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !rcw.isMirror(existing.Labels) {
+		return nil
+	}
+
+	rcw.log.Infof("Deleting mirrored service %s/%s", namespace, name)
+	err = rcw.localClient.CoreV1().Services(namespace).Delete(name, &metav1.DeleteOptions{})
+	if err != nil && !kerrors.IsNotFound(err) {
+		return err
+	}
+	err = rcw.localClient.CoreV1().Endpoints(namespace).Delete(name, &metav1.DeleteOptions{})
+	if err != nil && !kerrors.IsNotFound(err) {
+		return err
+	}
+	return rcw.deleteNamespaceIfEmpty(namespace)
+}
+
+// deleteAllMirrors deletes all the Services and Endpoints mirrored from the
+// remote cluster.
+func (rcw *RemoteClusterServiceWatcher) deleteAllMirrors() error {
+	mirrors, err := rcw.localClient.CoreV1().Services(metav1.NamespaceAll).List(metav1.ListOptions{
+		LabelSelector: rcw.mirrorSelector().String(),
+	})
+	if err != nil {
+		return err
+	}
+	for _, mirror := range mirrors.Items {
+		remoteName := mirror.Annotations[k8s.RemoteServiceAnnotation]
+		if err := rcw.deleteMirror(mirror.Namespace, remoteName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ensureNamespace creates the given namespace if it doesn't exist yet, so
+// that Services can be mirrored into namespaces which only exist in the
+// remote cluster.
+func (rcw *RemoteClusterServiceWatcher) ensureNamespace(name string) error {
+	_, err := rcw.localClient.CoreV1().Namespaces().Get(name, metav1.GetOptions{})
+	if !kerrors.IsNotFound(err) {
+		return err
+	}
+
+	rcw.log.Infof("Creating namespace %s", name)
+	_, err = rcw.localClient.CoreV1().Namespaces().Create(&corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: rcw.mirrorLabels(),
+		},
+	})
+	if kerrors.IsAlreadyExists(err) {
+		return nil
+	}
+	return err
+}
+
+// deleteNamespaceIfEmpty deletes the given namespace if it was created to
+// mirror Services from the remote cluster and no longer holds any Service or
+// pod.
+func (rcw *RemoteClusterServiceWatcher) deleteNamespaceIfEmpty(name string) error {
+	ns, err := rcw.localClient.CoreV1().Namespaces().Get(name, metav1.GetOptions{})
+	if kerrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !rcw.isMirror(ns.Labels) {
+		return nil
+	}
+
+	services, err := rcw.localClient.CoreV1().Services(name).List(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	pods, err := rcw.localClient.CoreV1().Pods(name).List(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	if len(services.Items) > 0 || len(pods.Items) > 0 {
+		return nil
+	}
+
+	rcw.log.Infof("Deleting mirrored namespace %s", name)
+	err = rcw.localClient.CoreV1().Namespaces().Delete(name, &metav1.DeleteOptions{})
+	if err != nil && !kerrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func (rcw *RemoteClusterServiceWatcher) mirrorService(remote *corev1.Service) *corev1.Service {
+	ports := []corev1.ServicePort{}
+	for _, port := range remote.Spec.Ports {
+		ports = append(ports, corev1.ServicePort{
+			Name:       port.Name,
+			Protocol:   port.Protocol,
+			Port:       port.Port,
+			TargetPort: intstr.FromInt(int(port.Port)),
+		})
+	}
+
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      rcw.mirrorName(remote.Name),
+			Namespace: remote.Namespace,
+			Labels:    rcw.mirrorLabels(),
+			Annotations: map[string]string{
+				k8s.RemoteServiceAnnotation:         remote.Name,
+				k8s.RemoteResourceVersionAnnotation: remote.ResourceVersion,
+			},
+		},
+		Spec: corev1.ServiceSpec{
+			Ports: ports,
+		},
+	}
+}
+
+func (rcw *RemoteClusterServiceWatcher) mirrorEndpoints(remote *corev1.Service) *corev1.Endpoints {
+	ports := []corev1.EndpointPort{}
+	for _, port := range remote.Spec.Ports {
+		ports = append(ports, corev1.EndpointPort{
+			Name:     port.Name,
+			Protocol: port.Protocol,
+			Port:     port.Port,
+		})
+	}
+
+	return &corev1.Endpoints{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      rcw.mirrorName(remote.Name),
+			Namespace: remote.Namespace,
+			Labels:    rcw.mirrorLabels(),
+		},
+		Subsets: []corev1.EndpointSubset{
+			{
+				Addresses: []corev1.EndpointAddress{{IP: rcw.gatewayAddress}},
+				Ports:     ports,
+			},
+		},
+	}
+}
+
+func (rcw *RemoteClusterServiceWatcher) mirrorName(remoteName string) string {
+	return fmt.Sprintf("%s-%s", remoteName, rcw.clusterName)
+}
+
+func (rcw *RemoteClusterServiceWatcher) mirrorLabels() map[string]string {
+	return map[string]string{
+		k8s.MirroredResourceLabel:  "true",
+		k8s.RemoteClusterNameLabel: rcw.clusterName,
+	}
+}
+
+func (rcw *RemoteClusterServiceWatcher) mirrorSelector() labels.Selector {
+	return labels.SelectorFromSet(rcw.mirrorLabels())
+}
+
+func (rcw *RemoteClusterServiceWatcher) isMirror(objLabels map[string]string) bool {
+	return rcw.mirrorSelector().Matches(labels.Set(objLabels))
+}
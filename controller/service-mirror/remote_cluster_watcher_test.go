@@ -0,0 +1,415 @@
+package servicemirror
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+)
+
+const exportedService = `
+apiVersion: v1
+kind: Service
+metadata:
+  name: web
+  namespace: emojivoto
+  resourceVersion: "42"
+  labels:
+    mirror.linkerd.io/exported: "true"
+spec:
+  type: ClusterIP
+  clusterIP: 10.96.0.10
+  selector:
+    app: web
+  ports:
+  - name: http
+    port: 80
+    protocol: TCP
+    targetPort: 8080`
+
+func newTestWatcher(t *testing.T, stop chan struct{}, remoteConfigs []string, localConfigs []string) (*RemoteClusterServiceWatcher, kubernetes.Interface) {
+	remoteAPI, err := k8s.NewFakeAPI(remoteConfigs...)
+	if err != nil {
+		t.Fatalf("NewFakeAPI returned an error: %s", err)
+	}
+	localAPI, err := k8s.NewFakeAPI(localConfigs...)
+	if err != nil {
+		t.Fatalf("NewFakeAPI returned an error: %s", err)
+	}
+
+	selector := labels.SelectorFromSet(labels.Set{k8s.ExportedServiceLabel: "true"})
+	rcw := NewRemoteClusterServiceWatcher(localAPI.Interface, remoteAPI, "remote", "192.0.2.1", selector)
+
+	rcw.remoteInformers.Start(stop)
+	if !cache.WaitForCacheSync(stop, rcw.remoteSynced) {
+		t.Fatalf("Failed to sync remote services")
+	}
+
+	return rcw, localAPI.Interface
+}
+
+func TestRemoteClusterServiceWatcherMirrorsServices(t *testing.T) {
+	stop := make(chan struct{})
+	defer close(stop)
+	rcw, localClient := newTestWatcher(t, stop, []string{exportedService}, []string{})
+
+	if err := rcw.reconcile("emojivoto/web"); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	ns, err := localClient.CoreV1().Namespaces().Get("emojivoto", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Expected namespace to be created: %s", err)
+	}
+	if ns.Labels[k8s.RemoteClusterNameLabel] != "remote" {
+		t.Fatalf("Expected namespace to be labeled with the remote cluster, got %v", ns.Labels)
+	}
+
+	svc, err := localClient.CoreV1().Services("emojivoto").Get("web-remote", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Expected service to be mirrored: %s", err)
+	}
+	expectedLabels := map[string]string{
+		k8s.MirroredResourceLabel:  "true",
+		k8s.RemoteClusterNameLabel: "remote",
+	}
+	if !reflect.DeepEqual(svc.Labels, expectedLabels) {
+		t.Fatalf("Expected labels %v, got %v", expectedLabels, svc.Labels)
+	}
+	expectedAnnotations := map[string]string{
+		k8s.RemoteServiceAnnotation:         "web",
+		k8s.RemoteResourceVersionAnnotation: "42",
+	}
+	if !reflect.DeepEqual(svc.Annotations, expectedAnnotations) {
+		t.Fatalf("Expected annotations %v, got %v", expectedAnnotations, svc.Annotations)
+	}
+	expectedPorts := []corev1.ServicePort{
+		{Name: "http", Protocol: corev1.ProtocolTCP, Port: 80, TargetPort: intstr.FromInt(80)},
+	}
+	if !reflect.DeepEqual(svc.Spec.Ports, expectedPorts) {
+		t.Fatalf("Expected ports %v, got %v", expectedPorts, svc.Spec.Ports)
+	}
+	if len(svc.Spec.Selector) != 0 {
+		t.Fatalf("Expected mirrored service to have no selector, got %v", svc.Spec.Selector)
+	}
+
+	endpoints, err := localClient.CoreV1().Endpoints("emojivoto").Get("web-remote", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Expected endpoints to be mirrored: %s", err)
+	}
+	expectedSubsets := []corev1.EndpointSubset{
+		{
+			Addresses: []corev1.EndpointAddress{{IP: "192.0.2.1"}},
+			Ports:     []corev1.EndpointPort{{Name: "http", Protocol: corev1.ProtocolTCP, Port: 80}},
+		},
+	}
+	if !reflect.DeepEqual(endpoints.Subsets, expectedSubsets) {
+		t.Fatalf("Expected subsets %v, got %v", expectedSubsets, endpoints.Subsets)
+	}
+}
+
+func TestRemoteClusterServiceWatcherUpdatesMirrors(t *testing.T) {
+	stop := make(chan struct{})
+	defer close(stop)
+	rcw, localClient := newTestWatcher(t, stop, []string{exportedService}, []string{`
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: emojivoto`, `
+apiVersion: v1
+kind: Service
+metadata:
+  name: web-remote
+  namespace: emojivoto
+  labels:
+    mirror.linkerd.io/mirrored-service: "true"
+    mirror.linkerd.io/cluster-name: remote
+  annotations:
+    mirror.linkerd.io/remote-svc-name: web
+    mirror.linkerd.io/remote-resource-version: "41"
+spec:
+  ports:
+  - name: grpc
+    port: 8080`, `
+apiVersion: v1
+kind: Endpoints
+metadata:
+  name: web-remote
+  namespace: emojivoto
+  labels:
+    mirror.linkerd.io/mirrored-service: "true"
+    mirror.linkerd.io/cluster-name: remote
+subsets:
+- addresses:
+  - ip: 192.0.2.1
+  ports:
+  - name: grpc
+    port: 8080`,
+	})
+
+	if err := rcw.reconcile("emojivoto/web"); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	svc, err := localClient.CoreV1().Services("emojivoto").Get("web-remote", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if svc.Annotations[k8s.RemoteResourceVersionAnnotation] != "42" {
+		t.Fatalf("Expected remote resource version to be updated, got %v", svc.Annotations)
+	}
+	if len(svc.Spec.Ports) != 1 || svc.Spec.Ports[0].Port != 80 {
+		t.Fatalf("Expected ports to be updated, got %v", svc.Spec.Ports)
+	}
+
+	endpoints, err := localClient.CoreV1().Endpoints("emojivoto").Get("web-remote", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if len(endpoints.Subsets[0].Ports) != 1 || endpoints.Subsets[0].Ports[0].Port != 80 {
+		t.Fatalf("Expected endpoint ports to be updated, got %v", endpoints.Subsets)
+	}
+}
+
+func TestRemoteClusterServiceWatcherDeletesMirrors(t *testing.T) {
+	mirror := func(cluster string) []string {
+		return []string{`
+apiVersion: v1
+kind: Service
+metadata:
+  name: web-` + cluster + `
+  namespace: emojivoto
+  labels:
+    mirror.linkerd.io/mirrored-service: "true"
+    mirror.linkerd.io/cluster-name: ` + cluster + `
+  annotations:
+    mirror.linkerd.io/remote-svc-name: web`, `
+apiVersion: v1
+kind: Endpoints
+metadata:
+  name: web-` + cluster + `
+  namespace: emojivoto
+  labels:
+    mirror.linkerd.io/mirrored-service: "true"
+    mirror.linkerd.io/cluster-name: ` + cluster,
+		}
+	}
+	localConfigs := append(mirror("remote"), mirror("other")...)
+
+	for _, tt := range []struct {
+		description   string
+		remoteConfigs []string
+		cleanup       func(*RemoteClusterServiceWatcher) error
+	}{
+		{
+			description:   "when the remote service is deleted",
+			remoteConfigs: []string{},
+			cleanup: func(rcw *RemoteClusterServiceWatcher) error {
+				return rcw.reconcile("emojivoto/web")
+			},
+		},
+		{
+			description: "when the remote service is no longer exported",
+			remoteConfigs: []string{`
+apiVersion: v1
+kind: Service
+metadata:
+  name: web
+  namespace: emojivoto
+spec:
+  ports:
+  - port: 80`,
+			},
+			cleanup: func(rcw *RemoteClusterServiceWatcher) error {
+				return rcw.reconcile("emojivoto/web")
+			},
+		},
+		{
+			description:   "when the remote cluster is removed",
+			remoteConfigs: []string{exportedService},
+			cleanup: func(rcw *RemoteClusterServiceWatcher) error {
+				return rcw.deleteAllMirrors()
+			},
+		},
+	} {
+		tt := tt // pin
+		t.Run(tt.description, func(t *testing.T) {
+			stop := make(chan struct{})
+			defer close(stop)
+			rcw, localClient := newTestWatcher(t, stop, tt.remoteConfigs, localConfigs)
+
+			if err := tt.cleanup(rcw); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			_, err := localClient.CoreV1().Services("emojivoto").Get("web-remote", metav1.GetOptions{})
+			if !kerrors.IsNotFound(err) {
+				t.Fatalf("Expected mirrored service to be deleted, got %v", err)
+			}
+			_, err = localClient.CoreV1().Endpoints("emojivoto").Get("web-remote", metav1.GetOptions{})
+			if !kerrors.IsNotFound(err) {
+				t.Fatalf("Expected mirrored endpoints to be deleted, got %v", err)
+			}
+
+			// Services mirrored from other clusters are left alone.
+			if _, err := localClient.CoreV1().Services("emojivoto").Get("web-other", metav1.GetOptions{}); err != nil {
+				t.Fatalf("Expected service mirrored from another cluster to be kept: %s", err)
+			}
+		})
+	}
+}
+
+func TestRemoteClusterServiceWatcherKeepsLocalServices(t *testing.T) {
+	stop := make(chan struct{})
+	defer close(stop)
+	rcw, localClient := newTestWatcher(t, stop, []string{exportedService}, []string{`
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: emojivoto`, `
+apiVersion: v1
+kind: Service
+metadata:
+  name: web-remote
+  namespace: emojivoto
+spec:
+  ports:
+  - port: 8080`,
+	})
+
+	if err := rcw.reconcile("emojivoto/web"); err == nil {
+		t.Fatalf("Expected an error when mirroring over a local service")
+	}
+
+	svc, err := localClient.CoreV1().Services("emojivoto").Get("web-remote", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if svc.Spec.Ports[0].Port != 8080 {
+		t.Fatalf("Expected local service to be left untouched, got %v", svc.Spec.Ports)
+	}
+}
+
+func TestRemoteClusterServiceWatcherKeepsLocalEndpoints(t *testing.T) {
+	stop := make(chan struct{})
+	defer close(stop)
+	rcw, localClient := newTestWatcher(t, stop, []string{exportedService}, []string{`
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: emojivoto`, `
+apiVersion: v1
+kind: Endpoints
+metadata:
+  name: web-remote
+  namespace: emojivoto
+subsets:
+- addresses:
+  - ip: 10.1.1.1
+  ports:
+  - port: 8080`,
+	})
+
+	if err := rcw.reconcile("emojivoto/web"); err == nil {
+		t.Fatalf("Expected an error when mirroring over local endpoints")
+	}
+
+	endpoints, err := localClient.CoreV1().Endpoints("emojivoto").Get("web-remote", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if endpoints.Subsets[0].Addresses[0].IP != "10.1.1.1" {
+		t.Fatalf("Expected local endpoints to be left untouched, got %v", endpoints.Subsets)
+	}
+}
+
+func TestRemoteClusterServiceWatcherDeletesMirroredNamespaces(t *testing.T) {
+	mirroredNamespace := `
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: emojivoto
+  labels:
+    mirror.linkerd.io/mirrored-service: "true"
+    mirror.linkerd.io/cluster-name: remote`
+	mirroredService := `
+apiVersion: v1
+kind: Service
+metadata:
+  name: web-remote
+  namespace: emojivoto
+  labels:
+    mirror.linkerd.io/mirrored-service: "true"
+    mirror.linkerd.io/cluster-name: remote
+  annotations:
+    mirror.linkerd.io/remote-svc-name: web`
+
+	for _, tt := range []struct {
+		description string
+		configs     []string
+		deleted     bool
+	}{
+		{
+			description: "deletes an empty mirrored namespace",
+			configs:     []string{mirroredNamespace, mirroredService},
+			deleted:     true,
+		},
+		{
+			description: "keeps a mirrored namespace holding other services",
+			configs: []string{mirroredNamespace, mirroredService, `
+apiVersion: v1
+kind: Service
+metadata:
+  name: voting
+  namespace: emojivoto`,
+			},
+			deleted: false,
+		},
+		{
+			description: "keeps a mirrored namespace holding pods",
+			configs: []string{mirroredNamespace, mirroredService, `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: voting
+  namespace: emojivoto`,
+			},
+			deleted: false,
+		},
+		{
+			description: "keeps a namespace which was not created by the mirror",
+			configs: []string{`
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: emojivoto`, mirroredService},
+			deleted: false,
+		},
+	} {
+		tt := tt // pin
+		t.Run(tt.description, func(t *testing.T) {
+			stop := make(chan struct{})
+			defer close(stop)
+			rcw, localClient := newTestWatcher(t, stop, []string{}, tt.configs)
+
+			if err := rcw.reconcile("emojivoto/web"); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			_, err := localClient.CoreV1().Namespaces().Get("emojivoto", metav1.GetOptions{})
+			if tt.deleted && !kerrors.IsNotFound(err) {
+				t.Fatalf("Expected namespace to be deleted, got %v", err)
+			}
+			if !tt.deleted && err != nil {
+				t.Fatalf("Expected namespace to be kept: %s", err)
+			}
+		})
+	}
+}
@@ -28,6 +28,9 @@ func NewAPIServer(
 	cert tls.Certificate,
 	k8sAPI *k8s.API,
 	grpcTapServer tap.TapServer,
+	sessions *SessionLog,
+	controllerNamespace string,
+	replica string,
 	disableCommonNames bool,
 ) (*http.Server, net.Listener, error) {
 	clientCAPem, allowedNames, usernameHeader, groupHeader, err := apiServerAuth(k8sAPI)
@@ -45,11 +48,18 @@ func NewAPIServer(
 		"addr":      addr,
 	})
 
+	peers, err := newPeers(k8sAPI, controllerNamespace, replica, addr, cert)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	h := &handler{
 		k8sAPI:         k8sAPI,
 		usernameHeader: usernameHeader,
 		groupHeader:    groupHeader,
 		grpcTapServer:  grpcTapServer,
+		sessions:       sessions,
+		peers:          peers,
 		log:            log,
 	}
 
@@ -88,8 +98,10 @@ func NewAPIServer(
 func (a *apiServer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 	a.log.Debugf("ServeHTTP(): %+v", req)
 
-	// if `requestheader-allowed-names` was empty, allow any CN
-	if len(a.allowedNames) > 0 {
+	// if `requestheader-allowed-names` was empty, allow any CN; the requests of
+	// other tap replicas don't come from the Kubernetes API server and are
+	// authenticated by their handler instead
+	if len(a.allowedNames) > 0 && req.URL.Path != peerSessionsPath {
 		validCN := ""
 		clientNames := []string{}
 		for _, cn := range a.allowedNames {
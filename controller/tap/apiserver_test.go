@@ -47,9 +47,9 @@ data:
 				t.Fatalf("NewFakeAPI returned an error: %s", err)
 			}
 
-			fakeGrpcServer := newGRPCTapServer(4190, "controller-ns", "cluster.local", k8sAPI)
+			fakeGrpcServer := newGRPCTapServer(4190, "controller-ns", "cluster.local", 100, k8sAPI)
 
-			_, _, err = NewAPIServer("localhost:0", tls.Certificate{}, k8sAPI, fakeGrpcServer, false)
+			_, _, err = NewAPIServer("localhost:0", tls.Certificate{}, k8sAPI, fakeGrpcServer, nil, "linkerd", "linkerd-tap-0", false)
 			if !reflect.DeepEqual(err, exp.err) {
 				t.Errorf("NewAPIServer returned unexpected error: %s, expected: %s", err, exp.err)
 			}
@@ -0,0 +1,254 @@
+package tap
+
+import (
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/linkerd/linkerd2/controller/gen/public"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxPendingStreams bounds the number of requests per proxy whose events are
+// held back while waiting for their responses. Requests beyond it are dropped
+// rather than buffered.
+const maxPendingStreams = 10000
+
+type (
+	// eventFilter holds the matches that proxies can't evaluate, because they
+	// depend on the response or on request headers, and that the tap server
+	// applies to the events before streaming them.
+	eventFilter struct {
+		statuses     []*public.TapByResourceRequest_Match_Http_StatusRange
+		minLatency   time.Duration
+		grpcStatuses []uint32
+		headers      []*public.TapByResourceRequest_Match_Http_Header
+
+		// stripHeaders is set when headers were only extracted to evaluate
+		// the header matches, and the client didn't ask for them.
+		stripHeaders bool
+	}
+
+	// streamFilter applies an eventFilter to the events of a single proxy.
+	// Events are grouped by stream, and a request's events are only emitted
+	// once the whole request is known to match.
+	streamFilter struct {
+		*eventFilter
+		pending map[streamKey]*pendingStream
+	}
+
+	streamKey struct {
+		base   uint32
+		stream uint64
+	}
+
+	pendingStream struct {
+		events []*public.TapEvent
+		// responded is set once the stream's response headers matched.
+		responded bool
+	}
+)
+
+// makeEventFilter extracts the matches evaluated by the tap server from a
+// request's match. It returns nil if there are none.
+func makeEventFilter(match *public.TapByResourceRequest_Match) (*eventFilter, error) {
+	filter := &eventFilter{}
+	for _, reqMatch := range match.GetAll().GetMatches() {
+		switch typed := reqMatch.GetHttp().GetMatch().(type) {
+		case *public.TapByResourceRequest_Match_Http_Status:
+			if typed.Status.GetMin() > typed.Status.GetMax() {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid HTTP status range: %d-%d", typed.Status.GetMin(), typed.Status.GetMax())
+			}
+			filter.statuses = append(filter.statuses, typed.Status)
+		case *public.TapByResourceRequest_Match_Http_MinLatency:
+			latency, err := ptypes.Duration(typed.MinLatency)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid minimum latency: %s", err)
+			}
+			if latency > filter.minLatency {
+				filter.minLatency = latency
+			}
+		case *public.TapByResourceRequest_Match_Http_GrpcStatus:
+			filter.grpcStatuses = append(filter.grpcStatuses, typed.GrpcStatus)
+		case *public.TapByResourceRequest_Match_Http_Header_:
+			if typed.Header.GetName() == "" {
+				return nil, status.Error(codes.InvalidArgument, "header match is missing a header name")
+			}
+			filter.headers = append(filter.headers, typed.Header)
+		}
+	}
+
+	if len(filter.statuses) == 0 && filter.minLatency == 0 && len(filter.grpcStatuses) == 0 && len(filter.headers) == 0 {
+		return nil, nil
+	}
+	return filter, nil
+}
+
+// needsHeaders returns true if the proxies must extract request headers for
+// the filter to be evaluated.
+func (f *eventFilter) needsHeaders() bool {
+	return f != nil && len(f.headers) > 0
+}
+
+func (f *eventFilter) needsResponseInit() bool {
+	return len(f.statuses) > 0 || f.minLatency > 0
+}
+
+func (f *eventFilter) matchRequestInit(ev *public.TapEvent_Http_RequestInit) bool {
+	for _, match := range f.headers {
+		if !hasHeader(ev.GetHeaders(), match.GetName(), match.GetValue()) {
+			return false
+		}
+	}
+	return true
+}
+
+func (f *eventFilter) matchResponseInit(ev *public.TapEvent_Http_ResponseInit) bool {
+	for _, match := range f.statuses {
+		if ev.GetHttpStatus() < match.GetMin() || ev.GetHttpStatus() > match.GetMax() {
+			return false
+		}
+	}
+	if f.minLatency > 0 {
+		latency, err := ptypes.Duration(ev.GetSinceRequestInit())
+		if err != nil || latency < f.minLatency {
+			return false
+		}
+	}
+	return true
+}
+
+func (f *eventFilter) matchResponseEnd(ev *public.TapEvent_Http_ResponseEnd) bool {
+	if len(f.grpcStatuses) == 0 {
+		return true
+	}
+	end, ok := ev.GetEos().GetEnd().(*public.Eos_GrpcStatusCode)
+	if !ok {
+		return false
+	}
+	for _, code := range f.grpcStatuses {
+		if end.GrpcStatusCode != code {
+			return false
+		}
+	}
+	return true
+}
+
+func hasHeader(headers *public.Headers, name, value string) bool {
+	for _, header := range headers.GetHeaders() {
+		if !strings.EqualFold(header.GetName(), name) {
+			continue
+		}
+		switch v := header.GetValue().(type) {
+		case *public.Headers_Header_ValueStr:
+			if v.ValueStr == value {
+				return true
+			}
+		case *public.Headers_Header_ValueBin:
+			if string(v.ValueBin) == value {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// newStreamFilter returns a streamFilter for a single proxy, or nil if filter
+// is nil, in which case all events are passed through.
+func newStreamFilter(filter *eventFilter) *streamFilter {
+	if filter == nil {
+		return nil
+	}
+	return &streamFilter{filter, make(map[streamKey]*pendingStream)}
+}
+
+// process takes the next event reported by the proxy and returns the events
+// which are now known to match, in the order they were reported.
+func (f *streamFilter) process(ev *public.TapEvent) []*public.TapEvent {
+	if f == nil {
+		return []*public.TapEvent{ev}
+	}
+
+	switch e := ev.GetHttp().GetEvent().(type) {
+	case *public.TapEvent_Http_RequestInit_:
+		if !f.matchRequestInit(e.RequestInit) {
+			return nil
+		}
+		if len(f.pending) >= maxPendingStreams {
+			log.Debugf("dropping tap event: too many pending streams")
+			return nil
+		}
+		if f.stripHeaders {
+			ev = withoutHeaders(ev)
+		}
+		f.pending[newStreamKey(e.RequestInit.GetId())] = &pendingStream{events: []*public.TapEvent{ev}}
+		return nil
+
+	case *public.TapEvent_Http_ResponseInit_:
+		key := newStreamKey(e.ResponseInit.GetId())
+		stream, ok := f.pending[key]
+		if !ok {
+			return nil
+		}
+		if !f.matchResponseInit(e.ResponseInit) {
+			delete(f.pending, key)
+			return nil
+		}
+		if f.stripHeaders {
+			ev = withoutHeaders(ev)
+		}
+		stream.responded = true
+		stream.events = append(stream.events, ev)
+		if len(f.grpcStatuses) > 0 {
+			// The stream can only be matched once it ends.
+			return nil
+		}
+		events := stream.events
+		stream.events = nil
+		return events
+
+	case *public.TapEvent_Http_ResponseEnd_:
+		key := newStreamKey(e.ResponseEnd.GetId())
+		stream, ok := f.pending[key]
+		if !ok {
+			return nil
+		}
+		delete(f.pending, key)
+		if !stream.responded && f.needsResponseInit() {
+			// The stream was reset before the response headers were received.
+			return nil
+		}
+		if !f.matchResponseEnd(e.ResponseEnd) {
+			return nil
+		}
+		if f.stripHeaders {
+			ev = withoutHeaders(ev)
+		}
+		return append(stream.events, ev)
+	}
+
+	return []*public.TapEvent{ev}
+}
+
+// withoutHeaders returns a copy of ev without its headers or trailers. Events
+// are shared by all the clients of a proxy stream, so they're never modified
+// in place.
+func withoutHeaders(ev *public.TapEvent) *public.TapEvent {
+	ev = proto.Clone(ev).(*public.TapEvent)
+	switch e := ev.GetHttp().GetEvent().(type) {
+	case *public.TapEvent_Http_RequestInit_:
+		e.RequestInit.Headers = nil
+	case *public.TapEvent_Http_ResponseInit_:
+		e.ResponseInit.Headers = nil
+	case *public.TapEvent_Http_ResponseEnd_:
+		e.ResponseEnd.Trailers = nil
+	}
+	return ev
+}
+
+func newStreamKey(id *public.TapEvent_Http_StreamId) streamKey {
+	return streamKey{id.GetBase(), id.GetStream()}
+}
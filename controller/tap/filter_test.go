@@ -0,0 +1,224 @@
+package tap
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/linkerd/linkerd2/controller/gen/public"
+)
+
+func httpMatch(match *public.TapByResourceRequest_Match_Http) *public.TapByResourceRequest_Match {
+	return &public.TapByResourceRequest_Match{
+		Match: &public.TapByResourceRequest_Match_Http_{Http: match},
+	}
+}
+
+func allMatches(matches ...*public.TapByResourceRequest_Match) *public.TapByResourceRequest_Match {
+	return &public.TapByResourceRequest_Match{
+		Match: &public.TapByResourceRequest_Match_All{
+			All: &public.TapByResourceRequest_Match_Seq{Matches: matches},
+		},
+	}
+}
+
+func statusMatch(min, max uint32) *public.TapByResourceRequest_Match {
+	return httpMatch(&public.TapByResourceRequest_Match_Http{
+		Match: &public.TapByResourceRequest_Match_Http_Status{
+			Status: &public.TapByResourceRequest_Match_Http_StatusRange{Min: min, Max: max},
+		},
+	})
+}
+
+func requestInit(stream uint64, headers map[string]string) *public.TapEvent {
+	var hs []*public.Headers_Header
+	for name, value := range headers {
+		hs = append(hs, &public.Headers_Header{
+			Name:  name,
+			Value: &public.Headers_Header_ValueStr{ValueStr: value},
+		})
+	}
+	return &public.TapEvent{
+		Event: &public.TapEvent_Http_{Http: &public.TapEvent_Http{
+			Event: &public.TapEvent_Http_RequestInit_{RequestInit: &public.TapEvent_Http_RequestInit{
+				Id:      &public.TapEvent_Http_StreamId{Base: 1, Stream: stream},
+				Headers: &public.Headers{Headers: hs},
+			}},
+		}},
+	}
+}
+
+func responseInit(stream uint64, httpStatus uint32, latency time.Duration) *public.TapEvent {
+	return &public.TapEvent{
+		Event: &public.TapEvent_Http_{Http: &public.TapEvent_Http{
+			Event: &public.TapEvent_Http_ResponseInit_{ResponseInit: &public.TapEvent_Http_ResponseInit{
+				Id:               &public.TapEvent_Http_StreamId{Base: 1, Stream: stream},
+				HttpStatus:       httpStatus,
+				SinceRequestInit: ptypes.DurationProto(latency),
+			}},
+		}},
+	}
+}
+
+func responseEnd(stream uint64, grpcStatus uint32) *public.TapEvent {
+	return &public.TapEvent{
+		Event: &public.TapEvent_Http_{Http: &public.TapEvent_Http{
+			Event: &public.TapEvent_Http_ResponseEnd_{ResponseEnd: &public.TapEvent_Http_ResponseEnd{
+				Id:  &public.TapEvent_Http_StreamId{Base: 1, Stream: stream},
+				Eos: &public.Eos{End: &public.Eos_GrpcStatusCode{GrpcStatusCode: grpcStatus}},
+			}},
+		}},
+	}
+}
+
+func TestMakeEventFilter(t *testing.T) {
+	t.Run("returns nil without server-side matches", func(t *testing.T) {
+		filter, err := makeEventFilter(allMatches(httpMatch(&public.TapByResourceRequest_Match_Http{
+			Match: &public.TapByResourceRequest_Match_Http_Path{Path: "/"},
+		})))
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if filter != nil {
+			t.Fatalf("Expected no filter, got %+v", filter)
+		}
+	})
+
+	t.Run("rejects empty status ranges", func(t *testing.T) {
+		expected := "rpc error: code = InvalidArgument desc = invalid HTTP status range: 599-500"
+		_, err := makeEventFilter(allMatches(statusMatch(599, 500)))
+		if err == nil || err.Error() != expected {
+			t.Fatalf("Expected error %q, got %v", expected, err)
+		}
+	})
+}
+
+func TestStreamFilter(t *testing.T) {
+	testCases := []struct {
+		name     string
+		match    *public.TapByResourceRequest_Match
+		events   []*public.TapEvent
+		expected int
+	}{
+		{
+			name:  "status in range",
+			match: statusMatch(500, 599),
+			events: []*public.TapEvent{
+				requestInit(1, nil),
+				responseInit(1, 503, time.Millisecond),
+				responseEnd(1, 0),
+			},
+			expected: 3,
+		},
+		{
+			name:  "status out of range",
+			match: statusMatch(500, 599),
+			events: []*public.TapEvent{
+				requestInit(1, nil),
+				responseInit(1, 200, time.Millisecond),
+				responseEnd(1, 0),
+			},
+			expected: 0,
+		},
+		{
+			name: "latency above minimum",
+			match: httpMatch(&public.TapByResourceRequest_Match_Http{
+				Match: &public.TapByResourceRequest_Match_Http_MinLatency{MinLatency: ptypes.DurationProto(time.Second)},
+			}),
+			events: []*public.TapEvent{
+				requestInit(1, nil),
+				responseInit(1, 200, 2*time.Second),
+				requestInit(2, nil),
+				responseInit(2, 200, time.Millisecond),
+				responseEnd(2, 0),
+				responseEnd(1, 0),
+			},
+			expected: 3,
+		},
+		{
+			name: "gRPC status",
+			match: httpMatch(&public.TapByResourceRequest_Match_Http{
+				Match: &public.TapByResourceRequest_Match_Http_GrpcStatus{GrpcStatus: 14},
+			}),
+			events: []*public.TapEvent{
+				requestInit(1, nil),
+				responseInit(1, 200, time.Millisecond),
+				requestInit(2, nil),
+				responseInit(2, 200, time.Millisecond),
+				responseEnd(1, 0),
+				responseEnd(2, 14),
+			},
+			expected: 3,
+		},
+		{
+			name: "header value",
+			match: httpMatch(&public.TapByResourceRequest_Match_Http{
+				Match: &public.TapByResourceRequest_Match_Http_Header_{
+					Header: &public.TapByResourceRequest_Match_Http_Header{Name: "X-Tenant", Value: "blue"},
+				},
+			}),
+			events: []*public.TapEvent{
+				requestInit(1, map[string]string{"x-tenant": "blue"}),
+				requestInit(2, map[string]string{"x-tenant": "green"}),
+				responseInit(1, 200, time.Millisecond),
+				responseInit(2, 200, time.Millisecond),
+				responseEnd(1, 0),
+				responseEnd(2, 0),
+			},
+			expected: 3,
+		},
+		{
+			name:  "stream reset before response",
+			match: statusMatch(500, 599),
+			events: []*public.TapEvent{
+				requestInit(1, nil),
+				responseEnd(1, 0),
+			},
+			expected: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.name, func(t *testing.T) {
+			filter, err := makeEventFilter(allMatches(tc.match))
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			streams := newStreamFilter(filter)
+
+			var emitted []*public.TapEvent
+			for _, ev := range tc.events {
+				emitted = append(emitted, streams.process(ev)...)
+			}
+			if len(emitted) != tc.expected {
+				t.Fatalf("Expected %d events, got %d: %v", tc.expected, len(emitted), emitted)
+			}
+			if len(streams.pending) != 0 {
+				t.Fatalf("Expected no pending streams, got %d", len(streams.pending))
+			}
+		})
+	}
+}
+
+func TestStreamFilterStripsHeaders(t *testing.T) {
+	filter, err := makeEventFilter(allMatches(httpMatch(&public.TapByResourceRequest_Match_Http{
+		Match: &public.TapByResourceRequest_Match_Http_Header_{
+			Header: &public.TapByResourceRequest_Match_Http_Header{Name: "x-tenant", Value: "blue"},
+		},
+	})))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	filter.stripHeaders = true
+	streams := newStreamFilter(filter)
+
+	streams.process(requestInit(1, map[string]string{"x-tenant": "blue"}))
+	emitted := streams.process(responseInit(1, 200, time.Millisecond))
+	if len(emitted) != 2 {
+		t.Fatalf("Expected 2 events, got %d", len(emitted))
+	}
+	if headers := emitted[0].GetHttp().GetRequestInit().GetHeaders(); headers != nil {
+		t.Fatalf("Expected headers to be stripped, got %v", headers)
+	}
+}
@@ -28,6 +28,8 @@ type handler struct {
 	usernameHeader string
 	groupHeader    string
 	grpcTapServer  pb.TapServer
+	sessions       *SessionLog
+	peers          *peers
 	log            *logrus.Entry
 }
 
@@ -85,6 +87,8 @@ func initRouter(h *handler) *httprouter.Router {
 	router.GET("/metrics", handleMetrics)
 	router.GET("/openapi/v2", handleOpenAPI)
 	router.GET("/version", handleVersion)
+	router.GET(tap.SessionsPath, h.handleSessions)
+	router.GET(peerSessionsPath, h.handlePeerSessions)
 	router.NotFound = handleNotFound()
 
 	for _, res := range resources {
@@ -142,6 +146,7 @@ func (h *handler) handleTap(w http.ResponseWriter, req *http.Request, p httprout
 	if err != nil {
 		err = fmt.Errorf("tap authorization failed (%s), visit %s for more information", err, tap.TapRbacURL)
 		h.log.Error(err)
+		h.sessions.denied(req.Header.Get(h.usernameHeader), req.Header[h.groupHeader], namespace, resource, name, err)
 		renderJSONError(w, err, http.StatusForbidden)
 		return
 	}
@@ -170,8 +175,10 @@ func (h *handler) handleTap(w http.ResponseWriter, req *http.Request, p httprout
 		return
 	}
 
-	serverStream := serverStream{w: flushableWriter, req: req, log: h.log}
+	session := h.sessions.start(req.Header.Get(h.usernameHeader), req.Header[h.groupHeader], &tapReq)
+	serverStream := serverStream{w: flushableWriter, req: req, session: session, log: h.log}
 	err = h.grpcTapServer.TapByResource(&tapReq, &serverStream)
+	h.sessions.end(session, err)
 	if err != nil {
 		h.log.Error(err)
 		protohttp.WriteErrorToHTTPResponse(flushableWriter, err)
@@ -179,6 +186,64 @@ func (h *handler) handleTap(w http.ResponseWriter, req *http.Request, p httprout
 	}
 }
 
+// GET /apis/tap.linkerd.io/v1alpha1/sessions
+func (h *handler) handleSessions(w http.ResponseWriter, req *http.Request, _ httprouter.Params) {
+	err := pkgK8s.ResourceAuthzForUser(
+		h.k8sAPI.Client,
+		"",
+		"list",
+		gvk.Group,
+		gvk.Version,
+		"sessions",
+		"",
+		"",
+		req.Header.Get(h.usernameHeader),
+		req.Header[h.groupHeader],
+	)
+	if err != nil {
+		err = fmt.Errorf("tap sessions authorization failed (%s), visit %s for more information", err, tap.TapRbacURL)
+		h.log.Error(err)
+		renderJSONError(w, err, http.StatusForbidden)
+		return
+	}
+
+	sessions, err := h.allSessions()
+	if err != nil {
+		h.log.Error(err)
+		renderJSONError(w, err, http.StatusInternalServerError)
+		return
+	}
+	renderJSON(w, sessions, http.StatusOK)
+}
+
+// GET /peers/sessions
+func (h *handler) handlePeerSessions(w http.ResponseWriter, req *http.Request, _ httprouter.Params) {
+	if err := h.peers.authenticate(req); err != nil {
+		h.log.Error(err)
+		renderJSONError(w, err, http.StatusUnauthorized)
+		return
+	}
+
+	renderJSON(w, h.sessions.Sessions(), http.StatusOK)
+}
+
+// allSessions returns the sessions of all the tap replicas, most recent
+// first: from the events they recorded if they record them, and otherwise
+// from the memory of each replica.
+func (h *handler) allSessions() ([]tap.Session, error) {
+	if h.sessions.recordsEvents() {
+		return h.sessions.eventSessions()
+	}
+
+	peerSessions, err := h.peers.sessions()
+	if err != nil {
+		return nil, err
+	}
+	sessions := append(h.sessions.Sessions(), peerSessions...)
+	sortSessions(sessions)
+	return sessions, nil
+}
+
 // GET (not found)
 func handleNotFound() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
@@ -268,6 +333,13 @@ func handleAPIResourceList(w http.ResponseWriter, _ *http.Request, _ httprouter.
 				Verbs:      metav1.Verbs{"watch"},
 			})
 	}
+	resList.APIResources = append(resList.APIResources,
+		metav1.APIResource{
+			Name:       "sessions",
+			Namespaced: false,
+			Kind:       "TapSession",
+			Verbs:      metav1.Verbs{"list"},
+		})
 
 	renderJSON(w, resList, http.StatusOK)
 }
@@ -369,9 +441,10 @@ func renderJSONError(w http.ResponseWriter, err error, status int) {
 // TODO: Share this code with streamServer and destinationServer in
 // http_server.go.
 type serverStream struct {
-	w   protohttp.FlushableResponseWriter
-	req *http.Request
-	log *logrus.Entry
+	w       protohttp.FlushableResponseWriter
+	req     *http.Request
+	session *auditedSession
+	log     *logrus.Entry
 }
 
 // Satisfy the grpc.ServerStream interface
@@ -392,5 +465,6 @@ func (s *serverStream) Send(m *public.TapEvent) error {
 	}
 
 	s.w.Flush()
+	s.session.delivered()
 	return nil
 }
@@ -11,6 +11,10 @@ import (
 	"github.com/julienschmidt/httprouter"
 	"github.com/linkerd/linkerd2/controller/k8s"
 	"github.com/sirupsen/logrus"
+	authV1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 )
 
 func TestHandleTap(t *testing.T) {
@@ -20,6 +24,7 @@ func TestHandleTap(t *testing.T) {
 		code   int
 		header http.Header
 		body   string
+		denied int
 	}{
 		{
 			req: &http.Request{
@@ -40,6 +45,7 @@ func TestHandleTap(t *testing.T) {
 			code:   http.StatusForbidden,
 			header: http.Header{"Content-Type": []string{"application/json"}},
 			body:   `{"error":"tap authorization failed (not authorized to access namespaces.tap.linkerd.io), visit https://linkerd.io/tap-rbac for more information"}`,
+			denied: 1,
 		},
 	}
 
@@ -53,8 +59,9 @@ func TestHandleTap(t *testing.T) {
 			}
 
 			h := &handler{
-				k8sAPI: k8sAPI,
-				log:    logrus.WithField("test", t.Name),
+				k8sAPI:   k8sAPI,
+				sessions: NewSessionLog(10, "linkerd-tap-0", k8sAPI, nil),
+				log:      logrus.WithField("test", t.Name),
 			}
 			recorder := httptest.NewRecorder()
 			h.handleTap(recorder, exp.req, exp.params)
@@ -68,6 +75,60 @@ func TestHandleTap(t *testing.T) {
 			if recorder.Body.String() != exp.body {
 				t.Errorf("Unexpected body: %s, expected: %s", recorder.Body.String(), exp.body)
 			}
+			if denied := len(h.sessions.Sessions()); denied != exp.denied {
+				t.Errorf("Unexpected denied sessions: %d, expected: %d", denied, exp.denied)
+			}
+		})
+	}
+}
+
+func TestHandleSessions(t *testing.T) {
+	expectations := []struct {
+		allowed bool
+		code    int
+		body    string
+	}{
+		{
+			allowed: false,
+			code:    http.StatusForbidden,
+			body:    `{"error":"tap sessions authorization failed (not authorized to access sessions.tap.linkerd.io), visit https://linkerd.io/tap-rbac for more information"}`,
+		},
+		{
+			allowed: true,
+			code:    http.StatusOK,
+			body:    "[]",
+		},
+	}
+
+	for i, exp := range expectations {
+		exp := exp // pin
+
+		t.Run(fmt.Sprintf("%d list tap sessions", i), func(t *testing.T) {
+			k8sAPI, err := k8s.NewFakeAPI()
+			if err != nil {
+				t.Fatalf("NewFakeAPI returned an error: %s", err)
+			}
+			k8sAPI.Client.(*fake.Clientset).PrependReactor("create", "subjectaccessreviews",
+				func(action k8stesting.Action) (bool, runtime.Object, error) {
+					sar := action.(k8stesting.CreateAction).GetObject().(*authV1.SubjectAccessReview)
+					sar.Status.Allowed = exp.allowed && sar.Spec.ResourceAttributes.Verb == "list"
+					return true, sar, nil
+				})
+
+			h := &handler{
+				k8sAPI:   k8sAPI,
+				sessions: NewSessionLog(10, "linkerd-tap-0", k8sAPI, nil),
+				log:      logrus.WithField("test", t.Name),
+			}
+			recorder := httptest.NewRecorder()
+			h.handleSessions(recorder, &http.Request{Header: http.Header{}}, httprouter.Params{})
+
+			if recorder.Code != exp.code {
+				t.Errorf("Unexpected code: %d, expected: %d", recorder.Code, exp.code)
+			}
+			if body := recorder.Body.String(); body != exp.body {
+				t.Errorf("Unexpected body: %s, expected: %s", body, exp.body)
+			}
 		})
 	}
 }
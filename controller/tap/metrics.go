@@ -0,0 +1,23 @@
+package tap
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	proxyStreamsGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "tap_proxy_streams",
+		Help: "The number of tap streams open to proxies, each shared by the clients tapping the proxy alike.",
+	})
+
+	clientsGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "tap_clients",
+		Help: "The number of clients currently tapping.",
+	})
+
+	eventsDroppedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "tap_events_dropped_total",
+		Help: "A counter for the number of tap events dropped because the client they were sent to was too slow to read them.",
+	})
+)
@@ -0,0 +1,165 @@
+package tap
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/linkerd/linkerd2/controller/k8s"
+	pkgK8s "github.com/linkerd/linkerd2/pkg/k8s"
+	pkgTap "github.com/linkerd/linkerd2/pkg/tap"
+	authnV1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	// peerSessionsPath is the tap APIServer path through which the replicas
+	// list each other's sessions. These requests don't come from the
+	// Kubernetes API server, so instead of its client certificate they bear
+	// the ServiceAccount token of the calling replica.
+	peerSessionsPath = "/peers/sessions"
+
+	tapServiceAccount = "linkerd-tap"
+	peerTimeout       = 5 * time.Second
+)
+
+// peers lists the sessions of the other tap replicas.
+type peers struct {
+	k8sAPI    *k8s.API
+	namespace string
+	replica   string
+	port      string
+	username  string
+	token     func() ([]byte, error)
+	client    *http.Client
+}
+
+// newPeers creates peers for the tap replica of the controller namespace
+// serving the APIServer on addr with cert.
+func newPeers(k8sAPI *k8s.API, namespace, replica, addr string, cert tls.Certificate) (*peers, error) {
+	_, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	// The replicas are reached by their IP, which their certificate doesn't
+	// name, so they are verified by serving the same certificate as this one.
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: true,
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			if len(rawCerts) == 0 || len(cert.Certificate) == 0 || !bytes.Equal(rawCerts[0], cert.Certificate[0]) {
+				return errors.New("peer doesn't serve the tap certificate")
+			}
+			return nil
+		},
+	}
+
+	return &peers{
+		k8sAPI:    k8sAPI,
+		namespace: namespace,
+		replica:   replica,
+		port:      port,
+		username:  fmt.Sprintf("system:serviceaccount:%s:%s", namespace, tapServiceAccount),
+		token: func() ([]byte, error) {
+			return ioutil.ReadFile(pkgK8s.IdentityServiceAccountTokenPath)
+		},
+		client: &http.Client{
+			Timeout:   peerTimeout,
+			Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		},
+	}, nil
+}
+
+// sessions returns the sessions of all the running tap replicas but this
+// one. It fails if any of them can't be reached, rather than returning an
+// incomplete list.
+func (p *peers) sessions() ([]pkgTap.Session, error) {
+	if p == nil {
+		return []pkgTap.Session{}, nil
+	}
+
+	selector := labels.Set{pkgK8s.ControllerComponentLabel: "tap"}.AsSelector()
+	pods, err := p.k8sAPI.Pod().Lister().Pods(p.namespace).List(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := p.token()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the tap ServiceAccount token: %s", err)
+	}
+
+	sessions := []pkgTap.Session{}
+	for _, pod := range pods {
+		if pod.Name == p.replica || pod.Status.Phase != corev1.PodRunning || pod.Status.PodIP == "" {
+			continue
+		}
+		podSessions, err := p.podSessions(pod, token)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list the sessions of tap replica %s: %s", pod.Name, err)
+		}
+		sessions = append(sessions, podSessions...)
+	}
+	return sessions, nil
+}
+
+func (p *peers) podSessions(pod *corev1.Pod, token []byte) ([]pkgTap.Session, error) {
+	url := fmt.Sprintf("https://%s%s", net.JoinHostPort(pod.Status.PodIP, p.port), peerSessionsPath)
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+strings.TrimSpace(string(token)))
+
+	rsp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer rsp.Body.Close()
+
+	if rsp.StatusCode != http.StatusOK {
+		var jsonErr jsonError
+		if err := json.NewDecoder(rsp.Body).Decode(&jsonErr); err != nil || jsonErr.Error == "" {
+			return nil, fmt.Errorf("unexpected status: %s", rsp.Status)
+		}
+		return nil, errors.New(jsonErr.Error)
+	}
+
+	var sessions []pkgTap.Session
+	if err := json.NewDecoder(rsp.Body).Decode(&sessions); err != nil {
+		return nil, err
+	}
+	return sessions, nil
+}
+
+// authenticate checks that req bears the ServiceAccount token of a tap
+// replica.
+func (p *peers) authenticate(req *http.Request) error {
+	token := strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer ")
+	if p == nil || token == "" {
+		return errors.New("tap peer requests must bear the tap ServiceAccount token")
+	}
+
+	review, err := p.k8sAPI.Client.AuthenticationV1().TokenReviews().Create(&authnV1.TokenReview{
+		Spec: authnV1.TokenReviewSpec{Token: token},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to review the tap peer token: %s", err)
+	}
+	if !review.Status.Authenticated {
+		return fmt.Errorf("tap peer token not authenticated: %s", review.Status.Error)
+	}
+	if review.Status.User.Username != p.username {
+		return fmt.Errorf("tap peer requests must come from %s, not %s", p.username, review.Status.User.Username)
+	}
+	return nil
+}
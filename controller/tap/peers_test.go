@@ -0,0 +1,151 @@
+package tap
+
+import (
+	"crypto/tls"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/linkerd/linkerd2/controller/k8s"
+	"github.com/sirupsen/logrus"
+	authnV1 "k8s.io/api/authentication/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestAllSessionsFromPeers(t *testing.T) {
+	expectations := []struct {
+		name     string
+		username string
+		cert     func(peer tls.Certificate) tls.Certificate
+		ids      []string
+		err      string
+	}{
+		{
+			name:     "sessions of all replicas",
+			username: "system:serviceaccount:linkerd:linkerd-tap",
+			ids:      []string{"linkerd-tap-1-1", "linkerd-tap-0-1"},
+		},
+		{
+			name:     "peer requests from another service account",
+			username: "system:serviceaccount:linkerd:linkerd-web",
+			err:      "failed to list the sessions of tap replica linkerd-tap-1: tap peer requests must come from system:serviceaccount:linkerd:linkerd-tap, not system:serviceaccount:linkerd:linkerd-web",
+		},
+		{
+			name:     "peer serving another certificate",
+			username: "system:serviceaccount:linkerd:linkerd-tap",
+			cert:     func(tls.Certificate) tls.Certificate { return tls.Certificate{Certificate: [][]byte{[]byte("other")}} },
+			err:      "peer doesn't serve the tap certificate",
+		},
+	}
+
+	for _, exp := range expectations {
+		exp := exp // pin
+
+		t.Run(exp.name, func(t *testing.T) {
+			k8sAPI, err := k8s.NewFakeAPI(`
+apiVersion: v1
+kind: Pod
+metadata:
+  name: linkerd-tap-0
+  namespace: linkerd
+  labels:
+    linkerd.io/control-plane-component: tap
+status:
+  phase: Running
+  podIP: 10.1.1.1
+`, `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: linkerd-tap-1
+  namespace: linkerd
+  labels:
+    linkerd.io/control-plane-component: tap
+status:
+  phase: Running
+  podIP: 127.0.0.1
+`, `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: linkerd-tap-2
+  namespace: linkerd
+  labels:
+    linkerd.io/control-plane-component: tap
+status:
+  phase: Pending
+`)
+			if err != nil {
+				t.Fatalf("NewFakeAPI returned an error: %s", err)
+			}
+			k8sAPI.Client.(*fake.Clientset).PrependReactor("create", "tokenreviews",
+				func(action k8stesting.Action) (bool, runtime.Object, error) {
+					review := action.(k8stesting.CreateAction).GetObject().(*authnV1.TokenReview)
+					review.Status.Authenticated = review.Spec.Token == "tap-token"
+					review.Status.User.Username = exp.username
+					return true, review, nil
+				})
+			k8sAPI.Sync()
+
+			startedAt := time.Date(2019, 11, 5, 10, 0, 0, 0, time.UTC)
+			newHandler := func(replica string) *handler {
+				sessions := NewSessionLog(10, replica, k8sAPI, nil)
+				sessions.now = func() time.Time { return startedAt }
+				sessions.start("alice", nil, tapRequest("emojivoto", "deployment", replica))
+				startedAt = startedAt.Add(time.Minute)
+				return &handler{
+					k8sAPI:   k8sAPI,
+					sessions: sessions,
+					log:      logrus.WithField("test", t.Name()),
+				}
+			}
+
+			local := newHandler("linkerd-tap-0")
+			peer := newHandler("linkerd-tap-1")
+			peer.peers, err = newPeers(k8sAPI, "linkerd", "linkerd-tap-1", ":8089", tls.Certificate{})
+			if err != nil {
+				t.Fatalf("newPeers returned an error: %s", err)
+			}
+			srv := httptest.NewTLSServer(initRouter(peer))
+			defer srv.Close()
+
+			srvURL, err := url.Parse(srv.URL)
+			if err != nil {
+				t.Fatalf("Failed to parse the server URL: %s", err)
+			}
+			cert := srv.TLS.Certificates[0]
+			if exp.cert != nil {
+				cert = exp.cert(cert)
+			}
+			local.peers, err = newPeers(k8sAPI, "linkerd", "linkerd-tap-0", srvURL.Host, cert)
+			if err != nil {
+				t.Fatalf("newPeers returned an error: %s", err)
+			}
+			local.peers.token = func() ([]byte, error) { return []byte("tap-token\n"), nil }
+
+			sessions, err := local.allSessions()
+			if exp.err != "" {
+				if err == nil || !strings.Contains(err.Error(), exp.err) {
+					t.Fatalf("Expected error %q, got %v", exp.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("allSessions returned an error: %s", err)
+			}
+
+			var ids []string
+			for _, session := range sessions {
+				ids = append(ids, session.ID)
+			}
+			if !reflect.DeepEqual(ids, exp.ids) {
+				t.Fatalf("Expected sessions %v, got %v", exp.ids, ids)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"sort"
 	"time"
 	"unicode/utf8"
 
@@ -20,7 +21,6 @@ import (
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -37,6 +37,8 @@ type GRPCTapServer struct {
 	k8sAPI              *k8s.API
 	controllerNamespace string
 	trustDomain         string
+	clientBufferSize    int
+	streams             *proxyStreams
 }
 
 var (
@@ -100,8 +102,6 @@ func (s *GRPCTapServer) TapByResource(req *public.TapByResourceRequest, stream p
 
 	log.Infof("Tapping %d pods for target: %+v", len(pods), *res)
 
-	events := make(chan *public.TapEvent)
-
 	// divide the rps evenly between all pods to tap
 	rpsPerPod := req.GetMaxRps() / float32(len(pods))
 	if rpsPerPod < 1 {
@@ -113,6 +113,11 @@ func (s *GRPCTapServer) TapByResource(req *public.TapByResourceRequest, stream p
 		return apiUtil.GRPCError(err)
 	}
 
+	filter, err := makeEventFilter(req.GetMatch())
+	if err != nil {
+		return err
+	}
+
 	extract := &proxy.ObserveRequest_Extract{}
 
 	// HTTP is the only protocol supported for extracting metadata, so this is
@@ -120,8 +125,37 @@ func (s *GRPCTapServer) TapByResource(req *public.TapByResourceRequest, stream p
 	extractHTTP := req.GetExtract().GetHttp()
 	if extractHTTP != nil {
 		extract = buildExtractHTTP(extractHTTP)
+	} else if filter.needsHeaders() {
+		// Header matches are evaluated here, so the proxies must report the
+		// headers even though the client didn't ask for them.
+		extract = buildExtractHTTP(&public.TapByResourceRequest_Extract_Http{
+			Extract: &public.TapByResourceRequest_Extract_Http_Headers_{
+				Headers: &public.TapByResourceRequest_Extract_Http_Headers{},
+			},
+		})
+		filter.stripHeaders = true
 	}
 
+	observeReq := &proxy.ObserveRequest{
+		Limit:   uint32(rpsPerPod * float32(tapInterval.Seconds())),
+		Match:   match,
+		Extract: extract,
+	}
+
+	client := newTapClient(s.clientBufferSize, filter)
+	clientsGauge.Inc()
+	defer clientsGauge.Dec()
+
+	subscribed := make([]*proxyStream, 0, len(pods))
+	defer func() {
+		for _, proxyStream := range subscribed {
+			s.streams.unsubscribe(proxyStream, client)
+		}
+		if dropped := client.droppedEvents(); dropped > 0 {
+			log.Infof("Dropped %d events for a slow client tapping target: %+v", dropped, *res)
+		}
+	}()
+
 	for _, pod := range pods {
 		// create the expected pod identity from the pod spec
 		ns := res.GetNamespace()
@@ -129,14 +163,11 @@ func (s *GRPCTapServer) TapByResource(req *public.TapByResourceRequest, stream p
 			ns = res.GetName()
 		}
 		name := fmt.Sprintf("%s.%s.serviceaccount.identity.%s.%s", pod.Spec.ServiceAccountName, ns, s.controllerNamespace, s.trustDomain)
-		log.Debugf("initiating tap request to %s with required name %s", pod.Spec.ServiceAccountName, name)
-
-		// pass the header metadata into the request context
-		ctx := stream.Context()
-		ctx = metadata.AppendToOutgoingContext(ctx, requireIDHeader, name)
+		log.Debugf("subscribing to tap on %s with required name %s", pod.Spec.ServiceAccountName, name)
 
-		// initiate a tap on the pod
-		go s.tapProxy(ctx, rpsPerPod, match, extract, pod.Status.PodIP, events)
+		// tap the pod, sharing the proxy stream with the other clients
+		// tapping it alike
+		subscribed = append(subscribed, s.streams.subscribe(pod.Status.PodIP, name, observeReq, client, s.tapProxy))
 	}
 
 	// read events from the taps and send them back
@@ -144,7 +175,7 @@ func (s *GRPCTapServer) TapByResource(req *public.TapByResourceRequest, stream p
 		select {
 		case <-stream.Context().Done():
 			return nil
-		case event := <-events:
+		case event := <-client.events:
 			err := stream.Send(event)
 			if err != nil {
 				return apiUtil.GRPCError(err)
@@ -166,12 +197,20 @@ func makeByResourceMatch(match *public.TapByResourceRequest_Match) (*proxy.Obser
 		switch typed := reqMatch.Match.(type) {
 		case *public.TapByResourceRequest_Match_Destinations:
 
-			for k, v := range destinationLabels(typed.Destinations.Resource) {
+			// Sorted so that equal requests have equal matches, and share
+			// their proxy streams.
+			labels := destinationLabels(typed.Destinations.Resource)
+			keys := make([]string, 0, len(labels))
+			for k := range labels {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			for _, k := range keys {
 				matches = append(matches, &proxy.ObserveRequest_Match{
 					Match: &proxy.ObserveRequest_Match_DestinationLabel{
 						DestinationLabel: &proxy.ObserveRequest_Match_Label{
 							Key:   k,
-							Value: v,
+							Value: labels[k],
 						},
 					},
 				})
@@ -214,6 +253,12 @@ func makeByResourceMatch(match *public.TapByResourceRequest_Match) (*proxy.Obser
 						},
 					},
 				}
+			case *public.TapByResourceRequest_Match_Http_Status,
+				*public.TapByResourceRequest_Match_Http_MinLatency,
+				*public.TapByResourceRequest_Match_Http_GrpcStatus,
+				*public.TapByResourceRequest_Match_Http_Header_:
+				// Evaluated by the tap server, see makeEventFilter.
+				continue
 			default:
 				return nil, status.Errorf(codes.Unimplemented, "unknown HTTP match type: %v", httpTyped)
 			}
@@ -268,13 +313,17 @@ func buildExtractHTTP(extract *public.TapByResourceRequest_Extract_Http) *proxy.
 
 // Tap a pod.
 // This method will run continuously until an error is encountered or the
-// request is cancelled via the context.  Thus it should be called as a
-// go-routine.
+// stream is closed via the context, once all its clients are gone. Thus it
+// should be called as a go-routine.
 // To limit the rps to maxRps, this method calls Observe on the pod with a limit
 // of maxRps * 1s at most once per 1s window.  If this limit is reached in
 // less than 1s, we sleep until the end of the window before calling Observe
 // again.
-func (s *GRPCTapServer) tapProxy(ctx context.Context, maxRps float32, match *proxy.ObserveRequest_Match, extract *proxy.ObserveRequest_Extract, addr string, events chan *public.TapEvent) {
+// Events are then published to the stream's clients, through their filters,
+// so the limit applies to the requests observed rather than to the requests
+// streamed back.
+func (s *GRPCTapServer) tapProxy(ctx context.Context, stream *proxyStream) {
+	addr := stream.addr
 	tapAddr := fmt.Sprintf("%s:%d", addr, s.tapPort)
 	log.Infof("Establishing tap on %s", tapAddr)
 	conn, err := grpc.DialContext(ctx, tapAddr, grpc.WithInsecure())
@@ -285,18 +334,14 @@ func (s *GRPCTapServer) tapProxy(ctx context.Context, maxRps float32, match *pro
 	client := proxy.NewTapClient(conn)
 	defer conn.Close()
 
-	req := &proxy.ObserveRequest{
-		Limit:   uint32(maxRps * float32(tapInterval.Seconds())),
-		Match:   match,
-		Extract: extract,
-	}
-
 	for { // Request loop
 		windowStart := time.Now()
 		windowEnd := windowStart.Add(tapInterval)
-		rsp, err := client.Observe(ctx, req)
+		rsp, err := client.Observe(ctx, stream.req)
 		if err != nil {
-			log.Error(err)
+			if ctx.Err() == nil {
+				log.Error(err)
+			}
 			return
 		}
 		for { // Stream loop
@@ -306,22 +351,21 @@ func (s *GRPCTapServer) tapProxy(ctx context.Context, maxRps float32, match *pro
 				break
 			}
 			if err != nil {
-				log.Errorf("[%s] encountered an error: %s", addr, err)
+				if ctx.Err() != nil {
+					log.Debugf("[%s] clients terminated the stream", addr)
+				} else {
+					log.Errorf("[%s] encountered an error: %s", addr, err)
+				}
 				return
 			}
 
-			translatedEvent := s.translateEvent(event)
-
-			select {
-			case <-ctx.Done():
-				log.Debugf("[%s] client terminated the stream", addr)
-				return
-			default:
-				events <- translatedEvent
-			}
+			stream.publish(s.translateEvent(event))
 		}
-		if time.Now().Before(windowEnd) {
-			time.Sleep(time.Until(windowEnd))
+		select {
+		case <-ctx.Done():
+			log.Debugf("[%s] clients terminated the stream", addr)
+			return
+		case <-time.After(time.Until(windowEnd)):
 		}
 	}
 }
@@ -504,23 +548,26 @@ func (s *GRPCTapServer) translateEvent(orig *proxy.TapEvent) *public.TapEvent {
 	return ev
 }
 
-// NewGrpcTapServer creates a new gRPC Tap server
+// NewGrpcTapServer creates a new gRPC Tap server. Up to clientBufferSize events are buffered for each client, and
+// events are dropped for the clients falling further behind.
 func NewGrpcTapServer(
 	tapPort uint,
 	controllerNamespace string,
 	trustDomain string,
+	clientBufferSize int,
 	k8sAPI *k8s.API,
 ) *GRPCTapServer {
 	k8sAPI.Pod().Informer().AddIndexers(cache.Indexers{ipIndex: indexByIP})
 	k8sAPI.Node().Informer().AddIndexers(cache.Indexers{ipIndex: indexByIP})
 
-	return newGRPCTapServer(tapPort, controllerNamespace, trustDomain, k8sAPI)
+	return newGRPCTapServer(tapPort, controllerNamespace, trustDomain, clientBufferSize, k8sAPI)
 }
 
 func newGRPCTapServer(
 	tapPort uint,
 	controllerNamespace string,
 	trustDomain string,
+	clientBufferSize int,
 	k8sAPI *k8s.API,
 ) *GRPCTapServer {
 	srv := &GRPCTapServer{
@@ -528,6 +575,8 @@ func newGRPCTapServer(
 		k8sAPI:              k8sAPI,
 		controllerNamespace: controllerNamespace,
 		trustDomain:         trustDomain,
+		clientBufferSize:    clientBufferSize,
+		streams:             newProxyStreams(),
 	}
 
 	s := prometheus.NewGrpcServer()
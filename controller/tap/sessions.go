@@ -0,0 +1,339 @@
+package tap
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/linkerd/linkerd2/controller/gen/public"
+	"github.com/linkerd/linkerd2/controller/k8s"
+	pkgK8s "github.com/linkerd/linkerd2/pkg/k8s"
+	pkgTap "github.com/linkerd/linkerd2/pkg/tap"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+const (
+	// eventSource is the component recording the events of tap sessions.
+	eventSource = "linkerd-tap"
+
+	// sessionAnnotation holds the JSON encoding of the session an event was
+	// recorded for, from which the sessions are listed back.
+	sessionAnnotation = "tap.linkerd.io/session"
+)
+
+type (
+	// SessionLog is the audit trail of tap sessions. Every session is logged
+	// when it starts and ends, or when it is denied, optionally recorded as
+	// Kubernetes events on the tapped resource, and the most recent ones are
+	// kept in memory.
+	//
+	// `linkerd tap audit` lists the sessions from the events when they are
+	// recorded, and otherwise from the memory of every tap replica. Either
+	// way they only last as long as the API server keeps events, or until
+	// the replicas restart, so the structured log entries are the
+	// authoritative audit trail.
+	SessionLog struct {
+		size     int
+		replica  string
+		k8sAPI   *k8s.API
+		recorder record.EventRecorder
+		log      *logrus.Entry
+		now      func() time.Time
+
+		mu       sync.Mutex
+		seq      uint64
+		sessions []*auditedSession
+	}
+
+	auditedSession struct {
+		// session is guarded by SessionLog.mu. Its Events field is only set
+		// in snapshots, as the events counter is updated without the lock.
+		session pkgTap.Session
+		events  uint64
+		target  runtime.Object
+	}
+)
+
+// NewSessionLog creates a SessionLog keeping the last size sessions of the
+// tap replica, which names the IDs of its sessions. If recorder is not nil,
+// the start and end of every session are also recorded as events on the
+// tapped resource.
+func NewSessionLog(size int, replica string, k8sAPI *k8s.API, recorder record.EventRecorder) *SessionLog {
+	return &SessionLog{
+		size:     size,
+		replica:  replica,
+		k8sAPI:   k8sAPI,
+		recorder: recorder,
+		log:      logrus.WithField("component", "audit"),
+		now:      time.Now,
+	}
+}
+
+// NewEventRecorder creates a recorder for the events of tap sessions. Every
+// event names its session, so the events are neither deduplicated nor
+// aggregated, and the rate limits are raised so that busy resources don't
+// lose theirs.
+func NewEventRecorder(client kubernetes.Interface) record.EventRecorder {
+	broadcaster := record.NewBroadcasterWithCorrelatorOptions(record.CorrelatorOptions{
+		BurstSize: 1000,
+		QPS:       10,
+		KeyFunc: func(event *corev1.Event) (string, string) {
+			return event.Message, event.Message
+		},
+	})
+	broadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{
+		Interface: client.CoreV1().Events(""),
+	})
+	return broadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: eventSource})
+}
+
+// start records the start of a session by user, member of groups, for the
+// tap request req.
+func (l *SessionLog) start(user string, groups []string, req *public.TapByResourceRequest) *auditedSession {
+	if l == nil {
+		return nil
+	}
+	res := req.GetTarget().GetResource()
+	s := &auditedSession{session: pkgTap.Session{
+		ID:        l.nextID(),
+		User:      user,
+		Groups:    groups,
+		Namespace: res.GetNamespace(),
+		Resource:  res.GetType(),
+		Name:      res.GetName(),
+		StartedAt: l.now(),
+	}}
+	if match := req.GetMatch(); match != nil {
+		s.session.Match = strings.TrimSpace(proto.CompactTextString(match))
+	}
+	if s.session.Resource == pkgK8s.Namespace {
+		s.session.Namespace = s.session.Name
+	}
+
+	l.add(s)
+
+	l.log.WithFields(sessionFields(s.session)).Info("tap session started")
+	if l.recorder != nil {
+		s.target = l.target(s.session)
+		if s.target != nil {
+			l.recorder.AnnotatedEventf(s.target, sessionAnnotations(s.session), corev1.EventTypeNormal, "TapStarted",
+				"%s started tapping %s (session %s)", user, s.session.Target(), s.session.ID)
+		}
+	}
+	return s
+}
+
+// denied records a tap attempt by user, member of groups, on the resource
+// restype/name in namespace, which was refused with err.
+func (l *SessionLog) denied(user string, groups []string, namespace, restype, name string, err error) {
+	if l == nil {
+		return
+	}
+	if canonical, cerr := pkgK8s.CanonicalResourceNameFromFriendlyName(restype); cerr == nil {
+		restype = canonical
+	}
+	now := l.now()
+	s := &auditedSession{session: pkgTap.Session{
+		ID:        l.nextID(),
+		User:      user,
+		Groups:    groups,
+		Namespace: namespace,
+		Resource:  restype,
+		Name:      name,
+		StartedAt: now,
+		EndedAt:   &now,
+		Denied:    true,
+		Error:     err.Error(),
+	}}
+	l.add(s)
+
+	l.log.WithFields(sessionFields(s.session)).WithField("error", err.Error()).Warn("tap session denied")
+	if l.recorder != nil {
+		if target := l.target(s.session); target != nil {
+			l.recorder.AnnotatedEventf(target, sessionAnnotations(s.session), corev1.EventTypeWarning, "TapDenied",
+				"%s was denied tapping %s (session %s)", user, s.session.Target(), s.session.ID)
+		}
+	}
+}
+
+// nextID returns the ID of a new session: the name of the replica and the
+// session's sequence number in it.
+func (l *SessionLog) nextID() string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.seq++
+	return fmt.Sprintf("%s-%d", l.replica, l.seq)
+}
+
+// add appends a session to the log, dropping the oldest one once the log is
+// full.
+func (l *SessionLog) add(s *auditedSession) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.sessions = append(l.sessions, s)
+	if len(l.sessions) > l.size {
+		l.sessions = l.sessions[len(l.sessions)-l.size:]
+	}
+}
+
+// delivered counts an event sent to the session's client.
+func (s *auditedSession) delivered() {
+	if s == nil {
+		return
+	}
+	atomic.AddUint64(&s.events, 1)
+}
+
+// end records the end of a session, with the error it ended with if any.
+func (l *SessionLog) end(s *auditedSession, err error) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	endedAt := l.now()
+	s.session.EndedAt = &endedAt
+	if err != nil {
+		s.session.Error = err.Error()
+	}
+	session := s.snapshot()
+	l.mu.Unlock()
+
+	entry := l.log.WithFields(sessionFields(session)).
+		WithField("duration", endedAt.Sub(session.StartedAt).String())
+	if err != nil {
+		entry.WithField("error", err.Error()).Info("tap session ended")
+	} else {
+		entry.Info("tap session ended")
+	}
+	if s.target != nil {
+		l.recorder.AnnotatedEventf(s.target, sessionAnnotations(session), corev1.EventTypeNormal, "TapEnded",
+			"tap of %s by %s ended after %s, %d events delivered (session %s)",
+			session.Target(), session.User, endedAt.Sub(session.StartedAt).Round(time.Second), session.Events, session.ID)
+	}
+}
+
+// Sessions returns the sessions in the log, most recent first.
+func (l *SessionLog) Sessions() []pkgTap.Session {
+	if l == nil {
+		return []pkgTap.Session{}
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	sessions := make([]pkgTap.Session, len(l.sessions))
+	for i, s := range l.sessions {
+		sessions[len(l.sessions)-1-i] = s.snapshot()
+	}
+	return sessions
+}
+
+// recordsEvents returns whether the sessions are recorded as events, in
+// which case they are listed from the events of all the replicas.
+func (l *SessionLog) recordsEvents() bool {
+	return l != nil && l.recorder != nil
+}
+
+// eventSessions returns the sessions recorded as events by all the tap
+// replicas, most recent first. The end of a session supersedes its start.
+func (l *SessionLog) eventSessions() ([]pkgTap.Session, error) {
+	events, err := l.k8sAPI.Client.CoreV1().Events("").List(metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("source", eventSource).String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the events of tap sessions: %s", err)
+	}
+
+	byID := map[string]pkgTap.Session{}
+	for _, event := range events.Items {
+		encoded, ok := event.Annotations[sessionAnnotation]
+		if !ok {
+			continue
+		}
+		var session pkgTap.Session
+		if err := json.Unmarshal([]byte(encoded), &session); err != nil {
+			l.log.Debugf("ignoring event %s/%s: %s", event.Namespace, event.Name, err)
+			continue
+		}
+		if recorded, ok := byID[session.ID]; ok && recorded.EndedAt != nil {
+			continue
+		}
+		byID[session.ID] = session
+	}
+
+	sessions := make([]pkgTap.Session, 0, len(byID))
+	for _, session := range byID {
+		sessions = append(sessions, session)
+	}
+	sortSessions(sessions)
+	return sessions, nil
+}
+
+// sortSessions sorts sessions most recent first.
+func sortSessions(sessions []pkgTap.Session) {
+	sort.SliceStable(sessions, func(i, j int) bool {
+		if sessions[i].StartedAt.Equal(sessions[j].StartedAt) {
+			return sessions[i].ID > sessions[j].ID
+		}
+		return sessions[i].StartedAt.After(sessions[j].StartedAt)
+	})
+}
+
+// snapshot must be called with SessionLog.mu held.
+func (s *auditedSession) snapshot() pkgTap.Session {
+	session := s.session
+	session.Events = atomic.LoadUint64(&s.events)
+	return session
+}
+
+// target returns the object the session's events are recorded on: the tapped
+// resource, or its namespace when all the resources of a kind are tapped.
+func (l *SessionLog) target(session pkgTap.Session) runtime.Object {
+	restype, name := session.Resource, session.Name
+	if name == "" {
+		restype, name = pkgK8s.Namespace, session.Namespace
+	}
+	objects, err := l.k8sAPI.GetObjects(session.Namespace, restype, name)
+	if err != nil || len(objects) != 1 {
+		l.log.Debugf("not recording events for tap session on %s/%s: %v", restype, name, err)
+		return nil
+	}
+	return objects[0]
+}
+
+// sessionAnnotations returns the annotations of the events recorded for
+// session.
+func sessionAnnotations(session pkgTap.Session) map[string]string {
+	encoded, err := json.Marshal(session)
+	if err != nil {
+		return nil
+	}
+	return map[string]string{sessionAnnotation: string(encoded)}
+}
+
+func sessionFields(session pkgTap.Session) logrus.Fields {
+	fields := logrus.Fields{
+		"id":        session.ID,
+		"user":      session.User,
+		"groups":    session.Groups,
+		"namespace": session.Namespace,
+		"target":    session.Target(),
+		"events":    session.Events,
+	}
+	if session.Match != "" {
+		fields["match"] = session.Match
+	}
+	return fields
+}
@@ -0,0 +1,247 @@
+package tap
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/linkerd/linkerd2/controller/gen/public"
+	"github.com/linkerd/linkerd2/controller/k8s"
+	pkgTap "github.com/linkerd/linkerd2/pkg/tap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+)
+
+func tapRequest(namespace, restype, name string) *public.TapByResourceRequest {
+	return &public.TapByResourceRequest{
+		Target: &public.ResourceSelection{
+			Resource: &public.Resource{Namespace: namespace, Type: restype, Name: name},
+		},
+		Match: httpMatch(&public.TapByResourceRequest_Match_Http{
+			Match: &public.TapByResourceRequest_Match_Http_Path{Path: "/api"},
+		}),
+	}
+}
+
+// annotatedRecorder fixes record.FakeRecorder's AnnotatedEventf, which
+// doesn't expand its arguments, and keeps the annotations of the events.
+type annotatedRecorder struct {
+	*record.FakeRecorder
+	annotations []map[string]string
+}
+
+func newAnnotatedRecorder(bufferSize int) *annotatedRecorder {
+	return &annotatedRecorder{FakeRecorder: record.NewFakeRecorder(bufferSize)}
+}
+
+func (r *annotatedRecorder) AnnotatedEventf(object runtime.Object, annotations map[string]string, eventtype, reason, messageFmt string, args ...interface{}) {
+	r.annotations = append(r.annotations, annotations)
+	r.Eventf(object, eventtype, reason, messageFmt, args...)
+}
+
+func TestSessionLog(t *testing.T) {
+	k8sAPI, err := k8s.NewFakeAPI(`
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: emojivoto
+`, `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+  namespace: emojivoto
+`)
+	if err != nil {
+		t.Fatalf("NewFakeAPI returned an error: %s", err)
+	}
+	k8sAPI.Sync()
+
+	recorder := newAnnotatedRecorder(10)
+	sessions := NewSessionLog(10, "linkerd-tap-0", k8sAPI, recorder)
+	now := time.Date(2019, 11, 5, 10, 0, 0, 0, time.UTC)
+	sessions.now = func() time.Time { return now }
+
+	session := sessions.start("alice", []string{"devs"}, tapRequest("emojivoto", "deployment", "web"))
+	for i := 0; i < 3; i++ {
+		session.delivered()
+	}
+	startedAt := now
+	now = now.Add(time.Minute)
+	sessions.end(session, nil)
+
+	endedAt := now
+	expected := []pkgTap.Session{{
+		ID:        "linkerd-tap-0-1",
+		User:      "alice",
+		Groups:    []string{"devs"},
+		Namespace: "emojivoto",
+		Resource:  "deployment",
+		Name:      "web",
+		Match:     `http:<path:"/api" >`,
+		StartedAt: startedAt,
+		EndedAt:   &endedAt,
+		Events:    3,
+	}}
+	if got := sessions.Sessions(); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("Expected sessions %+v, got %+v", expected, got)
+	}
+
+	for _, expectedEvent := range []string{
+		"Normal TapStarted alice started tapping deployment/web (session linkerd-tap-0-1)",
+		"Normal TapEnded tap of deployment/web by alice ended after 1m0s, 3 events delivered (session linkerd-tap-0-1)",
+	} {
+		select {
+		case event := <-recorder.Events:
+			if event != expectedEvent {
+				t.Fatalf("Expected event %q, got %q", expectedEvent, event)
+			}
+		default:
+			t.Fatalf("Expected event %q to be recorded", expectedEvent)
+		}
+	}
+}
+
+func TestSessionLogKeepsRecentSessions(t *testing.T) {
+	sessions := NewSessionLog(2, "linkerd-tap-0", nil, nil)
+	for _, name := range []string{"web", "voting", "emoji"} {
+		sessions.end(sessions.start("alice", nil, tapRequest("emojivoto", "deployment", name)), nil)
+	}
+
+	var names []string
+	for _, session := range sessions.Sessions() {
+		names = append(names, session.Name)
+	}
+	if expected := []string{"emoji", "voting"}; !reflect.DeepEqual(names, expected) {
+		t.Fatalf("Expected sessions %v, got %v", expected, names)
+	}
+}
+
+func TestSessionLogDenied(t *testing.T) {
+	k8sAPI, err := k8s.NewFakeAPI(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+  namespace: emojivoto
+`)
+	if err != nil {
+		t.Fatalf("NewFakeAPI returned an error: %s", err)
+	}
+	k8sAPI.Sync()
+
+	recorder := newAnnotatedRecorder(10)
+	sessions := NewSessionLog(10, "linkerd-tap-0", k8sAPI, recorder)
+	now := time.Date(2019, 11, 5, 10, 0, 0, 0, time.UTC)
+	sessions.now = func() time.Time { return now }
+
+	sessions.denied("mallory", []string{"guests"}, "emojivoto", "deployments", "web", errors.New("tap authorization failed"))
+
+	expected := []pkgTap.Session{{
+		ID:        "linkerd-tap-0-1",
+		User:      "mallory",
+		Groups:    []string{"guests"},
+		Namespace: "emojivoto",
+		Resource:  "deployment",
+		Name:      "web",
+		StartedAt: now,
+		EndedAt:   &now,
+		Denied:    true,
+		Error:     "tap authorization failed",
+	}}
+	if got := sessions.Sessions(); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("Expected sessions %+v, got %+v", expected, got)
+	}
+
+	expectedEvent := "Warning TapDenied mallory was denied tapping deployment/web (session linkerd-tap-0-1)"
+	select {
+	case event := <-recorder.Events:
+		if event != expectedEvent {
+			t.Fatalf("Expected event %q, got %q", expectedEvent, event)
+		}
+	default:
+		t.Fatalf("Expected event %q to be recorded", expectedEvent)
+	}
+}
+
+func TestSessionLogEventSessions(t *testing.T) {
+	k8sAPI, err := k8s.NewFakeAPI(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+  namespace: emojivoto
+`, `
+apiVersion: v1
+kind: Event
+metadata:
+  name: web.unrelated
+  namespace: emojivoto
+reason: ScalingReplicaSet
+`)
+	if err != nil {
+		t.Fatalf("NewFakeAPI returned an error: %s", err)
+	}
+	k8sAPI.Sync()
+
+	recorder := newAnnotatedRecorder(10)
+	sessions := NewSessionLog(10, "linkerd-tap-0", k8sAPI, recorder)
+	now := time.Date(2019, 11, 5, 10, 0, 0, 0, time.UTC)
+	sessions.now = func() time.Time { return now }
+
+	deniedAt := now
+	sessions.denied("mallory", nil, "emojivoto", "deployments", "web", errors.New("tap authorization failed"))
+	now = now.Add(time.Minute)
+	startedAt := now
+	session := sessions.start("alice", nil, tapRequest("emojivoto", "deployment", "web"))
+	now = now.Add(time.Minute)
+	endedAt := now
+	sessions.end(session, nil)
+
+	// the replica which recorded the events is gone, only its events remain
+	for i, annotations := range recorder.annotations {
+		event := &corev1.Event{ObjectMeta: metav1.ObjectMeta{
+			Name:        fmt.Sprintf("web.%d", i),
+			Namespace:   "emojivoto",
+			Annotations: annotations,
+		}}
+		if _, err := k8sAPI.Client.CoreV1().Events("emojivoto").Create(event); err != nil {
+			t.Fatalf("Failed to create event: %s", err)
+		}
+	}
+
+	got, err := NewSessionLog(10, "linkerd-tap-1", k8sAPI, recorder).eventSessions()
+	if err != nil {
+		t.Fatalf("eventSessions returned an error: %s", err)
+	}
+	expected := []pkgTap.Session{
+		{
+			ID:        "linkerd-tap-0-2",
+			User:      "alice",
+			Namespace: "emojivoto",
+			Resource:  "deployment",
+			Name:      "web",
+			Match:     `http:<path:"/api" >`,
+			StartedAt: startedAt,
+			EndedAt:   &endedAt,
+		},
+		{
+			ID:        "linkerd-tap-0-1",
+			User:      "mallory",
+			Namespace: "emojivoto",
+			Resource:  "deployment",
+			Name:      "web",
+			StartedAt: deniedAt,
+			EndedAt:   &deniedAt,
+			Denied:    true,
+			Error:     "tap authorization failed",
+		},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Fatalf("Expected sessions %+v, got %+v", expected, got)
+	}
+}
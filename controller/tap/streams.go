@@ -0,0 +1,167 @@
+package tap
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"github.com/golang/protobuf/proto"
+	proxy "github.com/linkerd/linkerd2-proxy-api/go/tap"
+	"github.com/linkerd/linkerd2/controller/gen/public"
+	"google.golang.org/grpc/metadata"
+)
+
+type (
+	// proxyStreams holds the tap streams open to proxies. A stream is shared
+	// by all the clients tapping a proxy with the same proxy-side match,
+	// extraction and limit, and is closed once its last client leaves, so
+	// that concurrent clients don't each cost the proxy a stream.
+	proxyStreams struct {
+		mu      sync.Mutex
+		streams map[proxyStreamKey]*proxyStream
+	}
+
+	proxyStreamKey struct {
+		addr    string
+		name    string
+		observe string
+	}
+
+	// proxyStream is a tap stream open to the proxy at addr, whose identity
+	// must be name. Its events are published to all of its clients.
+	proxyStream struct {
+		key    proxyStreamKey
+		addr   string
+		req    *proxy.ObserveRequest
+		cancel context.CancelFunc
+
+		mu sync.Mutex
+		// clients maps each client to its filter for this stream's events,
+		// as the filters track the state of the proxy's requests.
+		clients map[*tapClient]*streamFilter
+	}
+
+	// tapClient buffers the events to be sent to a client. Events are dropped
+	// rather than buffered once the client falls behind by more than the size
+	// of its buffer.
+	tapClient struct {
+		events  chan *public.TapEvent
+		filter  *eventFilter
+		dropped uint64
+	}
+)
+
+func newProxyStreams() *proxyStreams {
+	return &proxyStreams{streams: make(map[proxyStreamKey]*proxyStream)}
+}
+
+// subscribe adds client to the stream observing req on the proxy at addr,
+// whose identity must be name. If there's no such stream yet, it's opened by
+// running tap in a new goroutine.
+func (p *proxyStreams) subscribe(
+	addr, name string,
+	req *proxy.ObserveRequest,
+	client *tapClient,
+	tap func(context.Context, *proxyStream),
+) *proxyStream {
+	key := proxyStreamKey{addr, name, proto.CompactTextString(req)}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	stream, ok := p.streams[key]
+	if !ok {
+		// The stream outlives the client opening it, so its context is only
+		// cancelled once all of its clients are gone.
+		ctx := metadata.AppendToOutgoingContext(context.Background(), requireIDHeader, name)
+		ctx, cancel := context.WithCancel(ctx)
+		stream = &proxyStream{
+			key:     key,
+			addr:    addr,
+			req:     req,
+			cancel:  cancel,
+			clients: make(map[*tapClient]*streamFilter),
+		}
+		p.streams[key] = stream
+		proxyStreamsGauge.Inc()
+
+		go func() {
+			tap(ctx, stream)
+			p.remove(stream)
+		}()
+	}
+
+	stream.mu.Lock()
+	stream.clients[client] = newStreamFilter(client.filter)
+	stream.mu.Unlock()
+
+	return stream
+}
+
+// unsubscribe removes client from stream, closing the stream if it was its
+// last client.
+func (p *proxyStreams) unsubscribe(stream *proxyStream, client *tapClient) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	stream.mu.Lock()
+	delete(stream.clients, client)
+	last := len(stream.clients) == 0
+	stream.mu.Unlock()
+
+	if last && p.streams[stream.key] == stream {
+		stream.cancel()
+		delete(p.streams, stream.key)
+		proxyStreamsGauge.Dec()
+	}
+}
+
+// remove forgets about a stream which ended, so that it's opened again for
+// the next clients.
+func (p *proxyStreams) remove(stream *proxyStream) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.streams[stream.key] == stream {
+		stream.cancel()
+		delete(p.streams, stream.key)
+		proxyStreamsGauge.Dec()
+	}
+}
+
+// publish passes an event reported by the proxy to each of the stream's
+// clients, through their filters.
+func (s *proxyStream) publish(ev *public.TapEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for client, streams := range s.clients {
+		for _, e := range streams.process(ev) {
+			client.send(e)
+		}
+	}
+}
+
+func newTapClient(bufferSize int, filter *eventFilter) *tapClient {
+	return &tapClient{
+		events: make(chan *public.TapEvent, bufferSize),
+		filter: filter,
+	}
+}
+
+// send buffers an event for the client, or drops it if the client's buffer
+// is full. It never blocks, so that a slow client doesn't hold back the
+// other clients of the streams it's subscribed to.
+func (c *tapClient) send(ev *public.TapEvent) {
+	select {
+	case c.events <- ev:
+	default:
+		atomic.AddUint64(&c.dropped, 1)
+		eventsDroppedTotal.Inc()
+	}
+}
+
+// droppedEvents returns the number of events dropped for the client.
+func (c *tapClient) droppedEvents() uint64 {
+	return atomic.LoadUint64(&c.dropped)
+}
@@ -0,0 +1,153 @@
+package tap
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	proxy "github.com/linkerd/linkerd2-proxy-api/go/tap"
+	"github.com/linkerd/linkerd2/controller/gen/public"
+	"google.golang.org/grpc/metadata"
+)
+
+// fakeTap stands in for GRPCTapServer.tapProxy, recording the streams opened
+// and holding them open until they're closed.
+type fakeTap struct {
+	opened chan context.Context
+	closed chan *proxyStream
+}
+
+func newFakeTap() *fakeTap {
+	return &fakeTap{
+		opened: make(chan context.Context, 10),
+		closed: make(chan *proxyStream, 10),
+	}
+}
+
+func (f *fakeTap) tap(ctx context.Context, stream *proxyStream) {
+	f.opened <- ctx
+	<-ctx.Done()
+	f.closed <- stream
+}
+
+func TestProxyStreamsShareStreams(t *testing.T) {
+	streams := newProxyStreams()
+	tap := newFakeTap()
+	req := &proxy.ObserveRequest{Limit: 10}
+
+	client1 := newTapClient(10, nil)
+	client2 := newTapClient(10, nil)
+	stream1 := streams.subscribe("10.0.0.1", "web.emojivoto", req, client1, tap.tap)
+	stream2 := streams.subscribe("10.0.0.1", "web.emojivoto", &proxy.ObserveRequest{Limit: 10}, client2, tap.tap)
+	if stream1 != stream2 {
+		t.Fatalf("Expected equal requests to share a stream")
+	}
+
+	ctx := <-tap.opened
+	md, _ := metadata.FromOutgoingContext(ctx)
+	if ids := md.Get(requireIDHeader); len(ids) != 1 || ids[0] != "web.emojivoto" {
+		t.Fatalf("Unexpected %s header: %v", requireIDHeader, ids)
+	}
+
+	client3 := newTapClient(10, nil)
+	stream3 := streams.subscribe("10.0.0.1", "web.emojivoto", &proxy.ObserveRequest{Limit: 20}, client3, tap.tap)
+	if stream3 == stream1 {
+		t.Fatalf("Expected requests with different limits not to share a stream")
+	}
+	<-tap.opened
+
+	stream1.publish(requestInit(1, nil))
+	for i, client := range []*tapClient{client1, client2} {
+		select {
+		case <-client.events:
+		default:
+			t.Fatalf("Expected client %d to receive the event", i+1)
+		}
+	}
+	if len(client3.events) != 0 {
+		t.Fatalf("Expected client 3 not to receive the event")
+	}
+
+	streams.unsubscribe(stream1, client1)
+	select {
+	case <-tap.closed:
+		t.Fatalf("Expected the stream to stay open while it has clients")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	streams.unsubscribe(stream2, client2)
+	if closed := <-tap.closed; closed != stream1 {
+		t.Fatalf("Expected the shared stream to be closed")
+	}
+	streams.unsubscribe(stream3, client3)
+	<-tap.closed
+
+	if len(streams.streams) != 0 {
+		t.Fatalf("Expected no open streams, got %d", len(streams.streams))
+	}
+}
+
+func TestProxyStreamDropsEventsForSlowClients(t *testing.T) {
+	streams := newProxyStreams()
+	tap := newFakeTap()
+	req := &proxy.ObserveRequest{Limit: 10}
+
+	slow := newTapClient(1, nil)
+	fast := newTapClient(10, nil)
+	stream := streams.subscribe("10.0.0.1", "web.emojivoto", req, slow, tap.tap)
+	streams.subscribe("10.0.0.1", "web.emojivoto", req, fast, tap.tap)
+	defer streams.unsubscribe(stream, fast)
+	defer streams.unsubscribe(stream, slow)
+
+	for i := uint64(0); i < 3; i++ {
+		stream.publish(requestInit(i, nil))
+	}
+
+	if len(slow.events) != 1 || slow.droppedEvents() != 2 {
+		t.Fatalf("Expected the slow client to receive 1 event and drop 2, got %d and dropped %d", len(slow.events), slow.droppedEvents())
+	}
+	if len(fast.events) != 3 || fast.droppedEvents() != 0 {
+		t.Fatalf("Expected the fast client to receive all 3 events, got %d and dropped %d", len(fast.events), fast.droppedEvents())
+	}
+}
+
+func TestProxyStreamFiltersEventsPerClient(t *testing.T) {
+	streams := newProxyStreams()
+	tap := newFakeTap()
+	req := &proxy.ObserveRequest{Limit: 10}
+
+	filter, err := makeEventFilter(allMatches(httpMatch(&public.TapByResourceRequest_Match_Http{
+		Match: &public.TapByResourceRequest_Match_Http_Header_{
+			Header: &public.TapByResourceRequest_Match_Http_Header{Name: "x-tenant", Value: "blue"},
+		},
+	})))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	filter.stripHeaders = true
+
+	filtered := newTapClient(10, filter)
+	unfiltered := newTapClient(10, nil)
+	stream := streams.subscribe("10.0.0.1", "web.emojivoto", req, filtered, tap.tap)
+	streams.subscribe("10.0.0.1", "web.emojivoto", req, unfiltered, tap.tap)
+	defer streams.unsubscribe(stream, unfiltered)
+	defer streams.unsubscribe(stream, filtered)
+
+	stream.publish(requestInit(1, map[string]string{"x-tenant": "blue"}))
+	stream.publish(requestInit(2, map[string]string{"x-tenant": "green"}))
+	stream.publish(responseInit(1, 200, time.Millisecond))
+	stream.publish(responseInit(2, 200, time.Millisecond))
+
+	if len(filtered.events) != 2 {
+		t.Fatalf("Expected the filtered client to receive 2 events, got %d", len(filtered.events))
+	}
+	if headers := (<-filtered.events).GetHttp().GetRequestInit().GetHeaders(); headers != nil {
+		t.Fatalf("Expected headers to be stripped for the filtered client, got %v", headers)
+	}
+	if len(unfiltered.events) != 4 {
+		t.Fatalf("Expected the unfiltered client to receive 4 events, got %d", len(unfiltered.events))
+	}
+	if headers := (<-unfiltered.events).GetHttp().GetRequestInit().GetHeaders(); headers == nil {
+		t.Fatalf("Expected headers to be kept for the unfiltered client")
+	}
+}
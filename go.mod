@@ -12,7 +12,7 @@ require (
 	github.com/containernetworking/cni v0.6.0
 	github.com/cyphar/filepath-securejoin v0.2.2 // indirect
 	github.com/deislabs/smi-sdk-go v0.0.0-20190610232231-f281e2121a16
-	github.com/dgrijalva/jwt-go v3.1.0+incompatible // indirect
+	github.com/dgrijalva/jwt-go v3.1.0+incompatible
 	github.com/elazarl/goproxy v0.0.0-20190711103511-473e67f1d7d2 // indirect
 	github.com/elazarl/goproxy/ext v0.0.0-20190711103511-473e67f1d7d2 // indirect
 	github.com/emicklei/proto v1.6.8
@@ -27,6 +27,7 @@ require (
 	github.com/google/uuid v1.1.0 // indirect
 	github.com/gorilla/websocket v1.2.0
 	github.com/grpc-ecosystem/go-grpc-prometheus v0.0.0-20170330212424-2500245aa611
+	github.com/hashicorp/golang-lru v0.5.1
 	github.com/huandu/xstrings v1.2.0 // indirect
 	github.com/imdario/mergo v0.3.7
 	github.com/julienschmidt/httprouter v1.2.0
@@ -47,6 +48,7 @@ require (
 	github.com/wercker/stern v0.0.0-20190705090245-4fa46dd6987f
 	go.opencensus.io v0.22.0
 	golang.org/x/net v0.0.0-20190827160401-ba9fcec4b297
+	golang.org/x/time v0.0.0-20190308202827-9d24e82272b4
 	golang.org/x/tools v0.0.0-20191009213438-b090f1f24028
 	google.golang.org/grpc v1.22.0
 	k8s.io/api v0.0.0-20190620084959-7cf5895f2711
@@ -41,8 +41,10 @@ type (
 		ProxyInjectDisabled         string            `json:"proxyInjectDisabled"`
 		LinkerdNamespaceLabel       string            `json:"linkerdNamespaceLabel"`
 		ControllerUID               int64             `json:"controllerUID"`
+		EnableEndpointSlices        bool              `json:"enableEndpointSlices"`
 		EnableH2Upgrade             bool              `json:"enableH2Upgrade"`
 		EnablePodAntiAffinity       bool              `json:"enablePodAntiAffinity"`
+		EnableServiceMirror         bool              `json:"enableServiceMirror"`
 		HighAvailability            bool              `json:"highAvailability"`
 		NoInitContainer             bool              `json:"noInitContainer"`
 		WebhookFailurePolicy        string            `json:"webhookFailurePolicy"`
@@ -57,6 +59,7 @@ type (
 		Identity                    *Identity         `json:"identity"`
 		ProxyInjector               *ProxyInjector    `json:"proxyInjector"`
 		ProfileValidator            *ProfileValidator `json:"profileValidator"`
+		PublicAPI                   *PublicAPI        `json:"publicAPI"`
 		Tap                         *Tap              `json:"tap"`
 		Proxy                       *Proxy            `json:"proxy"`
 		ProxyInit                   *ProxyInit        `json:"proxyInit"`
@@ -161,9 +164,12 @@ type (
 	// Identity contains the fields to set the identity variables in the proxy
 	// sidecar container
 	Identity struct {
-		TrustAnchorsPEM string  `json:"trustAnchorsPEM"`
-		TrustDomain     string  `json:"trustDomain"`
-		Issuer          *Issuer `json:"issuer"`
+		TrustAnchorsPEM   string  `json:"trustAnchorsPEM"`
+		TrustDomain       string  `json:"trustDomain"`
+		SpiffeTrustDomain string  `json:"spiffeTrustDomain"`
+		TokenValidator    string  `json:"tokenValidator"`
+		TokenAudiences    string  `json:"tokenAudiences"`
+		Issuer            *Issuer `json:"issuer"`
 	}
 
 	// Issuer has the Helm variables of the identity issuer
@@ -173,7 +179,9 @@ type (
 		IssuanceLifetime    string    `json:"issuanceLifetime"`
 		CrtExpiryAnnotation string    `json:"crtExpiryAnnotation"`
 		CrtExpiry           time.Time `json:"crtExpiry"`
+		RenewBefore         string    `json:"renewBefore"`
 		TLS                 *TLS      `json:"tls"`
+		TrustAnchor         *TLS      `json:"trustAnchor"`
 	}
 
 	// ProxyInjector has all the proxy injector's Helm variables
@@ -186,9 +194,20 @@ type (
 		*TLS
 	}
 
+	// PublicAPI has all the public API's Helm variables
+	PublicAPI struct {
+		PrometheusURL            string            `json:"prometheusUrl"`
+		PrometheusHeaders        map[string]string `json:"prometheusHeaders"`
+		PrometheusSecret         string            `json:"prometheusSecret"`
+		PrometheusBearerTokenKey string            `json:"prometheusBearerTokenKey"`
+		PrometheusUsername       string            `json:"prometheusUsername"`
+		PrometheusPasswordKey    string            `json:"prometheusPasswordKey"`
+	}
+
 	// Tap has all the Tap's Helm variables
 	Tap struct {
 		*TLS
+		AuditEvents bool `json:"auditEvents"`
 	}
 
 	// TLS has a pair of PEM-encoded key and certificate variables used in the
@@ -37,6 +37,7 @@ func TestNewValues(t *testing.T) {
 		ControllerUID:               2103,
 		EnableH2Upgrade:             true,
 		EnablePodAntiAffinity:       false,
+		EnableServiceMirror:         false,
 		HighAvailability:            false,
 		NoInitContainer:             false,
 		WebhookFailurePolicy:        "Ignore",
@@ -60,12 +61,14 @@ func TestNewValues(t *testing.T) {
 				IssuanceLifetime:    "86400s",
 				CrtExpiryAnnotation: "linkerd.io/identity-issuer-expiry",
 				TLS:                 &TLS{},
+				TrustAnchor:         &TLS{},
 				Scheme:              "linkerd.io/tls",
 			},
 		},
 
 		ProxyInjector:    &ProxyInjector{TLS: &TLS{}},
 		ProfileValidator: &ProfileValidator{TLS: &TLS{}},
+		PublicAPI:        &PublicAPI{PrometheusHeaders: map[string]string{}},
 		Tap:              &Tap{TLS: &TLS{}},
 
 		ControlPlaneTracing: false,
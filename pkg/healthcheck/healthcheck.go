@@ -2,6 +2,8 @@ package healthcheck
 
 import (
 	"context"
+	"crypto/sha256"
+	"crypto/x509"
 	"errors"
 	"fmt"
 	"sort"
@@ -15,6 +17,7 @@ import (
 	pb "github.com/linkerd/linkerd2/controller/gen/public"
 	"github.com/linkerd/linkerd2/pkg/config"
 	"github.com/linkerd/linkerd2/pkg/identity"
+	"github.com/linkerd/linkerd2/pkg/issuercerts"
 	"github.com/linkerd/linkerd2/pkg/k8s"
 	"github.com/linkerd/linkerd2/pkg/tls"
 	"github.com/linkerd/linkerd2/pkg/version"
@@ -110,6 +113,15 @@ const (
 	// from LinkerdVersionChecks, so those checks must be added first.
 	LinkerdDataPlaneChecks CategoryID = "linkerd-data-plane"
 
+	// LinkerdIdentityRotationChecks adds a series of checks to follow the
+	// progress of a trust anchor rotation: that the issuer chains up to one of
+	// the trust anchors, that no other trust anchor is left in the bundle, and
+	// that every proxy trusts the current bundle.
+	// These checks are dependent on the output of KubernetesAPIChecks and
+	// `linkerdConfig` from LinkerdConfigChecks, so those checks must be added
+	// first.
+	LinkerdIdentityRotationChecks CategoryID = "linkerd-identity-rotation"
+
 	// linkerdCniResourceLabel is the label key that is used to identify
 	// whether a Kubernetes resource is related to the install-cni command
 	// The value is expected to be "true", "false" or "", where "false" and
@@ -284,6 +296,8 @@ type HealthChecker struct {
 	serverVersion    string
 	linkerdConfig    *configPb.All
 	uuid             string
+	trustAnchors     []*x509.Certificate
+	issuerAnchor     *x509.Certificate
 }
 
 // NewHealthChecker returns an initialized HealthChecker
@@ -865,6 +879,42 @@ func (hc *HealthChecker) allCategories() []category {
 				},
 			},
 		},
+		{
+			id: LinkerdIdentityRotationChecks,
+			checkers: []checker{
+				{
+					description: "trust anchors are valid",
+					hintAnchor:  "l5d-identity-trust-anchors-valid",
+					fatal:       true,
+					check: func(context.Context) error {
+						return hc.checkTrustAnchors()
+					},
+				},
+				{
+					description: "issuer certificate chains up to a trust anchor",
+					hintAnchor:  "l5d-identity-issuer-chains-to-trust-anchor",
+					check: func(context.Context) error {
+						return hc.checkIssuerTrustAnchor()
+					},
+				},
+				{
+					description: "no trust anchor rotation in progress",
+					hintAnchor:  "l5d-identity-trust-anchor-rotation",
+					warning:     true,
+					check: func(context.Context) error {
+						return hc.checkTrustAnchorRotation()
+					},
+				},
+				{
+					description: "data plane proxies trust the current trust anchors",
+					hintAnchor:  "l5d-identity-proxies-trust-anchors",
+					warning:     true,
+					check: func(context.Context) error {
+						return hc.checkProxiesTrustAnchors()
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -1259,6 +1309,193 @@ func (hc *HealthChecker) checkDataPlaneProxiesCertificate() error {
 	return fmt.Errorf("The following pods have old proxy certificate information; please, restart them:\n\t%s", strings.Join(offendingPods, "\n\t"))
 }
 
+// checkTrustAnchors parses the trust anchors bundle from the Linkerd config.
+func (hc *HealthChecker) checkTrustAnchors() error {
+	if hc.linkerdConfig == nil {
+		_, configPB, err := FetchLinkerdConfigMap(hc.kubeAPI, hc.ControlPlaneNamespace)
+		if err != nil {
+			return err
+		}
+		hc.linkerdConfig = configPB
+	}
+
+	trustAnchorsPem := hc.linkerdConfig.GetGlobal().GetIdentityContext().GetTrustAnchorsPem()
+	if trustAnchorsPem == "" {
+		// identity is disabled, there's nothing to rotate
+		return nil
+	}
+
+	anchors, err := tls.DecodePEMCertificates(trustAnchorsPem)
+	if err != nil {
+		return fmt.Errorf("failed to parse trust anchors: %s", err)
+	}
+	if len(anchors) == 0 {
+		return errors.New("no trust anchors found")
+	}
+	for _, anchor := range anchors {
+		if err := issuercerts.CheckCertTimeValidity(anchor); err != nil {
+			return fmt.Errorf("trust anchor %s is %s", describeTrustAnchor(anchor), err)
+		}
+		if err := issuercerts.CheckCertAlgoRequirements(anchor); err != nil {
+			return fmt.Errorf("trust anchor %s %s; proxies support %s", describeTrustAnchor(anchor), err, issuercerts.SupportedAlgorithms)
+		}
+	}
+
+	hc.trustAnchors = anchors
+	return nil
+}
+
+// checkIssuerTrustAnchor finds the trust anchor the issuer certificate chains
+// up to.
+func (hc *HealthChecker) checkIssuerTrustAnchor() error {
+	if len(hc.trustAnchors) == 0 {
+		return nil
+	}
+
+	var issuerData *issuercerts.IssuerCertData
+	var err error
+	if hc.linkerdConfig.GetGlobal().GetIdentityContext().GetScheme() == string(corev1.SecretTypeTLS) {
+		issuerData, err = issuercerts.FetchExternalIssuerData(hc.kubeAPI, hc.ControlPlaneNamespace)
+	} else {
+		issuerData, err = issuercerts.FetchIssuerData(hc.kubeAPI, "", hc.ControlPlaneNamespace)
+	}
+	if err != nil {
+		return err
+	}
+
+	issuer, err := tls.DecodePEMCrt(issuerData.IssuerCrt)
+	if err != nil {
+		return fmt.Errorf("failed to parse issuer certificate: %s", err)
+	}
+	if err := issuercerts.CheckCertAlgoRequirements(issuer.Certificate); err != nil {
+		return fmt.Errorf("issuer certificate %s; proxies support %s", err, issuercerts.SupportedAlgorithms)
+	}
+
+	chains, err := issuer.Certificate.Verify(x509.VerifyOptions{
+		Roots:     tls.CertificatesToPool(hc.trustAnchors),
+		KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	if err != nil {
+		return fmt.Errorf("issuer certificate does not chain up to any trust anchor: %s", err)
+	}
+
+	chain := chains[0]
+	hc.issuerAnchor = chain[len(chain)-1]
+	return nil
+}
+
+// checkTrustAnchorRotation reports the trust anchors which are still part of
+// the bundle but no longer used to issue certificates.
+func (hc *HealthChecker) checkTrustAnchorRotation() error {
+	if len(hc.trustAnchors) < 2 {
+		return nil
+	}
+
+	unused := []string{}
+	for _, anchor := range hc.trustAnchors {
+		if hc.issuerAnchor == nil || !anchor.Equal(hc.issuerAnchor) {
+			unused = append(unused, describeTrustAnchor(anchor))
+		}
+	}
+
+	if hc.issuerAnchor == nil {
+		return fmt.Errorf("the trust anchors bundle contains %d roots:\n\t%s", len(hc.trustAnchors), strings.Join(unused, "\n\t"))
+	}
+	return fmt.Errorf("the issuer chains up to %s; once all proxies trust it and have been issued new certificates, remove the following trust anchors with `linkerd upgrade --identity-remove-trust-anchors-file`:\n\t%s",
+		describeTrustAnchor(hc.issuerAnchor), strings.Join(unused, "\n\t"))
+}
+
+// checkProxiesTrustAnchors reports, for each proxy, the current trust anchors
+// it doesn't trust yet and the removed trust anchors it still trusts.
+func (hc *HealthChecker) checkProxiesTrustAnchors() error {
+	if len(hc.trustAnchors) == 0 {
+		return nil
+	}
+
+	podList, err := hc.kubeAPI.CoreV1().Pods(hc.DataPlaneNamespace).List(metav1.ListOptions{LabelSelector: k8s.ControllerNSLabel})
+	if err != nil {
+		return err
+	}
+
+	total := 0
+	offendingPods := []string{}
+	for _, pod := range podList.Items {
+		trustAnchorsPem, ok := proxyTrustAnchors(pod)
+		if !ok {
+			continue
+		}
+		total++
+
+		name := pod.ObjectMeta.Name
+		if hc.DataPlaneNamespace == "" {
+			name = fmt.Sprintf("%s/%s", pod.ObjectMeta.Namespace, pod.ObjectMeta.Name)
+		}
+
+		trusted, err := tls.DecodePEMCertificates(trustAnchorsPem)
+		if err != nil {
+			offendingPods = append(offendingPods, fmt.Sprintf("%s: invalid trust anchors: %s", name, err))
+			continue
+		}
+
+		problems := []string{}
+		if missing := diffTrustAnchors(hc.trustAnchors, trusted); len(missing) > 0 {
+			problems = append(problems, fmt.Sprintf("does not trust %s", strings.Join(missing, ", ")))
+		}
+		if removed := diffTrustAnchors(trusted, hc.trustAnchors); len(removed) > 0 {
+			problems = append(problems, fmt.Sprintf("still trusts removed %s", strings.Join(removed, ", ")))
+		}
+		if len(problems) > 0 {
+			offendingPods = append(offendingPods, fmt.Sprintf("%s: %s", name, strings.Join(problems, "; ")))
+		}
+	}
+
+	if len(offendingPods) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%d/%d proxies trust the current trust anchors; restart the following pods:\n\t%s",
+		total-len(offendingPods), total, strings.Join(offendingPods, "\n\t"))
+}
+
+func proxyTrustAnchors(pod corev1.Pod) (string, bool) {
+	for _, containerSpec := range pod.Spec.Containers {
+		if containerSpec.Name != k8s.ProxyContainerName {
+			continue
+		}
+		for _, envVar := range containerSpec.Env {
+			if envVar.Name == identity.EnvTrustAnchors {
+				return envVar.Value, true
+			}
+		}
+	}
+	return "", false
+}
+
+// diffTrustAnchors describes the trust anchors in a that are not in b.
+func diffTrustAnchors(a, b []*x509.Certificate) []string {
+	diff := []string{}
+	for _, anchor := range a {
+		found := false
+		for _, other := range b {
+			if anchor.Equal(other) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			diff = append(diff, describeTrustAnchor(anchor))
+		}
+	}
+	return diff
+}
+
+// describeTrustAnchor identifies a trust anchor by its common name and a
+// prefix of its SHA-256 fingerprint, as roots generated by `linkerd install`
+// all share the same name and serial number.
+func describeTrustAnchor(anchor *x509.Certificate) string {
+	fingerprint := sha256.Sum256(anchor.Raw)
+	return fmt.Sprintf("%s (fingerprint %x)", anchor.Subject.CommonName, fingerprint[:8])
+}
+
 func checkResources(resourceName string, objects []runtime.Object, expectedNames []string, shouldExist bool) error {
 	if !shouldExist {
 		if len(objects) > 0 {
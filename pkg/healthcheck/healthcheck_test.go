@@ -2,6 +2,8 @@ package healthcheck
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"reflect"
@@ -17,6 +19,7 @@ import (
 	pb "github.com/linkerd/linkerd2/controller/gen/public"
 	"github.com/linkerd/linkerd2/pkg/identity"
 	"github.com/linkerd/linkerd2/pkg/k8s"
+	"github.com/linkerd/linkerd2/pkg/tls"
 	corev1 "k8s.io/api/core/v1"
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -1774,6 +1777,121 @@ data:
 	}
 }
 
+func TestCheckIdentityRotation(t *testing.T) {
+	newRoot := func() *tls.CA {
+		root, err := tls.GenerateRootCAWithDefaults("identity.linkerd.cluster.local")
+		if err != nil {
+			t.Fatalf("Failed to generate root CA: %s", err)
+		}
+		return root
+	}
+	oldRoot := newRoot()
+	currentRoot := newRoot()
+	issuer, err := currentRoot.GenerateCA("identity.linkerd.cluster.local", tls.Validity{}, -1)
+	if err != nil {
+		t.Fatalf("Failed to generate issuer CA: %s", err)
+	}
+
+	oldPEM := oldRoot.Cred.Crt.EncodeCertificatePEM()
+	currentPEM := currentRoot.Cred.Crt.EncodeCertificatePEM()
+	oldName := describeTrustAnchor(oldRoot.Cred.Crt.Certificate)
+	currentName := describeTrustAnchor(currentRoot.Cred.Crt.Certificate)
+
+	quote := func(s string) string {
+		b, _ := json.Marshal(s)
+		return string(b)
+	}
+	linkerdConfigMap := func(trustAnchorsPem string) string {
+		global, _ := json.Marshal(map[string]interface{}{
+			"identityContext": map[string]string{"trustAnchorsPem": trustAnchorsPem},
+		})
+		return fmt.Sprintf(`
+kind: ConfigMap
+apiVersion: v1
+metadata:
+  name: %s
+  namespace: linkerd
+data:
+  global: %s
+`, k8s.ConfigConfigMapName, quote(string(global)))
+	}
+	issuerSecret := fmt.Sprintf(`
+kind: Secret
+apiVersion: v1
+metadata:
+  name: %s
+  namespace: linkerd
+data:
+  %s: %s
+  %s: %s
+`, k8s.IdentityIssuerSecretName,
+		k8s.IdentityIssuerCrtName, base64.StdEncoding.EncodeToString([]byte(issuer.Cred.Crt.EncodeCertificatePEM())),
+		k8s.IdentityIssuerKeyName, base64.StdEncoding.EncodeToString([]byte(issuer.Cred.EncodePrivateKeyPEM())))
+
+	var testCases = []struct {
+		description         string
+		trustAnchorsPem     string
+		proxies             []string
+		issuerErr           bool
+		expectedRotationErr error
+		expectedProxiesErr  error
+	}{
+		{
+			description:     "no rotation",
+			trustAnchorsPem: currentPEM,
+			proxies:         []string{currentPEM, currentPEM},
+		},
+		{
+			description:         "new trust anchor added",
+			trustAnchorsPem:     oldPEM + currentPEM,
+			proxies:             []string{oldPEM + currentPEM, oldPEM},
+			expectedRotationErr: fmt.Errorf("the issuer chains up to %s; once all proxies trust it and have been issued new certificates, remove the following trust anchors with `linkerd upgrade --identity-remove-trust-anchors-file`:\n\t%s", currentName, oldName),
+			expectedProxiesErr:  fmt.Errorf("1/2 proxies trust the current trust anchors; restart the following pods:\n\tnamespace-1/pod-1: does not trust %s", currentName),
+		},
+		{
+			description:        "old trust anchor removed",
+			trustAnchorsPem:    currentPEM,
+			proxies:            []string{oldPEM + currentPEM, currentPEM},
+			expectedProxiesErr: fmt.Errorf("1/2 proxies trust the current trust anchors; restart the following pods:\n\tnamespace-0/pod-0: still trusts removed %s", oldName),
+		},
+		{
+			description:     "issuer doesn't chain up to the trust anchors",
+			trustAnchorsPem: oldPEM,
+			proxies:         []string{oldPEM},
+			issuerErr:       true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase // pin
+		t.Run(testCase.description, func(t *testing.T) {
+			proxies := []string{}
+			for _, p := range testCase.proxies {
+				proxies = append(proxies, quote(p))
+			}
+
+			hc := NewHealthChecker([]CategoryID{}, &Options{ControlPlaneNamespace: "linkerd"})
+			hc.kubeAPI, err = k8s.NewFakeAPI(append(proxiesWithCertificates(proxies...), linkerdConfigMap(testCase.trustAnchorsPem), issuerSecret)...)
+			if err != nil {
+				t.Fatalf("Unexpected error: %q", err)
+			}
+
+			if err := hc.checkTrustAnchors(); err != nil {
+				t.Fatalf("Unexpected error: %q", err)
+			}
+			if err := hc.checkIssuerTrustAnchor(); (err != nil) != testCase.issuerErr {
+				t.Fatalf("Unexpected issuer check result: %v", err)
+			}
+			if err := hc.checkTrustAnchorRotation(); !reflect.DeepEqual(err, testCase.expectedRotationErr) {
+				t.Fatalf("Error %q does not match expected error: %q", err, testCase.expectedRotationErr)
+			}
+			if err := hc.checkProxiesTrustAnchors(); !reflect.DeepEqual(err, testCase.expectedProxiesErr) {
+				t.Fatalf("Error %q does not match expected error: %q", err, testCase.expectedProxiesErr)
+			}
+		})
+	}
+}
+
 func TestValidateControlPlanePods(t *testing.T) {
 	pod := func(name string, phase corev1.PodPhase, ready bool) corev1.Pod {
 		return corev1.Pod{
@@ -0,0 +1,57 @@
+package identity
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+)
+
+type (
+	// AuditRecord describes a certificate issued by the identity service.
+	AuditRecord struct {
+		Timestamp    time.Time `json:"timestamp"`
+		Identity     string    `json:"identity"`
+		SerialNumber string    `json:"serialNumber"`
+		NotAfter     time.Time `json:"notAfter"`
+		RequesterIP  string    `json:"requesterIP,omitempty"`
+	}
+
+	// AuditSink implementors record the certificates issued by the identity
+	// service. Record may be called concurrently.
+	AuditSink interface {
+		Record(AuditRecord) error
+	}
+
+	// FileAuditSink is an AuditSink appending records to a file, one JSON
+	// object per line.
+	FileAuditSink struct {
+		file *os.File
+		enc  *json.Encoder
+		mu   sync.Mutex
+	}
+)
+
+// NewFileAuditSink opens the file at path for appending, creating it if it
+// doesn't exist.
+func NewFileAuditSink(path string) (*FileAuditSink, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+	return &FileAuditSink{file: file, enc: json.NewEncoder(file)}, nil
+}
+
+// Record appends the record to the file.
+func (s *FileAuditSink) Record(record AuditRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.enc.Encode(record)
+}
+
+// Close closes the underlying file.
+func (s *FileAuditSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.file.Close()
+}
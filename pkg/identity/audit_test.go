@@ -0,0 +1,94 @@
+package identity
+
+import (
+	"bufio"
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	pb "github.com/linkerd/linkerd2-proxy-api/go/identity"
+	"github.com/linkerd/linkerd2/pkg/tls"
+	"google.golang.org/grpc/peer"
+)
+
+func TestCertifyRecordsIssuance(t *testing.T) {
+	issuer, err := tls.GenerateRootCAWithDefaults("identity.linkerd.cluster.local")
+	if err != nil {
+		t.Fatalf("Failed to generate issuer CA: %s", err)
+	}
+
+	dir, err := ioutil.TempDir("", "identity-audit")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %s", err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "audit.log")
+	sink, err := NewFileAuditSink(path)
+	if err != nil {
+		t.Fatalf("Failed to open audit log: %s", err)
+	}
+
+	svc := NewServiceWithIssuer(&fakeValidator{testIdentity, nil}, nil, nil, sink, nil, issuer)
+	ctx := peer.NewContext(context.TODO(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP("10.1.2.3"), Port: 41234},
+	})
+
+	var issued []*pb.CertifyResponse
+	for i := 0; i < 2; i++ {
+		rsp, err := svc.Certify(ctx, &pb.CertifyRequest{
+			Identity:                  testIdentity,
+			Token:                     []byte("token"),
+			CertificateSigningRequest: newTestCSR(t, testIdentity).Raw,
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		issued = append(issued, rsp)
+	}
+	if err := sink.Close(); err != nil {
+		t.Fatalf("Failed to close audit log: %s", err)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("Failed to open audit log: %s", err)
+	}
+	defer file.Close()
+
+	var records []AuditRecord
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var record AuditRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			t.Fatalf("Invalid audit record %q: %s", scanner.Text(), err)
+		}
+		records = append(records, record)
+	}
+	if len(records) != len(issued) {
+		t.Fatalf("Expected %d audit records, got %d", len(issued), len(records))
+	}
+
+	for i, record := range records {
+		crt, err := x509.ParseCertificate(issued[i].GetLeafCertificate())
+		if err != nil {
+			t.Fatalf("Failed to parse certificate: %s", err)
+		}
+		if record.Identity != testIdentity {
+			t.Fatalf("Expected identity %s, got %s", testIdentity, record.Identity)
+		}
+		if record.SerialNumber != crt.SerialNumber.Text(16) {
+			t.Fatalf("Expected serial number %s, got %s", crt.SerialNumber.Text(16), record.SerialNumber)
+		}
+		if !record.NotAfter.Equal(crt.NotAfter) {
+			t.Fatalf("Expected NotAfter %s, got %s", crt.NotAfter, record.NotAfter)
+		}
+		if record.RequesterIP != "10.1.2.3" {
+			t.Fatalf("Expected requester IP 10.1.2.3, got %s", record.RequesterIP)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package identity
+
+import (
+	"sort"
+	"strings"
+)
+
+// Denylist implementors list the identities that must not be certified, e.g.
+// because the workloads they were issued to have been compromised.
+type Denylist interface {
+	// Denied returns true if certificates must not be issued for the given
+	// identity.
+	Denied(identity string) bool
+}
+
+// ParseDenylist parses a list of identities, one per line. Blank lines and
+// lines starting with `#` are ignored.
+func ParseDenylist(data string) map[string]struct{} {
+	identities := make(map[string]struct{})
+	for _, line := range strings.Split(data, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		identities[line] = struct{}{}
+	}
+	return identities
+}
+
+// FormatDenylist serializes a set of identities so that it can be read back
+// with ParseDenylist. Identities are sorted so that the output is stable.
+func FormatDenylist(identities map[string]struct{}) string {
+	sorted := make([]string, 0, len(identities))
+	for id := range identities {
+		sorted = append(sorted, id)
+	}
+	sort.Strings(sorted)
+
+	var b strings.Builder
+	for _, id := range sorted {
+		b.WriteString(id)
+		b.WriteString("\n")
+	}
+	return b.String()
+}
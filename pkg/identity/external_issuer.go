@@ -0,0 +1,199 @@
+package identity
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/linkerd/linkerd2/pkg/tls"
+)
+
+const (
+	// DefaultExternalIssuerTimeout is the default time allowed for an external
+	// signer to respond to a signing request.
+	DefaultExternalIssuerTimeout = 10 * time.Second
+
+	// maxSignerResponseBytes bounds the size of the responses read from an
+	// external signer.
+	maxSignerResponseBytes = 1 << 20
+)
+
+type (
+	// ExternalIssuer implements tls.Issuer by forwarding certificate signing
+	// requests to an external CA over HTTP, so that the issuer's private key
+	// never has to be available to the identity controller.
+	//
+	// Requests and responses follow the format of Vault's PKI `sign` endpoint:
+	// the CSR is POSTed as PEM along with the requested common name, DNS names
+	// and TTL, and the signer responds with the PEM-encoded certificate and its
+	// chain.
+	ExternalIssuer struct {
+		url          string
+		tokenPath    string
+		trustAnchors *x509.CertPool
+		validity     *tls.Validity
+		client       *http.Client
+	}
+
+	// ExternalIssuerError is returned by an ExternalIssuer when the external
+	// signer rejects a signing request.
+	ExternalIssuerError struct {
+		StatusCode int
+		Message    string
+	}
+
+	externalSignRequest struct {
+		CSR        string `json:"csr"`
+		CommonName string `json:"common_name"`
+		AltNames   string `json:"alt_names,omitempty"`
+		URISANs    string `json:"uri_sans,omitempty"`
+		TTL        string `json:"ttl,omitempty"`
+		Format     string `json:"format"`
+	}
+
+	externalSignResponse struct {
+		Data struct {
+			Certificate string   `json:"certificate"`
+			IssuingCA   string   `json:"issuing_ca"`
+			CAChain     []string `json:"ca_chain"`
+		} `json:"data"`
+		Errors []string `json:"errors"`
+	}
+)
+
+// NewExternalIssuer returns an ExternalIssuer which sends signing requests to
+// the given URL.
+//
+// If tokenPath is not empty, the token read from that file is sent with each
+// request in the `X-Vault-Token` header. The file is read on every request so
+// that the token can be rotated without restarting the identity controller.
+//
+// Certificates returned by the signer are only accepted if they chain up to
+// the given trust anchors.
+func NewExternalIssuer(url, tokenPath string, trustAnchors *x509.CertPool, validity *tls.Validity, client *http.Client) *ExternalIssuer {
+	if client == nil {
+		client = &http.Client{Timeout: DefaultExternalIssuerTimeout}
+	}
+	return &ExternalIssuer{
+		url:          url,
+		tokenPath:    tokenPath,
+		trustAnchors: trustAnchors,
+		validity:     validity,
+		client:       client,
+	}
+}
+
+func init() {
+	// Assert that the struct implements the interface.
+	var _ tls.Issuer = &ExternalIssuer{}
+}
+
+// IssueEndEntityCrt sends the CSR to the external signer and returns the
+// certificate it issued.
+func (ei *ExternalIssuer) IssueEndEntityCrt(csr *x509.CertificateRequest) (tls.Crt, error) {
+	if len(csr.DNSNames) == 0 {
+		return tls.Crt{}, errors.New("CSR must have a DNSName")
+	}
+
+	body, err := json.Marshal(ei.signRequest(csr))
+	if err != nil {
+		return tls.Crt{}, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, ei.url, bytes.NewReader(body))
+	if err != nil {
+		return tls.Crt{}, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if ei.tokenPath != "" {
+		token, err := ioutil.ReadFile(ei.tokenPath)
+		if err != nil {
+			return tls.Crt{}, fmt.Errorf("failed to read external signer token: %s", err)
+		}
+		req.Header.Set("X-Vault-Token", strings.TrimSpace(string(token)))
+	}
+
+	rsp, err := ei.client.Do(req)
+	if err != nil {
+		return tls.Crt{}, err
+	}
+	defer rsp.Body.Close()
+
+	rspBody, err := ioutil.ReadAll(http.MaxBytesReader(nil, rsp.Body, maxSignerResponseBytes))
+	if err != nil {
+		return tls.Crt{}, fmt.Errorf("failed to read external signer response: %s", err)
+	}
+
+	var signed externalSignResponse
+	jsonErr := json.Unmarshal(rspBody, &signed)
+
+	if rsp.StatusCode != http.StatusOK {
+		message := strings.TrimSpace(string(rspBody))
+		if jsonErr == nil && len(signed.Errors) > 0 {
+			message = strings.Join(signed.Errors, "; ")
+		}
+		return tls.Crt{}, ExternalIssuerError{StatusCode: rsp.StatusCode, Message: message}
+	}
+	if jsonErr != nil {
+		return tls.Crt{}, fmt.Errorf("invalid external signer response: %s", jsonErr)
+	}
+
+	crt, err := signed.crt()
+	if err != nil {
+		return tls.Crt{}, fmt.Errorf("invalid external signer response: %s", err)
+	}
+
+	if err := crt.Verify(ei.trustAnchors, csr.DNSNames[0]); err != nil {
+		return tls.Crt{}, fmt.Errorf("certificate issued by external signer could not be verified: %s", err)
+	}
+	return *crt, nil
+}
+
+func (ei *ExternalIssuer) signRequest(csr *x509.CertificateRequest) *externalSignRequest {
+	req := &externalSignRequest{
+		CSR:        string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csr.Raw})),
+		CommonName: csr.DNSNames[0],
+		AltNames:   strings.Join(csr.DNSNames, ","),
+		Format:     "pem",
+	}
+	uris := make([]string, len(csr.URIs))
+	for i, uri := range csr.URIs {
+		uris[i] = uri.String()
+	}
+	req.URISANs = strings.Join(uris, ",")
+	if ei.validity != nil && ei.validity.Lifetime != 0 {
+		req.TTL = ei.validity.Lifetime.String()
+	}
+	return req
+}
+
+// crt decodes the certificate and its chain from the signer's response. The
+// chain is read from `ca_chain` if present, and from `issuing_ca` otherwise.
+func (rsp *externalSignResponse) crt() (*tls.Crt, error) {
+	if rsp.Data.Certificate == "" {
+		return nil, errors.New("missing certificate")
+	}
+
+	pems := []string{rsp.Data.Certificate}
+	if len(rsp.Data.CAChain) > 0 {
+		pems = append(pems, rsp.Data.CAChain...)
+	} else if rsp.Data.IssuingCA != "" {
+		pems = append(pems, rsp.Data.IssuingCA)
+	}
+	for i, p := range pems {
+		pems[i] = strings.TrimSpace(p)
+	}
+
+	return tls.DecodePEMCrt(strings.Join(pems, "\n"))
+}
+
+func (e ExternalIssuerError) Error() string {
+	return fmt.Sprintf("external signer responded with status %d: %s", e.StatusCode, e.Message)
+}
@@ -0,0 +1,200 @@
+package identity
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	pb "github.com/linkerd/linkerd2-proxy-api/go/identity"
+	"github.com/linkerd/linkerd2/pkg/tls"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const testIdentity = "foo.ns.serviceaccount.identity.linkerd.cluster.local"
+
+// newTestSigner returns an httptest server which signs CSRs with the given CA
+// the way Vault's PKI sign endpoint does.
+func newTestSigner(t *testing.T, ca *tls.CA, token string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != token {
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"errors":["permission denied"]}`))
+			return
+		}
+
+		var req externalSignRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode signing request: %s", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		block, _ := pem.Decode([]byte(req.CSR))
+		if block == nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"errors":["invalid csr"]}`))
+			return
+		}
+		csr, err := x509.ParseCertificateRequest(block.Bytes)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"errors":["invalid csr"]}`))
+			return
+		}
+		crt, err := ca.IssueEndEntityCrt(csr)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		rsp := externalSignResponse{}
+		rsp.Data.Certificate = crt.EncodeCertificatePEM()
+		rsp.Data.IssuingCA = ca.Cred.Crt.EncodeCertificatePEM()
+		json.NewEncoder(w).Encode(rsp)
+	}))
+}
+
+func newTestCSR(t *testing.T, name string) *x509.CertificateRequest {
+	key, err := tls.GenerateKey()
+	if err != nil {
+		t.Fatalf("Failed to generate key: %s", err)
+	}
+	der, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: name},
+		DNSNames: []string{name},
+	}, key)
+	if err != nil {
+		t.Fatalf("Failed to create CSR: %s", err)
+	}
+	csr, err := x509.ParseCertificateRequest(der)
+	if err != nil {
+		t.Fatalf("Failed to parse CSR: %s", err)
+	}
+	return csr
+}
+
+func newTestIssuerCA(t *testing.T) (*tls.CA, *x509.CertPool) {
+	root, err := tls.GenerateRootCAWithDefaults("root.linkerd.cluster.local")
+	if err != nil {
+		t.Fatalf("Failed to generate root CA: %s", err)
+	}
+	issuer, err := root.GenerateCA("identity.linkerd.cluster.local", tls.Validity{}, 0)
+	if err != nil {
+		t.Fatalf("Failed to generate issuer CA: %s", err)
+	}
+	return issuer, root.Cred.Crt.CertPool()
+}
+
+func TestExternalIssuer(t *testing.T) {
+	ca, trustAnchors := newTestIssuerCA(t)
+	signer := newTestSigner(t, ca, "s3cr3t")
+	defer signer.Close()
+
+	tokenFile, err := ioutil.TempFile("", "external-signer-token")
+	if err != nil {
+		t.Fatalf("Failed to create token file: %s", err)
+	}
+	defer os.Remove(tokenFile.Name())
+	tokenFile.WriteString("s3cr3t\n")
+	tokenFile.Close()
+
+	t.Run("Issues certificates signed by the external signer", func(t *testing.T) {
+		issuer := NewExternalIssuer(signer.URL, tokenFile.Name(), trustAnchors, nil, nil)
+		crt, err := issuer.IssueEndEntityCrt(newTestCSR(t, testIdentity))
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if crt.Certificate.DNSNames[0] != testIdentity {
+			t.Fatalf("Expected certificate for %s, got %v", testIdentity, crt.Certificate.DNSNames)
+		}
+		if len(crt.TrustChain) != 1 || !crt.TrustChain[0].Equal(ca.Cred.Crt.Certificate) {
+			t.Fatalf("Expected the issuer certificate in the trust chain, got %v", crt.TrustChain)
+		}
+	})
+
+	t.Run("Returns the signer's errors", func(t *testing.T) {
+		issuer := NewExternalIssuer(signer.URL, "", trustAnchors, nil, nil)
+		_, err := issuer.IssueEndEntityCrt(newTestCSR(t, testIdentity))
+		expected := ExternalIssuerError{StatusCode: http.StatusForbidden, Message: "permission denied"}
+		if err != expected {
+			t.Fatalf("Expected error %v, got %v", expected, err)
+		}
+	})
+
+	t.Run("Rejects certificates which don't chain up to the trust anchors", func(t *testing.T) {
+		_, otherTrustAnchors := newTestIssuerCA(t)
+		issuer := NewExternalIssuer(signer.URL, tokenFile.Name(), otherTrustAnchors, nil, nil)
+		if _, err := issuer.IssueEndEntityCrt(newTestCSR(t, testIdentity)); err == nil {
+			t.Fatalf("Expected an error, got nothing")
+		}
+	})
+}
+
+func TestCertifyWithExternalIssuer(t *testing.T) {
+	ca, trustAnchors := newTestIssuerCA(t)
+	signer := newTestSigner(t, ca, "")
+	defer signer.Close()
+	failingSigner := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(`{"errors":["Vault is sealed"]}`))
+	}))
+	defer failingSigner.Close()
+	unreachableSigner := httptest.NewServer(http.NotFoundHandler())
+	unreachableSigner.Close()
+
+	req := &pb.CertifyRequest{
+		Identity:                  testIdentity,
+		Token:                     []byte("token"),
+		CertificateSigningRequest: newTestCSR(t, testIdentity).Raw,
+	}
+
+	for _, tt := range []struct {
+		description  string
+		url          string
+		expectedCode codes.Code
+	}{
+		{"a signer which issues certificates", signer.URL, codes.OK},
+		{"a signer which is unavailable", failingSigner.URL, codes.Unavailable},
+		{"a signer which cannot be reached", unreachableSigner.URL, codes.Unavailable},
+	} {
+		tt := tt // pin
+		t.Run(tt.description, func(t *testing.T) {
+			issuer := NewExternalIssuer(tt.url, "", trustAnchors, nil, nil)
+			svc := NewServiceWithIssuer(&fakeValidator{testIdentity, nil}, nil, nil, nil, nil, issuer)
+
+			rsp, err := svc.Certify(context.TODO(), req)
+			if code := status.Code(err); code != tt.expectedCode {
+				t.Fatalf("Expected code %s, got %s (%v)", tt.expectedCode, code, err)
+			}
+			if tt.expectedCode == codes.OK && len(rsp.GetIntermediateCertificates()) != 1 {
+				t.Fatalf("Expected one intermediate certificate, got %d", len(rsp.GetIntermediateCertificates()))
+			}
+		})
+	}
+}
+
+func TestIssuanceErrorCode(t *testing.T) {
+	for _, tt := range []struct {
+		statusCode   int
+		expectedCode codes.Code
+	}{
+		{http.StatusBadRequest, codes.InvalidArgument},
+		{http.StatusForbidden, codes.PermissionDenied},
+		{http.StatusTooManyRequests, codes.ResourceExhausted},
+		{http.StatusBadGateway, codes.Unavailable},
+		{http.StatusNotFound, codes.Internal},
+	} {
+		code := issuanceErrorCode(ExternalIssuerError{StatusCode: tt.statusCode})
+		if code != tt.expectedCode {
+			t.Fatalf("Expected status %d to map to %s, got %s", tt.statusCode, tt.expectedCode, code)
+		}
+	}
+}
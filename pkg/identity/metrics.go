@@ -0,0 +1,76 @@
+package identity
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	labelCode   = "grpc_code"
+	labelResult = "result"
+	labelLimit  = "limit"
+
+	resultIssued    = "issued"
+	resultRejected  = "rejected"
+	resultThrottled = "throttled"
+	resultFailed    = "failed"
+)
+
+var (
+	certIssuanceTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "identity_cert_issuance_total",
+		Help: "A counter for the number of certificates the issuer was asked to sign, by outcome.",
+	}, []string{labelCode})
+
+	certIssuanceLatency = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "identity_cert_issuance_duration_seconds",
+		Help:    "A histogram of the time taken by the issuer to sign certificates, by outcome.",
+		Buckets: []float64{0.001, 0.005, 0.01, 0.05, 0.1, 0.5, 1, 5, 10},
+	}, []string{labelCode})
+
+	certifyRequestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "identity_certify_requests_total",
+		Help: "A counter for the number of certificate requests received from proxies, by result.",
+	}, []string{labelResult})
+
+	certifyThrottledTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "identity_certify_throttled_total",
+		Help: "A counter for the number of certificate requests refused by the rate limits, by limit.",
+	}, []string{labelLimit})
+
+	issuerExpiry = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "identity_cert_issuer_expiry_timestamp_seconds",
+		Help: "The time at which the issuer certificate in use expires, in seconds since the epoch.",
+	})
+)
+
+func observeIssuance(code codes.Code, seconds float64) {
+	labels := prometheus.Labels{labelCode: code.String()}
+	certIssuanceTotal.With(labels).Inc()
+	certIssuanceLatency.With(labels).Observe(seconds)
+}
+
+func observeCertifyRequest(err error) {
+	certifyRequestsTotal.With(prometheus.Labels{labelResult: certifyResult(status.Code(err))}).Inc()
+}
+
+func observeThrottled(limit string) {
+	certifyThrottledTotal.With(prometheus.Labels{labelLimit: limit}).Inc()
+}
+
+// certifyResult buckets the status codes returned by Certify into requests
+// that were served, refused because of the requester, refused because of the
+// rate limits, and failed because of the service itself.
+func certifyResult(code codes.Code) string {
+	switch code {
+	case codes.OK:
+		return resultIssued
+	case codes.ResourceExhausted:
+		return resultThrottled
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.PermissionDenied:
+		return resultRejected
+	}
+	return resultFailed
+}
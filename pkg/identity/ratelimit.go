@@ -0,0 +1,111 @@
+package identity
+
+import (
+	"fmt"
+	"sync"
+
+	lru "github.com/hashicorp/golang-lru"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// maxLimitedIdentities bounds the number of per-identity limiters kept in
+	// memory. The least recently used limiters are dropped first, which only
+	// ever grants an identity a fresh burst.
+	maxLimitedIdentities = 10000
+
+	limitGlobal   = "global"
+	limitIdentity = "identity"
+)
+
+type (
+	// RateLimiter bounds how often certificates are issued, both across all
+	// requesters and for each individual identity.
+	RateLimiter struct {
+		global        *rate.Limiter
+		identityLimit rate.Limit
+		identityBurst int
+		identities    *lru.Cache
+		mu            sync.Mutex
+	}
+
+	// Throttled is an error type returned by a RateLimiter to indicate that a
+	// request exceeded one of its limits.
+	Throttled struct {
+		Limit    string
+		Identity string
+	}
+)
+
+// NewRateLimiter creates a RateLimiter allowing globalRate requests per
+// second overall and identityRate requests per second for each identity, with
+// bursts of up to globalBurst and identityBurst requests respectively. A rate
+// of 0 disables the corresponding limit.
+func NewRateLimiter(globalRate float64, globalBurst int, identityRate float64, identityBurst int) (*RateLimiter, error) {
+	if globalRate < 0 || identityRate < 0 {
+		return nil, fmt.Errorf("rates must not be negative")
+	}
+	if (globalRate > 0 && globalBurst < 1) || (identityRate > 0 && identityBurst < 1) {
+		return nil, fmt.Errorf("bursts must be at least 1 when the corresponding rate is set")
+	}
+
+	identities, err := lru.New(maxLimitedIdentities)
+	if err != nil {
+		return nil, err
+	}
+	return &RateLimiter{
+		global:        rate.NewLimiter(toLimit(globalRate), globalBurst),
+		identityLimit: toLimit(identityRate),
+		identityBurst: identityBurst,
+		identities:    identities,
+	}, nil
+}
+
+func toLimit(r float64) rate.Limit {
+	if r == 0 {
+		return rate.Inf
+	}
+	return rate.Limit(r)
+}
+
+// AllowRequest returns a Throttled error if a new request would exceed the
+// global limit. It is checked before the requester has been authenticated,
+// so that floods of requests don't reach the token validator.
+func (l *RateLimiter) AllowRequest() error {
+	if l == nil || l.global.Allow() {
+		return nil
+	}
+	return Throttled{Limit: limitGlobal}
+}
+
+// AllowIdentity returns a Throttled error if certifying the given identity
+// would exceed its own limit. It must only be called once the requester has
+// been authenticated as that identity, so that one workload can't exhaust
+// another's quota.
+func (l *RateLimiter) AllowIdentity(identity string) error {
+	if l == nil || l.identityLimit == rate.Inf {
+		return nil
+	}
+
+	l.mu.Lock()
+	var limiter *rate.Limiter
+	if v, ok := l.identities.Get(identity); ok {
+		limiter = v.(*rate.Limiter)
+	} else {
+		limiter = rate.NewLimiter(l.identityLimit, l.identityBurst)
+		l.identities.Add(identity, limiter)
+	}
+	l.mu.Unlock()
+
+	if limiter.Allow() {
+		return nil
+	}
+	return Throttled{Limit: limitIdentity, Identity: identity}
+}
+
+func (t Throttled) Error() string {
+	if t.Limit == limitIdentity {
+		return fmt.Sprintf("too many certificate requests for %s", t.Identity)
+	}
+	return "too many certificate requests"
+}
@@ -0,0 +1,143 @@
+package identity
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/linkerd/linkerd2-proxy-api/go/identity"
+	"github.com/linkerd/linkerd2/pkg/tls"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestNewRateLimiter(t *testing.T) {
+	testCases := []struct {
+		name          string
+		globalRate    float64
+		globalBurst   int
+		identityRate  float64
+		identityBurst int
+		err           bool
+	}{
+		{"unlimited", 0, 0, 0, 0, false},
+		{"global and identity limits", 10, 100, 1, 5, false},
+		{"negative rate", -1, 10, 0, 0, true},
+		{"missing global burst", 10, 0, 0, 0, true},
+		{"missing identity burst", 0, 0, 1, 0, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewRateLimiter(tc.globalRate, tc.globalBurst, tc.identityRate, tc.identityBurst)
+			if tc.err && err == nil {
+				t.Fatal("Expected error, got nothing")
+			}
+			if !tc.err && err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestRateLimiterIdentities(t *testing.T) {
+	limiter, err := NewRateLimiter(0, 0, 0.001, 2)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := limiter.AllowIdentity("foo"); err != nil {
+			t.Fatalf("Expected request %d to be allowed, got: %s", i, err)
+		}
+	}
+	err = limiter.AllowIdentity("foo")
+	if err == nil {
+		t.Fatal("Expected request to be throttled")
+	}
+	if expected := "too many certificate requests for foo"; err.Error() != expected {
+		t.Fatalf("Expected error %q, got %q", expected, err)
+	}
+
+	// Other identities have their own quota.
+	if err := limiter.AllowIdentity("bar"); err != nil {
+		t.Fatalf("Expected request to be allowed, got: %s", err)
+	}
+	// The global limit is disabled.
+	if err := limiter.AllowRequest(); err != nil {
+		t.Fatalf("Expected request to be allowed, got: %s", err)
+	}
+}
+
+func TestCertifyThrottled(t *testing.T) {
+	issuer, err := tls.GenerateRootCAWithDefaults("identity.linkerd.cluster.local")
+	if err != nil {
+		t.Fatalf("Failed to generate issuer CA: %s", err)
+	}
+
+	testCases := []struct {
+		name          string
+		globalBurst   int
+		identityBurst int
+		expectedError string
+	}{
+		{
+			"global limit",
+			1, 10,
+			"rpc error: code = ResourceExhausted desc = too many certificate requests",
+		},
+		{
+			"identity limit",
+			10, 1,
+			"rpc error: code = ResourceExhausted desc = too many certificate requests for " + testIdentity,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.name, func(t *testing.T) {
+			limiter, err := NewRateLimiter(0.001, tc.globalBurst, 0.001, tc.identityBurst)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			svc := NewServiceWithIssuer(&fakeValidator{testIdentity, nil}, nil, nil, nil, limiter, issuer)
+			req := &pb.CertifyRequest{
+				Identity:                  testIdentity,
+				Token:                     []byte("token"),
+				CertificateSigningRequest: newTestCSR(t, testIdentity).Raw,
+			}
+
+			if _, err := svc.Certify(context.TODO(), req); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			_, err = svc.Certify(context.TODO(), req)
+			if status.Code(err) != codes.ResourceExhausted {
+				t.Fatalf("Expected ResourceExhausted, got: %v", err)
+			}
+			if err.Error() != tc.expectedError {
+				t.Fatalf("Expected error %q, got %q", tc.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestCertifyResult(t *testing.T) {
+	testCases := []struct {
+		code     codes.Code
+		expected string
+	}{
+		{codes.OK, resultIssued},
+		{codes.ResourceExhausted, resultThrottled},
+		{codes.InvalidArgument, resultRejected},
+		{codes.FailedPrecondition, resultRejected},
+		{codes.PermissionDenied, resultRejected},
+		{codes.Unavailable, resultFailed},
+		{codes.Internal, resultFailed},
+	}
+
+	for _, tc := range testCases {
+		if result := certifyResult(tc.code); result != tc.expected {
+			t.Errorf("Expected %s to be counted as %q, got %q", tc.code, tc.expected, result)
+		}
+	}
+}
@@ -5,6 +5,9 @@ import (
 	"crypto/x509"
 	"errors"
 	"fmt"
+	"net"
+	"net/http"
+	"net/url"
 	"sync"
 	"time"
 
@@ -16,6 +19,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 )
 
@@ -35,6 +39,10 @@ type (
 	// Service implements the gRPC service in terms of a Validator and Issuer.
 	Service struct {
 		validator                                  Validator
+		denylist                                   Denylist
+		spiffeIDs                                  SPIFFEIDs
+		audit                                      AuditSink
+		limiter                                    *RateLimiter
 		trustAnchors                               *x509.CertPool
 		issuer                                     *tls.Issuer
 		issuerMutex                                *sync.RWMutex
@@ -117,14 +125,31 @@ func (svc *Service) loadCredentials() (tls.Issuer, error) {
 		return nil, fmt.Errorf("failed to verify issuer credentials for '%s' with trust anchors: %s", svc.expectedName, err)
 	}
 
+	issuerExpiry.Set(float64(creds.Crt.Certificate.NotAfter.Unix()))
 	log.Debugf("Loaded issuer cert: %s", creds.EncodeCertificatePEM())
 	return tls.NewCA(*creds, *svc.validity), nil
 }
 
-// NewService creates a new identity service.
-func NewService(validator Validator, trustAnchors *x509.CertPool, validity *tls.Validity, recordEvent func(eventType, reason, message string), expectedName, issuerPathCrt, issuerPathKey string) *Service {
+// NewServiceWithIssuer creates a new identity service which signs certificates
+// with the given issuer, such as an ExternalIssuer, rather than with issuer
+// credentials read from disk.
+func NewServiceWithIssuer(validator Validator, denylist Denylist, spiffeIDs SPIFFEIDs, audit AuditSink, limiter *RateLimiter, issuer tls.Issuer) *Service {
+	svc := NewService(validator, denylist, spiffeIDs, audit, limiter, nil, nil, nil, "", "", "")
+	svc.updateIssuer(issuer)
+	return svc
+}
+
+// NewService creates a new identity service. The denylist may be nil if no
+// identities are denied, spiffeIDs may be nil if issued certificates
+// shouldn't carry SPIFFE IDs, audit may be nil if issued certificates don't
+// need to be recorded, and limiter may be nil if requests aren't rate limited.
+func NewService(validator Validator, denylist Denylist, spiffeIDs SPIFFEIDs, audit AuditSink, limiter *RateLimiter, trustAnchors *x509.CertPool, validity *tls.Validity, recordEvent func(eventType, reason, message string), expectedName, issuerPathCrt, issuerPathKey string) *Service {
 	return &Service{
 		validator,
+		denylist,
+		spiffeIDs,
+		audit,
+		limiter,
 		trustAnchors,
 		nil,
 		&sync.RWMutex{},
@@ -144,6 +169,12 @@ func Register(g *grpc.Server, s *Service) {
 
 // Certify validates identity and signs certificates.
 func (svc *Service) Certify(ctx context.Context, req *pb.CertifyRequest) (*pb.CertifyResponse, error) {
+	rsp, err := svc.certify(ctx, req)
+	observeCertifyRequest(err)
+	return rsp, err
+}
+
+func (svc *Service) certify(ctx context.Context, req *pb.CertifyRequest) (*pb.CertifyResponse, error) {
 	svc.issuerMutex.RLock()
 	defer svc.issuerMutex.RUnlock()
 
@@ -152,12 +183,23 @@ func (svc *Service) Certify(ctx context.Context, req *pb.CertifyRequest) (*pb.Ce
 		return nil, status.Error(codes.Unavailable, "cert issuer not ready yet")
 	}
 
+	if err := svc.limiter.AllowRequest(); err != nil {
+		return nil, throttledError(err)
+	}
+
 	// Extract the relevant info from the request.
 	reqIdentity, tok, csr, err := checkRequest(req)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	if err = checkCSR(csr, reqIdentity); err != nil {
+	var spiffeID *url.URL
+	if svc.spiffeIDs != nil {
+		if spiffeID, err = svc.spiffeIDs.SPIFFEID(reqIdentity); err != nil {
+			log.Debugf("no SPIFFE ID for %s: %s", reqIdentity, err)
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if err = checkCSR(csr, reqIdentity, spiffeID); err != nil {
 		log.Debugf("requester sent invalid CSR: %s", err)
 		return nil, status.Error(codes.FailedPrecondition, err.Error())
 	}
@@ -188,12 +230,34 @@ func (svc *Service) Certify(ctx context.Context, req *pb.CertifyRequest) (*pb.Ce
 		return nil, status.Error(codes.FailedPrecondition, msg)
 	}
 
+	// Refuse to certify identities which have been revoked.
+	if svc.denylist != nil && svc.denylist.Denied(tokIdentity) {
+		msg := fmt.Sprintf("identity has been revoked: %s", tokIdentity)
+		log.Info(msg)
+		return nil, status.Error(codes.PermissionDenied, msg)
+	}
+
+	if err = svc.limiter.AllowIdentity(tokIdentity); err != nil {
+		return nil, throttledError(err)
+	}
+
+	// Certificates carry the workload's SPIFFE ID alongside its DNS-form
+	// identity, whether or not the proxy asked for it.
+	if spiffeID != nil {
+		csr.URIs = []*url.URL{spiffeID}
+	}
+
 	// Create a certificate
 	issuer := *svc.issuer
+	start := time.Now()
 	crt, err := issuer.IssueEndEntityCrt(csr)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		code := issuanceErrorCode(err)
+		observeIssuance(code, time.Since(start).Seconds())
+		log.Errorf("failed to issue certificate for %s: %s", reqIdentity, err)
+		return nil, status.Error(code, err.Error())
 	}
+	observeIssuance(codes.OK, time.Since(start).Seconds())
 	crts := crt.ExtractRaw()
 	if len(crts) == 0 {
 		log.Fatal("the issuer provided a certificate without key material")
@@ -201,6 +265,7 @@ func (svc *Service) Certify(ctx context.Context, req *pb.CertifyRequest) (*pb.Ce
 
 	// Bundle issuer crt with certificate so the trust path to the root can be verified.
 	log.Infof("certifying %s until %s", tokIdentity, crt.Certificate.NotAfter)
+	svc.recordIssuance(ctx, tokIdentity, crt.Certificate)
 	validUntil, err := ptypes.TimestampProto(crt.Certificate.NotAfter)
 	if err != nil {
 		log.Errorf("invalid expiry time: %s", err)
@@ -216,6 +281,39 @@ func (svc *Service) Certify(ctx context.Context, req *pb.CertifyRequest) (*pb.Ce
 	return rsp, nil
 }
 
+// issuanceErrorCode maps an error returned by an issuer to the gRPC code
+// returned to the proxy. Failures to reach an external signer are reported as
+// transient so that proxies retry.
+func issuanceErrorCode(err error) codes.Code {
+	switch e := err.(type) {
+	case ExternalIssuerError:
+		switch {
+		case e.StatusCode == http.StatusBadRequest || e.StatusCode == http.StatusUnprocessableEntity:
+			return codes.InvalidArgument
+		case e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden:
+			return codes.PermissionDenied
+		case e.StatusCode == http.StatusTooManyRequests:
+			return codes.ResourceExhausted
+		case e.StatusCode >= http.StatusInternalServerError:
+			return codes.Unavailable
+		}
+	case net.Error:
+		if e.Timeout() {
+			return codes.DeadlineExceeded
+		}
+		return codes.Unavailable
+	}
+	return codes.Internal
+}
+
+func throttledError(err error) error {
+	log.Debug(err)
+	if t, ok := err.(Throttled); ok {
+		observeThrottled(t.Limit)
+	}
+	return status.Error(codes.ResourceExhausted, err.Error())
+}
+
 func checkRequest(req *pb.CertifyRequest) (string, []byte, *x509.CertificateRequest, error) {
 	reqIdentity := req.GetIdentity()
 	if reqIdentity == "" {
@@ -240,7 +338,33 @@ func checkRequest(req *pb.CertifyRequest) (string, []byte, *x509.CertificateRequ
 	return reqIdentity, tok, csr, nil
 }
 
-func checkCSR(csr *x509.CertificateRequest, identity string) error {
+// recordIssuance writes an audit record for the issued certificate. Failing
+// to record it doesn't prevent the certificate from being returned, so that
+// an unavailable sink can't take down the whole mesh.
+func (svc *Service) recordIssuance(ctx context.Context, identity string, crt *x509.Certificate) {
+	if svc.audit == nil {
+		return
+	}
+
+	record := AuditRecord{
+		Timestamp:    time.Now().UTC(),
+		Identity:     identity,
+		SerialNumber: crt.SerialNumber.Text(16),
+		NotAfter:     crt.NotAfter.UTC(),
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		record.RequesterIP = p.Addr.String()
+		if host, _, err := net.SplitHostPort(record.RequesterIP); err == nil {
+			record.RequesterIP = host
+		}
+	}
+
+	if err := svc.audit.Record(record); err != nil {
+		log.Errorf("failed to record issuance of certificate %s for %s: %s", record.SerialNumber, identity, err)
+	}
+}
+
+func checkCSR(csr *x509.CertificateRequest, identity string, spiffeID *url.URL) error {
 	if len(csr.DNSNames) != 1 {
 		return errors.New("CSR must have exactly one DNSName")
 	}
@@ -260,8 +384,20 @@ func checkCSR(csr *x509.CertificateRequest, identity string) error {
 	if len(csr.IPAddresses) > 0 {
 		return errors.New("cannot validate IP addresses")
 	}
-	if len(csr.URIs) > 0 {
-		return errors.New("cannot validate URIs")
+	if spiffeID == nil {
+		if len(csr.URIs) > 0 {
+			return errors.New("cannot validate URIs")
+		}
+	} else {
+		switch len(csr.URIs) {
+		case 0:
+		case 1:
+			if csr.URIs[0].String() != spiffeID.String() {
+				return fmt.Errorf("CSR URI does not match requested identity: csr=%s; req=%s", csr.URIs[0], spiffeID)
+			}
+		default:
+			return errors.New("CSR must have at most one URI")
+		}
 	}
 
 	return nil
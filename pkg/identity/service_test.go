@@ -2,7 +2,13 @@ package identity
 
 import (
 	"context"
+	"crypto/rand"
 	"crypto/x509"
+	"crypto/x509/pkix"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
 	"testing"
 
 	pb "github.com/linkerd/linkerd2-proxy-api/go/identity"
@@ -29,7 +35,7 @@ func (fk *fakeValidator) Validate(context.Context, []byte) (string, error) {
 
 func TestServiceNotReady(t *testing.T) {
 	//ch := make(chan tls.Issuer, 1)
-	svc := NewService(&fakeValidator{"successful-result", nil}, nil, nil, nil, "", "", "")
+	svc := NewService(&fakeValidator{"successful-result", nil}, nil, nil, nil, nil, nil, nil, nil, "", "", "")
 	req := &pb.CertifyRequest{
 		Identity:                  "some-identitiy",
 		Token:                     []byte{},
@@ -50,7 +56,7 @@ func TestServiceNotReady(t *testing.T) {
 }
 
 func TestInvalidRequestArguments(t *testing.T) {
-	svc := NewService(&fakeValidator{"successful-result", nil}, nil, nil, nil, "", "", "")
+	svc := NewService(&fakeValidator{"successful-result", nil}, nil, nil, nil, nil, nil, nil, nil, "", "", "")
 	svc.updateIssuer(&fakeIssuer{tls.Crt{}, nil})
 	fakeData := "fake-data"
 	invalidCsr := pb.CertifyRequest{
@@ -93,3 +99,211 @@ func TestInvalidRequestArguments(t *testing.T) {
 	}
 
 }
+
+type fakeDenylist map[string]struct{}
+
+func (fd fakeDenylist) Denied(id string) bool {
+	_, ok := fd[id]
+	return ok
+}
+
+func TestDeniedIdentity(t *testing.T) {
+	denylist := fakeDenylist{testIdentity: struct{}{}}
+	svc := NewService(&fakeValidator{testIdentity, nil}, denylist, nil, nil, nil, nil, nil, nil, "", "", "")
+	svc.updateIssuer(&fakeIssuer{tls.Crt{}, nil})
+
+	req := &pb.CertifyRequest{
+		Identity:                  testIdentity,
+		Token:                     []byte("token"),
+		CertificateSigningRequest: newTestCSR(t, testIdentity).Raw,
+	}
+
+	_, err := svc.Certify(context.TODO(), req)
+
+	expectedError := "rpc error: code = PermissionDenied desc = identity has been revoked: " + testIdentity
+	if err == nil {
+		t.Fatalf("Expected error but got nothing")
+	}
+	if err.Error() != expectedError {
+		t.Fatalf("Expected error string\"%s\", got \"%s\"", expectedError, err)
+	}
+}
+
+func TestParseDenylist(t *testing.T) {
+	identities := ParseDenylist(`
+# revoked after incident 42
+web.emojivoto.serviceaccount.identity.linkerd.cluster.local
+
+  emoji.emojivoto.serviceaccount.identity.linkerd.cluster.local  
+`)
+	expected := "emoji.emojivoto.serviceaccount.identity.linkerd.cluster.local\n" +
+		"web.emojivoto.serviceaccount.identity.linkerd.cluster.local\n"
+	if actual := FormatDenylist(identities); actual != expected {
+		t.Fatalf("Expected denylist \"%s\", got \"%s\"", expected, actual)
+	}
+}
+
+func TestInitializeWithTrustAnchorsBundle(t *testing.T) {
+	oldRoot, err := tls.GenerateRootCAWithDefaults("identity.linkerd.cluster.local")
+	if err != nil {
+		t.Fatalf("Failed to generate root CA: %s", err)
+	}
+	newRoot, err := tls.GenerateRootCAWithDefaults("identity.linkerd.cluster.local")
+	if err != nil {
+		t.Fatalf("Failed to generate root CA: %s", err)
+	}
+	issuer, err := newRoot.GenerateCA("identity.linkerd.cluster.local", tls.Validity{}, -1)
+	if err != nil {
+		t.Fatalf("Failed to generate issuer CA: %s", err)
+	}
+
+	dir, err := ioutil.TempDir("", "identity-issuer")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %s", err)
+	}
+	defer os.RemoveAll(dir)
+	crtPath := filepath.Join(dir, "crt.pem")
+	keyPath := filepath.Join(dir, "key.pem")
+	if err := ioutil.WriteFile(crtPath, []byte(issuer.Cred.Crt.EncodeCertificatePEM()), 0600); err != nil {
+		t.Fatalf("Failed to write issuer certificate: %s", err)
+	}
+	if err := ioutil.WriteFile(keyPath, []byte(issuer.Cred.EncodePrivateKeyPEM()), 0600); err != nil {
+		t.Fatalf("Failed to write issuer key: %s", err)
+	}
+
+	for _, tt := range []struct {
+		description  string
+		trustAnchors []*tls.CA
+		expectedErr  bool
+	}{
+		{"only the old root is trusted", []*tls.CA{oldRoot}, true},
+		{"both roots are trusted during a rotation", []*tls.CA{oldRoot, newRoot}, false},
+		{"only the new root is trusted", []*tls.CA{newRoot}, false},
+	} {
+		tt := tt // pin
+		t.Run(tt.description, func(t *testing.T) {
+			pool := x509.NewCertPool()
+			for _, root := range tt.trustAnchors {
+				pool.AddCert(root.Cred.Crt.Certificate)
+			}
+
+			svc := NewService(&fakeValidator{testIdentity, nil}, nil, nil, nil, nil, pool, &tls.Validity{}, nil, "", crtPath, keyPath)
+			err := svc.Initialize()
+			if (err != nil) != tt.expectedErr {
+				t.Fatalf("Unexpected result: %v", err)
+			}
+		})
+	}
+}
+
+type fakeSPIFFEIDs struct{}
+
+func (fakeSPIFFEIDs) SPIFFEID(string) (*url.URL, error) {
+	return ServiceAccountSPIFFEID("cluster.local", "ns", "foo"), nil
+}
+
+func newTestCSRWithURIs(t *testing.T, name string, uris ...string) *x509.CertificateRequest {
+	key, err := tls.GenerateKey()
+	if err != nil {
+		t.Fatalf("Failed to generate key: %s", err)
+	}
+	template := &x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: name},
+		DNSNames: []string{name},
+	}
+	for _, uri := range uris {
+		u, err := url.Parse(uri)
+		if err != nil {
+			t.Fatalf("Failed to parse URI: %s", err)
+		}
+		template.URIs = append(template.URIs, u)
+	}
+	der, err := x509.CreateCertificateRequest(rand.Reader, template, key)
+	if err != nil {
+		t.Fatalf("Failed to create CSR: %s", err)
+	}
+	csr, err := x509.ParseCertificateRequest(der)
+	if err != nil {
+		t.Fatalf("Failed to parse CSR: %s", err)
+	}
+	return csr
+}
+
+func TestCertifySPIFFEID(t *testing.T) {
+	issuer, err := tls.GenerateRootCAWithDefaults("identity.linkerd.cluster.local")
+	if err != nil {
+		t.Fatalf("Failed to generate issuer CA: %s", err)
+	}
+	spiffeID := "spiffe://cluster.local/ns/ns/sa/foo"
+
+	for _, tt := range []struct {
+		description   string
+		spiffeIDs     SPIFFEIDs
+		uris          []string
+		expectedURIs  []string
+		expectedError string
+	}{
+		{
+			description: "doesn't add a SPIFFE ID when disabled",
+		},
+		{
+			description:   "rejects URIs when disabled",
+			uris:          []string{spiffeID},
+			expectedError: "rpc error: code = FailedPrecondition desc = cannot validate URIs",
+		},
+		{
+			description:  "adds the SPIFFE ID to CSRs without URIs",
+			spiffeIDs:    fakeSPIFFEIDs{},
+			expectedURIs: []string{spiffeID},
+		},
+		{
+			description:  "accepts CSRs with the matching SPIFFE ID",
+			spiffeIDs:    fakeSPIFFEIDs{},
+			uris:         []string{spiffeID},
+			expectedURIs: []string{spiffeID},
+		},
+		{
+			description:   "rejects CSRs with another SPIFFE ID",
+			spiffeIDs:     fakeSPIFFEIDs{},
+			uris:          []string{"spiffe://cluster.local/ns/ns/sa/bar"},
+			expectedError: "rpc error: code = FailedPrecondition desc = CSR URI does not match requested identity: csr=spiffe://cluster.local/ns/ns/sa/bar; req=" + spiffeID,
+		},
+	} {
+		tt := tt // pin
+		t.Run(tt.description, func(t *testing.T) {
+			svc := NewServiceWithIssuer(&fakeValidator{testIdentity, nil}, nil, tt.spiffeIDs, nil, nil, issuer)
+			req := &pb.CertifyRequest{
+				Identity:                  testIdentity,
+				Token:                     []byte("token"),
+				CertificateSigningRequest: newTestCSRWithURIs(t, testIdentity, tt.uris...).Raw,
+			}
+
+			rsp, err := svc.Certify(context.TODO(), req)
+			if tt.expectedError != "" {
+				if err == nil {
+					t.Fatalf("Expected error but got nothing")
+				}
+				if err.Error() != tt.expectedError {
+					t.Fatalf("Expected error string\"%s\", got \"%s\"", tt.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			crt, err := x509.ParseCertificate(rsp.GetLeafCertificate())
+			if err != nil {
+				t.Fatalf("Failed to parse certificate: %s", err)
+			}
+			if len(crt.URIs) != len(tt.expectedURIs) {
+				t.Fatalf("Expected URIs %v, got %v", tt.expectedURIs, crt.URIs)
+			}
+			for i, uri := range crt.URIs {
+				if uri.String() != tt.expectedURIs[i] {
+					t.Fatalf("Expected URIs %v, got %v", tt.expectedURIs, crt.URIs)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package identity
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// SPIFFEIDs implementors derive the SPIFFE ID of the workload a DNS-form
+// identity has been issued to, so that it can be added to its certificate as
+// a URI SAN.
+type SPIFFEIDs interface {
+	// SPIFFEID returns the SPIFFE ID matching the given identity, or an error
+	// if the identity has no SPIFFE equivalent.
+	SPIFFEID(identity string) (*url.URL, error)
+}
+
+// ServiceAccountSPIFFEID formats the SPIFFE ID of a Kubernetes service
+// account, i.e. spiffe://<trust-domain>/ns/<namespace>/sa/<name>.
+func ServiceAccountSPIFFEID(trustDomain, namespace, name string) *url.URL {
+	return &url.URL{
+		Scheme: "spiffe",
+		Host:   trustDomain,
+		Path:   fmt.Sprintf("/ns/%s/sa/%s", namespace, name),
+	}
+}
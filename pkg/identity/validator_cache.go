@@ -0,0 +1,56 @@
+package identity
+
+import (
+	"context"
+	"crypto/sha256"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+)
+
+// ValidatorCache wraps a Validator and remembers the identities of
+// successfully validated tokens for a short time, so that proxies retrying
+// their certificate requests don't each cost a round trip to the Kubernetes
+// API. Failed validations are never cached.
+type ValidatorCache struct {
+	validator Validator
+	ttl       time.Duration
+	entries   *lru.Cache
+	now       func() time.Time
+}
+
+type validatorCacheEntry struct {
+	identity string
+	expiry   time.Time
+}
+
+// NewValidatorCache creates a ValidatorCache holding up to size validated
+// tokens, each for at most ttl.
+func NewValidatorCache(validator Validator, ttl time.Duration, size int) (*ValidatorCache, error) {
+	entries, err := lru.New(size)
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorCache{validator, ttl, entries, time.Now}, nil
+}
+
+// Validate returns the cached identity for the token if it was validated
+// less than ttl ago, and otherwise validates it with the wrapped Validator.
+func (c *ValidatorCache) Validate(ctx context.Context, tok []byte) (string, error) {
+	// Tokens are credentials, so only their digests are kept in memory.
+	key := sha256.Sum256(tok)
+	if v, ok := c.entries.Get(key); ok {
+		entry := v.(validatorCacheEntry)
+		if c.now().Before(entry.expiry) {
+			return entry.identity, nil
+		}
+		c.entries.Remove(key)
+	}
+
+	identity, err := c.validator.Validate(ctx, tok)
+	if err != nil {
+		return "", err
+	}
+	c.entries.Add(key, validatorCacheEntry{identity, c.now().Add(c.ttl)})
+	return identity, nil
+}
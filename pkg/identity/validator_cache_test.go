@@ -0,0 +1,66 @@
+package identity
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+type countingValidator struct {
+	fakeValidator
+	calls int
+}
+
+func (cv *countingValidator) Validate(ctx context.Context, tok []byte) (string, error) {
+	cv.calls++
+	return cv.fakeValidator.Validate(ctx, tok)
+}
+
+func TestValidatorCache(t *testing.T) {
+	now := time.Unix(1500000000, 0)
+	validator := &countingValidator{fakeValidator: fakeValidator{testIdentity, nil}}
+	cache, err := NewValidatorCache(validator, time.Minute, 10)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	cache.now = func() time.Time { return now }
+
+	validate := func(tok string, expectedCalls int) {
+		t.Helper()
+		identity, err := cache.Validate(context.TODO(), []byte(tok))
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if identity != testIdentity {
+			t.Fatalf("Expected identity %s, got %s", testIdentity, identity)
+		}
+		if validator.calls != expectedCalls {
+			t.Fatalf("Expected %d validations, got %d", expectedCalls, validator.calls)
+		}
+	}
+
+	validate("token", 1)
+	validate("token", 1)
+	validate("other-token", 2)
+
+	now = now.Add(time.Minute)
+	validate("token", 3)
+}
+
+func TestValidatorCacheSkipsFailures(t *testing.T) {
+	validator := &countingValidator{fakeValidator: fakeValidator{"", NotAuthenticated{}}}
+	cache, err := NewValidatorCache(validator, time.Minute, 10)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	for i := 1; i <= 2; i++ {
+		_, err := cache.Validate(context.TODO(), []byte("token"))
+		if _, ok := err.(NotAuthenticated); !ok {
+			t.Fatalf("Expected NotAuthenticated, got: %v", err)
+		}
+		if validator.calls != i {
+			t.Fatalf("Expected %d validations, got %d", i, validator.calls)
+		}
+	}
+}
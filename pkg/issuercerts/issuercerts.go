@@ -1,8 +1,12 @@
 package issuercerts
 
 import (
+	"bytes"
 	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
 	"crypto/x509"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"time"
@@ -112,28 +116,110 @@ func CheckCertTimeValidity(cert *x509.Certificate) error {
 	return nil
 }
 
+// SupportedAlgorithms describes the public key algorithms of the issuer and
+// trust anchor certificates that the proxies are able to verify.
+const SupportedAlgorithms = "ECDSA P-256 or P-384, RSA with 2048 to 8192 bit keys, or Ed25519"
+
 // CheckCertAlgoRequirements ensures the certificate respects with the constraints
 // we have posed on the public key and signature algorithms
 func CheckCertAlgoRequirements(cert *x509.Certificate) error {
-	if cert.PublicKeyAlgorithm == x509.ECDSA {
-		// this si a safe cast here as wel know we are using ECDSA
-		k, ok := cert.PublicKey.(*ecdsa.PublicKey)
-		if !ok {
-			return fmt.Errorf("expected ecdsa.PublicKey but got something %v", cert.PublicKey)
+	switch k := cert.PublicKey.(type) {
+	case *ecdsa.PublicKey:
+		if size := k.Params().BitSize; size != 256 && size != 384 {
+			return fmt.Errorf("must use P-256 or P-384 curve for public key, instead P-%d was used", size)
 		}
-		if k.Params().BitSize != 256 {
-			return fmt.Errorf("must use P-256 curve for public key, instead P-%d was used", k.Params().BitSize)
+	case *rsa.PublicKey:
+		if size := k.N.BitLen(); size < 2048 || size > 8192 {
+			return fmt.Errorf("must use a 2048 to 8192 bit RSA public key, instead a %d bit key was used", size)
 		}
-	} else {
-		return fmt.Errorf("must use ECDSA for public key algorithm, instead %s was used", cert.PublicKeyAlgorithm)
+	case ed25519.PublicKey:
+	default:
+		return fmt.Errorf("must use %s for public key algorithm, instead %s was used", SupportedAlgorithms, cert.PublicKeyAlgorithm)
 	}
 
-	if cert.SignatureAlgorithm != x509.ECDSAWithSHA256 {
-		return fmt.Errorf("must be signed by an ECDSA P-256 key, instead %s was used", cert.SignatureAlgorithm)
+	switch cert.SignatureAlgorithm {
+	case x509.ECDSAWithSHA256, x509.ECDSAWithSHA384,
+		x509.SHA256WithRSA, x509.SHA384WithRSA, x509.SHA512WithRSA,
+		x509.SHA256WithRSAPSS, x509.SHA384WithRSAPSS, x509.SHA512WithRSAPSS,
+		x509.PureEd25519:
+	default:
+		return fmt.Errorf("must be signed by an %s key with SHA-256 or stronger, instead %s was used", SupportedAlgorithms, cert.SignatureAlgorithm)
 	}
 	return nil
 }
 
+// MergeTrustAnchors adds the roots in added to the trust anchors bundle, so
+// that proxies trust certificates chaining up to any of them. Roots which are
+// already part of the bundle are not duplicated.
+func MergeTrustAnchors(bundle, added string) (string, error) {
+	anchors, err := decodeTrustAnchors(bundle)
+	if err != nil {
+		return "", err
+	}
+	newAnchors, err := decodeTrustAnchors(added)
+	if err != nil {
+		return "", err
+	}
+
+	for _, c := range newAnchors {
+		if indexOfCertificate(anchors, c) < 0 {
+			anchors = append(anchors, c)
+		}
+	}
+	return tls.EncodeCertificatesPEM(anchors...), nil
+}
+
+// RemoveTrustAnchors removes the roots in removed from the trust anchors
+// bundle. It fails if one of them is not part of the bundle or if no root
+// would be left.
+func RemoveTrustAnchors(bundle, removed string) (string, error) {
+	anchors, err := decodeTrustAnchors(bundle)
+	if err != nil {
+		return "", err
+	}
+	oldAnchors, err := decodeTrustAnchors(removed)
+	if err != nil {
+		return "", err
+	}
+
+	for _, c := range oldAnchors {
+		if indexOfCertificate(anchors, c) < 0 {
+			return "", fmt.Errorf("trust anchor %s is not part of the trust anchors bundle", c.Subject.CommonName)
+		}
+	}
+
+	remaining := []*x509.Certificate{}
+	for _, c := range anchors {
+		if indexOfCertificate(oldAnchors, c) < 0 {
+			remaining = append(remaining, c)
+		}
+	}
+	if len(remaining) == 0 {
+		return "", errors.New("the trust anchors bundle must contain at least one root")
+	}
+	return tls.EncodeCertificatesPEM(remaining...), nil
+}
+
+func decodeTrustAnchors(pem string) ([]*x509.Certificate, error) {
+	anchors, err := tls.DecodePEMCertificates(pem)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read trust anchors: %s", err)
+	}
+	if len(anchors) == 0 {
+		return nil, errors.New("failed to read trust anchors: no certificates found")
+	}
+	return anchors, nil
+}
+
+func indexOfCertificate(certs []*x509.Certificate, c *x509.Certificate) int {
+	for i, other := range certs {
+		if bytes.Equal(other.Raw, c.Raw) {
+			return i
+		}
+	}
+	return -1
+}
+
 // VerifyAndBuildCreds builds and validates the creds out of the data in IssuerCertData
 func (ic *IssuerCertData) VerifyAndBuildCreds(dnsName string) (*tls.Cred, error) {
 	creds, err := tls.ValidateAndCreateCreds(ic.IssuerCrt, ic.IssuerKey)
@@ -0,0 +1,128 @@
+package issuercerts
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+
+	"github.com/linkerd/linkerd2/pkg/tls"
+)
+
+func newTestRoot(t *testing.T) string {
+	root, err := tls.GenerateRootCAWithDefaults("identity.linkerd.cluster.local")
+	if err != nil {
+		t.Fatalf("Failed to generate root CA: %s", err)
+	}
+	return root.Cred.Crt.EncodeCertificatePEM()
+}
+
+func TestMergeTrustAnchors(t *testing.T) {
+	oldRoot := newTestRoot(t)
+	newRoot := newTestRoot(t)
+
+	t.Run("Adds the new roots to the bundle", func(t *testing.T) {
+		bundle, err := MergeTrustAnchors(oldRoot, newRoot)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if bundle != oldRoot+newRoot {
+			t.Fatalf("Expected both roots in the bundle, got:\n%s", bundle)
+		}
+	})
+
+	t.Run("Doesn't duplicate roots already in the bundle", func(t *testing.T) {
+		bundle, err := MergeTrustAnchors(oldRoot+newRoot, newRoot)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if bundle != oldRoot+newRoot {
+			t.Fatalf("Expected both roots in the bundle, got:\n%s", bundle)
+		}
+	})
+
+	t.Run("Rejects invalid roots", func(t *testing.T) {
+		if _, err := MergeTrustAnchors(oldRoot, "not-a-certificate"); err == nil {
+			t.Fatalf("Expected an error, got nothing")
+		}
+	})
+}
+
+func TestRemoveTrustAnchors(t *testing.T) {
+	oldRoot := newTestRoot(t)
+	newRoot := newTestRoot(t)
+
+	t.Run("Removes the old roots from the bundle", func(t *testing.T) {
+		bundle, err := RemoveTrustAnchors(oldRoot+newRoot, oldRoot)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if bundle != newRoot {
+			t.Fatalf("Expected only the new root in the bundle, got:\n%s", bundle)
+		}
+	})
+
+	t.Run("Rejects roots which are not part of the bundle", func(t *testing.T) {
+		if _, err := RemoveTrustAnchors(newRoot, oldRoot); err == nil {
+			t.Fatalf("Expected an error, got nothing")
+		}
+	})
+
+	t.Run("Rejects removing every root", func(t *testing.T) {
+		if _, err := RemoveTrustAnchors(oldRoot+newRoot, oldRoot+newRoot); err == nil {
+			t.Fatalf("Expected an error, got nothing")
+		}
+	})
+}
+
+func TestCheckCertAlgoRequirements(t *testing.T) {
+	generate := map[string]func() (crypto.Signer, error){
+		"ECDSA P-256": func() (crypto.Signer, error) { return tls.GenerateKey() },
+		"ECDSA P-384": func() (crypto.Signer, error) { return ecdsa.GenerateKey(elliptic.P384(), rand.Reader) },
+		"ECDSA P-521": func() (crypto.Signer, error) { return ecdsa.GenerateKey(elliptic.P521(), rand.Reader) },
+		"RSA 1024":    func() (crypto.Signer, error) { return rsa.GenerateKey(rand.Reader, 1024) },
+		"RSA 3072":    func() (crypto.Signer, error) { return rsa.GenerateKey(rand.Reader, 3072) },
+		"Ed25519": func() (crypto.Signer, error) {
+			_, key, err := ed25519.GenerateKey(rand.Reader)
+			return key, err
+		},
+	}
+
+	for _, tt := range []struct {
+		algorithm     string
+		expectedError string
+	}{
+		{"ECDSA P-256", ""},
+		{"ECDSA P-384", ""},
+		{"ECDSA P-521", "must use P-256 or P-384 curve for public key, instead P-521 was used"},
+		{"RSA 1024", "must use a 2048 to 8192 bit RSA public key, instead a 1024 bit key was used"},
+		{"RSA 3072", ""},
+		{"Ed25519", ""},
+	} {
+		tt := tt // pin
+		t.Run(tt.algorithm, func(t *testing.T) {
+			key, err := generate[tt.algorithm]()
+			if err != nil {
+				t.Fatalf("Failed to generate key: %s", err)
+			}
+			root, err := tls.CreateRootCA("identity.linkerd.cluster.local", key, tls.Validity{})
+			if err != nil {
+				t.Fatalf("Failed to generate root CA: %s", err)
+			}
+
+			err = CheckCertAlgoRequirements(root.Cred.Crt.Certificate)
+			if tt.expectedError == "" {
+				if err != nil {
+					t.Fatalf("Unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.expectedError {
+				t.Fatalf("Expected error %q, got %v", tt.expectedError, err)
+			}
+		})
+	}
+}
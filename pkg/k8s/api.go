@@ -41,7 +41,13 @@ func NewAPI(configPath, kubeContext string, impersonate string, timeout time.Dur
 	if err != nil {
 		return nil, fmt.Errorf("error configuring Kubernetes API client: %v", err)
 	}
+	return NewAPIForConfig(config, impersonate, timeout)
+}
 
+// NewAPIForConfig returns a client for accessing the cluster described by the
+// given config.  It is used when the config is not read from a kubeconfig
+// file, such as for remote clusters whose kubeconfig is stored in a Secret.
+func NewAPIForConfig(config *rest.Config, impersonate string, timeout time.Duration) (*KubernetesAPI, error) {
 	// k8s' client-go doesn't support injecting context
 	// https://github.com/kubernetes/kubernetes/issues/46503
 	// but we can set the timeout manually
@@ -49,9 +49,11 @@ var AllResources = []string{
 	TrafficSplit,
 }
 
-// StatAllResourceTypes represents the resources to query in StatSummary when Resource.Type is "all"
+// StatAllResourceTypes represents the resources to query in StatSummary when
+// Resource.Type is "all". Namespaces aren't among them: requests asking for
+// rollups get the namespace stats and their totals alongside these, which
+// saves the web process its separate namespace query.
 var StatAllResourceTypes = []string{
-	// TODO: add Namespace here to decrease queries from the web process
 	DaemonSet,
 	StatefulSet,
 	Job,
@@ -191,6 +191,79 @@ const (
 	// disable the proxy from participating in automatic identity.
 	IdentityModeDisabled = "disabled"
 
+	// TopologyModeAnnotation can be set on a Service to make the destination
+	// service take the zone of each endpoint into account when serving
+	// addresses to clients.  Supported values are "prefer-zone" and
+	// "weighted".  The annotation is read when a client subscribes to the
+	// Service, so changing it only affects new subscriptions, i.e. proxies
+	// resolving the Service after the change.
+	TopologyModeAnnotation = Prefix + "/topology-mode"
+
+	// TopologyModePreferZone is assigned to TopologyModeAnnotation to only
+	// serve endpoints in the client's zone, falling back to all endpoints when
+	// the client's zone has none.
+	TopologyModePreferZone = "prefer-zone"
+
+	// TopologyModeWeighted is assigned to TopologyModeAnnotation to serve all
+	// endpoints, weighting those in the client's zone more heavily.
+	TopologyModeWeighted = "weighted"
+
+	/*
+	 * Service mirroring
+	 */
+
+	// MirrorPrefix is the prefix common to all labels and annotations used
+	// to mirror services across clusters.
+	MirrorPrefix = "mirror." + Prefix
+
+	// MirroredResourceLabel is set to "true" on all the Services, Endpoints and
+	// Namespaces created by the service mirror controller.
+	MirroredResourceLabel = MirrorPrefix + "/mirrored-service"
+
+	// RemoteClusterNameLabel identifies the remote cluster a mirrored
+	// resource was mirrored from.
+	RemoteClusterNameLabel = MirrorPrefix + "/cluster-name"
+
+	// RemoteServiceAnnotation holds the name of the remote Service a mirrored
+	// Service was mirrored from.
+	RemoteServiceAnnotation = MirrorPrefix + "/remote-svc-name"
+
+	// RemoteResourceVersionAnnotation holds the resource version of the
+	// remote Service at the time it was last mirrored.
+	RemoteResourceVersionAnnotation = MirrorPrefix + "/remote-resource-version"
+
+	// ExportedServiceLabel can be set to "true" on a Service to have it
+	// mirrored into other clusters.
+	ExportedServiceLabel = MirrorPrefix + "/exported"
+
+	// MirrorSecretType is the type of the Secrets holding the credentials of
+	// remote clusters to mirror services from.
+	MirrorSecretType = MirrorPrefix + "/remote-kubeconfig"
+
+	// MirrorSecretKubeconfigKey is the key of a remote cluster's kubeconfig in
+	// its Secret.
+	MirrorSecretKubeconfigKey = "kubeconfig"
+
+	// RemoteClusterNameAnnotation is set on a remote cluster's Secret to name
+	// the cluster.
+	RemoteClusterNameAnnotation = MirrorPrefix + "/cluster-name"
+
+	// RemoteGatewayAddressAnnotation is set on a remote cluster's Secret to
+	// the IP address of the gateway through which the remote cluster's
+	// services are reached.
+	RemoteGatewayAddressAnnotation = MirrorPrefix + "/gateway-address"
+
+	/*
+	 * Well-known Kubernetes labels
+	 */
+
+	// ZoneLabel is the label Kubernetes sets on nodes to identify their zone.
+	ZoneLabel = "topology.kubernetes.io/zone"
+
+	// LegacyZoneLabel is the deprecated label Kubernetes sets on nodes to
+	// identify their zone.
+	LegacyZoneLabel = "failure-domain.beta.kubernetes.io/zone"
+
 	/*
 	 * Component Names
 	 */
@@ -229,6 +302,18 @@ const (
 	// IdentityIssuerTrustAnchorsNameExternal is the issuer's certificate file (when using cert-manager).
 	IdentityIssuerTrustAnchorsNameExternal = "ca.crt"
 
+	// IdentityTrustAnchorSecretName is the name of the Secret that stores the
+	// trust anchor credentials used to renew the issuer certificate.
+	IdentityTrustAnchorSecretName = "linkerd-identity-trust-anchor"
+
+	// IdentityDenylistConfigMapName is the name of the ConfigMap listing the
+	// identities the identity service refuses to certify.
+	IdentityDenylistConfigMapName = "linkerd-identity-denylist"
+
+	// IdentityDenylistKey is the key of the IdentityDenylistConfigMapName
+	// ConfigMap holding the denied identities, one per line.
+	IdentityDenylistKey = "identities"
+
 	// ProxyPortName is the name of the Linkerd Proxy's proxy port.
 	ProxyPortName = "linkerd-proxy"
 
@@ -11,6 +11,8 @@ import (
 
 	sp "github.com/linkerd/linkerd2/controller/gen/apis/serviceprofile/v1alpha2" // TODO: pkg/profiles should not depend on controller/gen
 	"github.com/linkerd/linkerd2/pkg/k8s"
+	"github.com/linkerd/linkerd2/pkg/slo"
+	"github.com/prometheus/common/model"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"sigs.k8s.io/yaml"
@@ -107,6 +109,54 @@ func Validate(data []byte) error {
 		}
 	}
 
+	for _, objective := range serviceProfile.Spec.Objectives {
+		err := validateObjective(&serviceProfile, objective)
+		if err != nil {
+			return fmt.Errorf("ServiceProfile \"%s\" has an invalid objective: %s", serviceProfile.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func validateObjective(profile *sp.ServiceProfile, objective *sp.Objective) error {
+	if objective.Name == "" {
+		return errors.New("objective has no name")
+	}
+
+	if objective.Window == "" {
+		return fmt.Errorf("objective \"%s\" has no window", objective.Name)
+	}
+	if _, err := model.ParseDuration(objective.Window); err != nil {
+		return fmt.Errorf("objective \"%s\" has an invalid window: %s", objective.Name, err)
+	}
+
+	if objective.Route != "" {
+		found := false
+		for _, route := range profile.Spec.Routes {
+			found = found || route.Name == objective.Route
+		}
+		if !found {
+			return fmt.Errorf("objective \"%s\" refers to unknown route \"%s\"", objective.Name, objective.Route)
+		}
+	}
+
+	if objective.SuccessRate == 0 && objective.Latency == nil {
+		return fmt.Errorf("objective \"%s\" has neither a successRate nor a latency target", objective.Name)
+	}
+	if objective.SuccessRate != 0 && (objective.SuccessRate < 0 || objective.SuccessRate >= 1) {
+		return fmt.Errorf("objective \"%s\" successRate must be between 0 and 1: %f", objective.Name, objective.SuccessRate)
+	}
+
+	if objective.Latency != nil {
+		if _, err := slo.ParseLatencyThreshold(objective.Latency.Threshold); err != nil {
+			return fmt.Errorf("objective \"%s\" has an invalid latency threshold: %s", objective.Name, err)
+		}
+		if objective.Latency.Target <= 0 || objective.Latency.Target >= 1 {
+			return fmt.Errorf("objective \"%s\" latency target must be between 0 and 1: %f", objective.Name, objective.Latency.Target)
+		}
+	}
+
 	return nil
 }
 
@@ -405,6 +405,122 @@ spec:
       method: GET
       pathRegex: /route-1`,
 		},
+		{
+			err: nil,
+			sp: `apiVersion: linkerd.io/v1alpha2
+kind: ServiceProfile
+metadata:
+  name: name.ns.svc.cluster.local
+  namespace: linkerd-ns
+spec:
+  routes:
+  - name: name-1
+    condition:
+      method: GET
+      pathRegex: /route-1
+  objectives:
+  - name: availability
+    window: 30d
+    successRate: 0.999
+  - name: route-latency
+    route: name-1
+    window: 7d
+    latency:
+      threshold: 300ms
+      target: 0.99`,
+		},
+		{
+			err: errors.New("ServiceProfile \"name.ns.svc.cluster.local\" has an invalid objective: objective \"availability\" refers to unknown route \"name-2\""),
+			sp: `apiVersion: linkerd.io/v1alpha2
+kind: ServiceProfile
+metadata:
+  name: name.ns.svc.cluster.local
+  namespace: linkerd-ns
+spec:
+  routes:
+  - name: name-1
+    condition:
+      method: GET
+      pathRegex: /route-1
+  objectives:
+  - name: availability
+    route: name-2
+    window: 30d
+    successRate: 0.999`,
+		},
+		{
+			err: errors.New("ServiceProfile \"name.ns.svc.cluster.local\" has an invalid objective: objective \"availability\" has neither a successRate nor a latency target"),
+			sp: `apiVersion: linkerd.io/v1alpha2
+kind: ServiceProfile
+metadata:
+  name: name.ns.svc.cluster.local
+  namespace: linkerd-ns
+spec:
+  routes:
+  - name: name-1
+    condition:
+      method: GET
+      pathRegex: /route-1
+  objectives:
+  - name: availability
+    window: 30d`,
+		},
+		{
+			err: errors.New("ServiceProfile \"name.ns.svc.cluster.local\" has an invalid objective: objective \"availability\" successRate must be between 0 and 1: 1.500000"),
+			sp: `apiVersion: linkerd.io/v1alpha2
+kind: ServiceProfile
+metadata:
+  name: name.ns.svc.cluster.local
+  namespace: linkerd-ns
+spec:
+  routes:
+  - name: name-1
+    condition:
+      method: GET
+      pathRegex: /route-1
+  objectives:
+  - name: availability
+    window: 30d
+    successRate: 1.5`,
+		},
+		{
+			err: errors.New("ServiceProfile \"name.ns.svc.cluster.local\" has an invalid objective: objective \"availability\" has an invalid window: not a valid duration string: \"thirty days\""),
+			sp: `apiVersion: linkerd.io/v1alpha2
+kind: ServiceProfile
+metadata:
+  name: name.ns.svc.cluster.local
+  namespace: linkerd-ns
+spec:
+  routes:
+  - name: name-1
+    condition:
+      method: GET
+      pathRegex: /route-1
+  objectives:
+  - name: availability
+    window: thirty days
+    successRate: 0.999`,
+		},
+		{
+			err: errors.New("ServiceProfile \"name.ns.svc.cluster.local\" has an invalid objective: objective \"latency\" has an invalid latency threshold: 250ms isn't one of the proxy's latency bucket bounds"),
+			sp: `apiVersion: linkerd.io/v1alpha2
+kind: ServiceProfile
+metadata:
+  name: name.ns.svc.cluster.local
+  namespace: linkerd-ns
+spec:
+  routes:
+  - name: name-1
+    condition:
+      method: GET
+      pathRegex: /route-1
+  objectives:
+  - name: latency
+    window: 30d
+    latency:
+      threshold: 250ms
+      target: 0.99`,
+		},
 	}
 
 	for id, exp := range expectations {
@@ -96,4 +96,21 @@ spec:
   #   purposes of calculating the retryRatio.  A higher value considers a larger
   #   window and therefore allows burstier retries.
   #   ttl: 10s
+
+  # A service profile can also declare service level objectives, which
+  # "linkerd slo" reports the error budgets of and "linkerd slo rules" generates
+  # Prometheus alerting rules for.
+  # objectives:
+  # - name: availability
+  #   An objective can be restricted to one of the routes above.
+  #   route: '/authors/{id}'
+  #   The window the objective is evaluated over.
+  #   window: 30d
+  #   The ratio of responses which must be successful.
+  #   successRate: 0.999
+  #   The ratio of responses which must be faster than the threshold, which
+  #   must be one of the bounds of the proxy's latency buckets.
+  #   latency:
+  #     threshold: 300ms
+  #     target: 0.99
 `
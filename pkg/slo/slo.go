@@ -0,0 +1,257 @@
+// Package slo evaluates the service level objectives declared in
+// ServiceProfiles against the route metrics reported by the proxies.
+package slo
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	sp "github.com/linkerd/linkerd2/controller/gen/apis/serviceprofile/v1alpha2"
+	"github.com/prometheus/common/model"
+)
+
+// The kinds of service level indicators.
+const (
+	SuccessRate = "success_rate"
+	Latency     = "latency"
+)
+
+// latencyBucketsMs are the bounds of the proxy's latency histogram buckets,
+// which latency thresholds must be one of.
+var latencyBucketsMs = []float64{
+	1, 2, 3, 4, 5,
+	10, 20, 30, 40, 50,
+	100, 200, 300, 400, 500,
+	1000, 2000, 3000, 4000, 5000,
+	10000, 20000, 30000, 40000, 50000,
+}
+
+// Indicator is a service level indicator of an objective, with its target.
+type Indicator struct {
+	Namespace string
+	// Service is the name of the ServiceProfile declaring the objective.
+	Service   string
+	Objective string
+	Route     string
+	Window    model.Duration
+	Kind      string
+	// Target is the ratio of good events the objective aims for.
+	Target float64
+	// LatencyThresholdMs is the latency under which responses are good, for
+	// latency indicators.
+	LatencyThresholdMs float64
+}
+
+// BurnRateAlert fires when an objective's error budget is spent at least
+// Threshold times faster than it would be if spent evenly over the
+// objective's window, over both LongWindow and ShortWindow.
+type BurnRateAlert struct {
+	LongWindow  model.Duration
+	ShortWindow model.Duration
+	Threshold   float64
+	Severity    string
+}
+
+// RuleGroups is a Prometheus rules file.
+type RuleGroups struct {
+	Groups []RuleGroup `json:"groups"`
+}
+
+// RuleGroup is a group of Prometheus rules.
+type RuleGroup struct {
+	Name  string `json:"name"`
+	Rules []Rule `json:"rules"`
+}
+
+// Rule is a Prometheus alerting rule.
+type Rule struct {
+	Alert       string            `json:"alert"`
+	Expr        string            `json:"expr"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// burnRateAlerts are the multiwindow burn rate alerts recommended by the Site
+// Reliability Workbook. For a 30 days objective, they fire once 2%, 5%, 10%
+// and 10% of the error budget were spent over their long window.
+var burnRateAlerts = []struct {
+	long, short string
+	threshold   float64
+	severity    string
+}{
+	{"1h", "5m", 14.4, "page"},
+	{"6h", "30m", 6, "page"},
+	{"1d", "2h", 3, "ticket"},
+	{"3d", "6h", 1, "ticket"},
+}
+
+// Indicators returns the indicators of the objectives of profile.
+func Indicators(profile *sp.ServiceProfile) ([]Indicator, error) {
+	var indicators []Indicator
+	for _, objective := range profile.Spec.Objectives {
+		window, err := model.ParseDuration(objective.Window)
+		if err != nil {
+			return nil, fmt.Errorf("objective \"%s\" has an invalid window: %s", objective.Name, err)
+		}
+
+		indicator := Indicator{
+			Namespace: profile.Namespace,
+			Service:   profile.Name,
+			Objective: objective.Name,
+			Route:     objective.Route,
+			Window:    window,
+		}
+
+		if objective.SuccessRate != 0 {
+			indicator.Kind = SuccessRate
+			indicator.Target = objective.SuccessRate
+			indicators = append(indicators, indicator)
+		}
+
+		if objective.Latency != nil {
+			threshold, err := ParseLatencyThreshold(objective.Latency.Threshold)
+			if err != nil {
+				return nil, fmt.Errorf("objective \"%s\" has an invalid latency threshold: %s", objective.Name, err)
+			}
+			indicator.Kind = Latency
+			indicator.Target = objective.Latency.Target
+			indicator.LatencyThresholdMs = threshold
+			indicators = append(indicators, indicator)
+		}
+	}
+	return indicators, nil
+}
+
+// ParseLatencyThreshold parses a latency threshold, which must be one of the
+// bounds of the proxy's latency histogram buckets, into milliseconds.
+func ParseLatencyThreshold(threshold string) (float64, error) {
+	d, err := time.ParseDuration(threshold)
+	if err != nil {
+		return 0, err
+	}
+	ms := float64(d) / float64(time.Millisecond)
+	for _, bound := range latencyBucketsMs {
+		if ms == bound {
+			return ms, nil
+		}
+	}
+	return 0, fmt.Errorf("%s isn't one of the proxy's latency bucket bounds", threshold)
+}
+
+// BurnRateAlerts returns the burn rate alerts of the objectives evaluated over
+// window. Alerts over longer windows than the objective's are left out.
+func BurnRateAlerts(window model.Duration) []BurnRateAlert {
+	var alerts []BurnRateAlert
+	for _, a := range burnRateAlerts {
+		long, _ := model.ParseDuration(a.long)
+		short, _ := model.ParseDuration(a.short)
+		if long > window {
+			continue
+		}
+		alerts = append(alerts, BurnRateAlert{
+			LongWindow:  long,
+			ShortWindow: short,
+			Threshold:   a.threshold,
+			Severity:    a.severity,
+		})
+	}
+	return alerts
+}
+
+// BurnRateWindows returns the windows burn rates are evaluated over for the
+// alerts of the objectives evaluated over window, from the shortest.
+func BurnRateWindows(window model.Duration) []model.Duration {
+	seen := make(map[model.Duration]bool)
+	var windows []model.Duration
+	for _, alert := range BurnRateAlerts(window) {
+		for _, w := range []model.Duration{alert.ShortWindow, alert.LongWindow} {
+			if !seen[w] {
+				seen[w] = true
+				windows = append(windows, w)
+			}
+		}
+	}
+	sort.Slice(windows, func(i, j int) bool { return windows[i] < windows[j] })
+	return windows
+}
+
+// ErrorRatioQuery returns the query of the ratio of bad events of the
+// indicator over window. It returns no sample if there was no traffic.
+func (i Indicator) ErrorRatioQuery(window model.Duration) string {
+	labels := fmt.Sprintf(`direction="inbound", dst=~"(%s)(:\\d+)?"`, i.Service)
+	if i.Route != "" {
+		labels += fmt.Sprintf(`, rt_route=%q`, i.Route)
+	}
+
+	if i.Kind == Latency {
+		le := strconv.FormatFloat(i.LatencyThresholdMs, 'f', -1, 64)
+		return fmt.Sprintf(
+			"1 - (sum(increase(route_response_latency_ms_bucket{%s, le=%q}[%s])) / sum(increase(route_response_latency_ms_count{%s}[%s])))",
+			labels, le, window, labels, window)
+	}
+
+	return fmt.Sprintf(
+		`(sum(increase(route_response_total{%s, classification="failure"}[%s])) or vector(0)) / sum(increase(route_response_total{%s}[%s]))`,
+		labels, window, labels, window)
+}
+
+// BurnRateQuery returns the query of the rate the indicator's error budget was
+// spent at over window, relative to spending it evenly over the objective's
+// window.
+func (i Indicator) BurnRateQuery(window model.Duration) string {
+	return fmt.Sprintf("(%s) / %s", i.ErrorRatioQuery(window), formatFloat(1-i.Target))
+}
+
+// formatFloat formats f for PromQL, rounded to hide floating point noise such
+// as 1-0.99 = 0.010000000000000009.
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(math.Round(f*1e9)/1e9, 'f', -1, 64)
+}
+
+// AlertingRules returns the Prometheus alerting rules firing when the error
+// budgets of indicators burn too fast, grouped by service.
+func AlertingRules(indicators []Indicator) RuleGroups {
+	groups := []RuleGroup{}
+	index := make(map[string]int)
+
+	for _, i := range indicators {
+		name := fmt.Sprintf("linkerd-slo-%s-%s", i.Namespace, i.Service)
+		if _, ok := index[name]; !ok {
+			index[name] = len(groups)
+			groups = append(groups, RuleGroup{Name: name})
+		}
+
+		for _, alert := range BurnRateAlerts(i.Window) {
+			threshold := formatFloat(alert.Threshold)
+			labels := map[string]string{
+				"severity":  alert.Severity,
+				"namespace": i.Namespace,
+				"service":   i.Service,
+				"objective": i.Objective,
+				"indicator": i.Kind,
+			}
+			if i.Route != "" {
+				labels["route"] = i.Route
+			}
+
+			groups[index[name]].Rules = append(groups[index[name]].Rules, Rule{
+				Alert: "LinkerdErrorBudgetBurn",
+				Expr: fmt.Sprintf("%s > %s\nand\n%s > %s",
+					i.BurnRateQuery(alert.LongWindow), threshold,
+					i.BurnRateQuery(alert.ShortWindow), threshold),
+				Labels: labels,
+				Annotations: map[string]string{
+					"summary": fmt.Sprintf("%s/%s is burning the %s error budget of objective %s",
+						i.Namespace, i.Service, i.Kind, i.Objective),
+					"description": fmt.Sprintf("Over the last %s and %s, the %s error budget of objective %s over %s was spent more than %s times faster than it can be sustained.",
+						alert.LongWindow, alert.ShortWindow, i.Kind, i.Objective, i.Window, threshold),
+				},
+			})
+		}
+	}
+
+	return RuleGroups{Groups: groups}
+}
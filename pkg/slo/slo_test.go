@@ -0,0 +1,169 @@
+package slo
+
+import (
+	"reflect"
+	"testing"
+
+	sp "github.com/linkerd/linkerd2/controller/gen/apis/serviceprofile/v1alpha2"
+	"github.com/prometheus/common/model"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func mustParseDuration(t *testing.T, s string) model.Duration {
+	d, err := model.ParseDuration(s)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	return d
+}
+
+func TestIndicators(t *testing.T) {
+	profile := &sp.ServiceProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "books.booksapp.svc.cluster.local", Namespace: "booksapp"},
+		Spec: sp.ServiceProfileSpec{
+			Objectives: []*sp.Objective{
+				{
+					Name:        "availability",
+					Window:      "30d",
+					SuccessRate: 0.999,
+					Latency:     &sp.LatencyObjective{Threshold: "300ms", Target: 0.99},
+				},
+				{
+					Name:        "authors",
+					Route:       "GET /authors",
+					Window:      "7d",
+					SuccessRate: 0.99,
+				},
+			},
+		},
+	}
+
+	indicators, err := Indicators(profile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	expected := []Indicator{
+		{
+			Namespace: "booksapp",
+			Service:   "books.booksapp.svc.cluster.local",
+			Objective: "availability",
+			Window:    mustParseDuration(t, "30d"),
+			Kind:      SuccessRate,
+			Target:    0.999,
+		},
+		{
+			Namespace:          "booksapp",
+			Service:            "books.booksapp.svc.cluster.local",
+			Objective:          "availability",
+			Window:             mustParseDuration(t, "30d"),
+			Kind:               Latency,
+			Target:             0.99,
+			LatencyThresholdMs: 300,
+		},
+		{
+			Namespace: "booksapp",
+			Service:   "books.booksapp.svc.cluster.local",
+			Objective: "authors",
+			Route:     "GET /authors",
+			Window:    mustParseDuration(t, "7d"),
+			Kind:      SuccessRate,
+			Target:    0.99,
+		},
+	}
+	if !reflect.DeepEqual(indicators, expected) {
+		t.Fatalf("Expected indicators %+v, got %+v", expected, indicators)
+	}
+}
+
+func TestBurnRateAlerts(t *testing.T) {
+	t.Run("Follows the Site Reliability Workbook", func(t *testing.T) {
+		alerts := BurnRateAlerts(mustParseDuration(t, "30d"))
+		thresholds := []float64{}
+		for _, alert := range alerts {
+			thresholds = append(thresholds, alert.Threshold)
+		}
+		expected := []float64{14.4, 6, 3, 1}
+		for i := range expected {
+			if i >= len(thresholds) || thresholds[i]-expected[i] > 1e-9 || expected[i]-thresholds[i] > 1e-9 {
+				t.Fatalf("Expected thresholds %v, got %v", expected, thresholds)
+			}
+		}
+		if alerts[0].Severity != "page" || alerts[3].Severity != "ticket" {
+			t.Fatalf("Unexpected severities: %+v", alerts)
+		}
+	})
+
+	t.Run("Leaves out windows longer than the objective's", func(t *testing.T) {
+		alerts := BurnRateAlerts(mustParseDuration(t, "1d"))
+		if len(alerts) != 3 {
+			t.Fatalf("Expected 3 alerts, got %+v", alerts)
+		}
+
+		windows := BurnRateWindows(mustParseDuration(t, "1d"))
+		expected := []string{"5m", "30m", "1h", "2h", "6h", "1d"}
+		if len(windows) != len(expected) {
+			t.Fatalf("Expected windows %v, got %v", expected, windows)
+		}
+		for i, w := range windows {
+			if w.String() != expected[i] {
+				t.Fatalf("Expected windows %v, got %v", expected, windows)
+			}
+		}
+	})
+}
+
+func TestErrorRatioQuery(t *testing.T) {
+	window := mustParseDuration(t, "1h")
+
+	expectations := []struct {
+		indicator Indicator
+		query     string
+	}{
+		{
+			Indicator{Service: "books.booksapp.svc.cluster.local", Kind: SuccessRate, Target: 0.999},
+			`(sum(increase(route_response_total{direction="inbound", dst=~"(books.booksapp.svc.cluster.local)(:\\d+)?", classification="failure"}[1h])) or vector(0)) / sum(increase(route_response_total{direction="inbound", dst=~"(books.booksapp.svc.cluster.local)(:\\d+)?"}[1h]))`,
+		},
+		{
+			Indicator{Service: "books.booksapp.svc.cluster.local", Route: "GET /authors", Kind: Latency, Target: 0.99, LatencyThresholdMs: 300},
+			`1 - (sum(increase(route_response_latency_ms_bucket{direction="inbound", dst=~"(books.booksapp.svc.cluster.local)(:\\d+)?", rt_route="GET /authors", le="300"}[1h])) / sum(increase(route_response_latency_ms_count{direction="inbound", dst=~"(books.booksapp.svc.cluster.local)(:\\d+)?", rt_route="GET /authors"}[1h])))`,
+		},
+	}
+
+	for _, exp := range expectations {
+		exp := exp // pin
+		t.Run(exp.indicator.Kind, func(t *testing.T) {
+			query := exp.indicator.ErrorRatioQuery(window)
+			if query != exp.query {
+				t.Fatalf("Expected query:\n%s\ngot:\n%s", exp.query, query)
+			}
+		})
+	}
+}
+
+func TestAlertingRules(t *testing.T) {
+	indicators := []Indicator{
+		{Namespace: "booksapp", Service: "books", Objective: "availability", Window: mustParseDuration(t, "30d"), Kind: SuccessRate, Target: 0.999},
+		{Namespace: "booksapp", Service: "books", Objective: "latency", Window: mustParseDuration(t, "1d"), Kind: Latency, Target: 0.99, LatencyThresholdMs: 300},
+		{Namespace: "booksapp", Service: "authors", Objective: "availability", Window: mustParseDuration(t, "30d"), Kind: SuccessRate, Target: 0.99},
+	}
+
+	rules := AlertingRules(indicators)
+	if len(rules.Groups) != 2 {
+		t.Fatalf("Expected 2 rule groups, got %d", len(rules.Groups))
+	}
+	if rules.Groups[0].Name != "linkerd-slo-booksapp-books" || len(rules.Groups[0].Rules) != 7 {
+		t.Fatalf("Unexpected rule group: %+v", rules.Groups[0])
+	}
+
+	rule := rules.Groups[1].Rules[0]
+	if rule.Labels["severity"] != "page" || rule.Labels["objective"] != "availability" {
+		t.Fatalf("Unexpected labels: %v", rule.Labels)
+	}
+	expectedExpr := `((sum(increase(route_response_total{direction="inbound", dst=~"(authors)(:\\d+)?", classification="failure"}[1h])) or vector(0)) / sum(increase(route_response_total{direction="inbound", dst=~"(authors)(:\\d+)?"}[1h]))) / 0.01 > 14.4
+and
+((sum(increase(route_response_total{direction="inbound", dst=~"(authors)(:\\d+)?", classification="failure"}[5m])) or vector(0)) / sum(increase(route_response_total{direction="inbound", dst=~"(authors)(:\\d+)?"}[5m]))) / 0.01 > 14.4`
+	if rule.Expr != expectedExpr {
+		t.Fatalf("Expected expr:\n%s\ngot:\n%s", expectedExpr, rule.Expr)
+	}
+}
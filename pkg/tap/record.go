@@ -0,0 +1,171 @@
+package tap
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	pb "github.com/linkerd/linkerd2/controller/gen/public"
+)
+
+// recordingVersion is the version of the recording format written by
+// Recorder. Replayer refuses recordings from later versions.
+const recordingVersion = 1
+
+type (
+	// Recorder writes tap events to a recording, one JSON object per line.
+	// The first line describes the tap request the events were captured
+	// from, including its target resource.
+	Recorder struct {
+		w         io.Writer
+		marshaler jsonpb.Marshaler
+		now       func() time.Time
+	}
+
+	// Replayer reads back the events of a recording written by a Recorder.
+	Replayer struct {
+		r           *bufio.Reader
+		unmarshaler jsonpb.Unmarshaler
+
+		// Request is the tap request the events were captured from.
+		Request *pb.TapByResourceRequest
+		// StartedAt is the time at which recording started.
+		StartedAt time.Time
+	}
+
+	// RecordedEvent is a tap event along with the time it was recorded at.
+	RecordedEvent struct {
+		Time  time.Time
+		Event *pb.TapEvent
+	}
+
+	recordingHeader struct {
+		Version   int             `json:"version"`
+		StartedAt time.Time       `json:"startedAt"`
+		Request   json.RawMessage `json:"request"`
+	}
+
+	recordingLine struct {
+		Time  time.Time       `json:"time"`
+		Event json.RawMessage `json:"event"`
+	}
+)
+
+// NewRecorder creates a Recorder writing to w, and writes the recording's
+// header describing req.
+func NewRecorder(w io.Writer, req *pb.TapByResourceRequest) (*Recorder, error) {
+	rec := &Recorder{w: w, now: time.Now}
+	request, err := rec.marshaler.MarshalToString(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := rec.writeLine(recordingHeader{
+		Version:   recordingVersion,
+		StartedAt: rec.now(),
+		Request:   json.RawMessage(request),
+	}); err != nil {
+		return nil, err
+	}
+	return rec, nil
+}
+
+// Record appends an event to the recording.
+func (r *Recorder) Record(event *pb.TapEvent) error {
+	ev, err := r.marshaler.MarshalToString(event)
+	if err != nil {
+		return err
+	}
+	return r.writeLine(recordingLine{
+		Time:  r.now(),
+		Event: json.RawMessage(ev),
+	})
+}
+
+func (r *Recorder) writeLine(v interface{}) error {
+	line, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = r.w.Write(append(line, '\n'))
+	return err
+}
+
+// NewReplayer creates a Replayer reading the recording from r, and reads the
+// recording's header.
+func NewReplayer(r io.Reader) (*Replayer, error) {
+	rep := &Replayer{
+		r: bufio.NewReader(r),
+		// Allow recordings made by newer versions of the tap API to be
+		// replayed, ignoring the fields this version doesn't know about.
+		unmarshaler: jsonpb.Unmarshaler{AllowUnknownFields: true},
+	}
+
+	line, err := rep.readLine()
+	if err == io.EOF {
+		return nil, fmt.Errorf("recording is empty")
+	}
+	if err != nil {
+		return nil, err
+	}
+	var header recordingHeader
+	if err := json.Unmarshal(line, &header); err != nil {
+		return nil, fmt.Errorf("invalid recording header: %s", err)
+	}
+	if header.Version < 1 || header.Version > recordingVersion {
+		return nil, fmt.Errorf("unsupported recording version: %d", header.Version)
+	}
+
+	req := &pb.TapByResourceRequest{}
+	if err := rep.unmarshaler.Unmarshal(bytes.NewReader(header.Request), req); err != nil {
+		return nil, fmt.Errorf("invalid recording header: %s", err)
+	}
+	rep.Request = req
+	rep.StartedAt = header.StartedAt
+	return rep, nil
+}
+
+// Next returns the next event of the recording, or io.EOF once all the
+// events have been read.
+func (r *Replayer) Next() (*RecordedEvent, error) {
+	line, err := r.readLine()
+	if err != nil {
+		return nil, err
+	}
+	var rec recordingLine
+	if err := json.Unmarshal(line, &rec); err != nil {
+		return nil, fmt.Errorf("invalid recorded event: %s", err)
+	}
+	event := &pb.TapEvent{}
+	if err := r.unmarshaler.Unmarshal(bytes.NewReader(rec.Event), event); err != nil {
+		return nil, fmt.Errorf("invalid recorded event: %s", err)
+	}
+	return &RecordedEvent{Time: rec.Time, Event: event}, nil
+}
+
+// readLine returns the next non-empty line of the recording.
+func (r *Replayer) readLine() ([]byte, error) {
+	for {
+		line, err := r.r.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			if err == io.EOF {
+				return nil, io.EOF
+			}
+			continue
+		}
+		// A last line without its newline may have been cut short while it
+		// was being written, e.g. because tap was interrupted. The recording
+		// is then replayed up to the previous event.
+		if err == io.EOF && !json.Valid(line) {
+			return nil, io.EOF
+		}
+		return line, nil
+	}
+}
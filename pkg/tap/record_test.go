@@ -0,0 +1,121 @@
+package tap
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/linkerd/linkerd2/controller/gen/public"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	req := &pb.TapByResourceRequest{
+		Target: &pb.ResourceSelection{
+			Resource: &pb.Resource{Namespace: "emojivoto", Type: "deployment", Name: "web"},
+		},
+		MaxRps: 10,
+	}
+	events := []*pb.TapEvent{
+		{
+			ProxyDirection: pb.TapEvent_OUTBOUND,
+			SourceMeta:     &pb.TapEvent_EndpointMeta{Labels: map[string]string{"pod": "web-1"}},
+			Event: &pb.TapEvent_Http_{Http: &pb.TapEvent_Http{
+				Event: &pb.TapEvent_Http_RequestInit_{RequestInit: &pb.TapEvent_Http_RequestInit{
+					Id:   &pb.TapEvent_Http_StreamId{Base: 1, Stream: 2},
+					Path: "/api/list",
+				}},
+			}},
+		},
+		{
+			ProxyDirection: pb.TapEvent_OUTBOUND,
+			Event: &pb.TapEvent_Http_{Http: &pb.TapEvent_Http{
+				Event: &pb.TapEvent_Http_ResponseEnd_{ResponseEnd: &pb.TapEvent_Http_ResponseEnd{
+					Id:  &pb.TapEvent_Http_StreamId{Base: 1, Stream: 2},
+					Eos: &pb.Eos{End: &pb.Eos_GrpcStatusCode{GrpcStatusCode: 14}},
+				}},
+			}},
+		},
+	}
+
+	now := time.Date(2019, 11, 5, 10, 0, 0, 0, time.UTC)
+	var buf bytes.Buffer
+	recorder, err := NewRecorder(&buf, req)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	recorder.now = func() time.Time { return now }
+	for _, event := range events {
+		now = now.Add(time.Second)
+		if err := recorder.Record(event); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+	}
+
+	replayer, err := NewReplayer(&buf)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if !proto.Equal(replayer.Request, req) {
+		t.Fatalf("Expected request %v, got %v", req, replayer.Request)
+	}
+
+	expectedTime := time.Date(2019, 11, 5, 10, 0, 0, 0, time.UTC)
+	for i, event := range events {
+		expectedTime = expectedTime.Add(time.Second)
+		rec, err := replayer.Next()
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if !proto.Equal(rec.Event, event) {
+			t.Fatalf("Expected event %d to be %v, got %v", i, event, rec.Event)
+		}
+		if !rec.Time.Equal(expectedTime) {
+			t.Fatalf("Expected event %d to be recorded at %s, got %s", i, expectedTime, rec.Time)
+		}
+	}
+	if _, err := replayer.Next(); err != io.EOF {
+		t.Fatalf("Expected EOF, got %v", err)
+	}
+}
+
+func TestReplayTruncatedRecording(t *testing.T) {
+	recording := `{"version":1,"startedAt":"2019-11-05T10:00:00Z","request":{"maxRps":10}}
+{"time":"2019-11-05T10:00:01Z","event":{"proxyDirection":"INBOUND"}}
+{"time":"2019-11-05T10:00:02Z","event":{"proxyDir`
+
+	replayer, err := NewReplayer(strings.NewReader(recording))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	rec, err := replayer.Next()
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if rec.Event.GetProxyDirection() != pb.TapEvent_INBOUND {
+		t.Fatalf("Expected an inbound event, got %v", rec.Event)
+	}
+	if _, err := replayer.Next(); err != io.EOF {
+		t.Fatalf("Expected EOF, got %v", err)
+	}
+}
+
+func TestNewReplayerErrors(t *testing.T) {
+	testCases := []struct {
+		recording string
+		err       string
+	}{
+		{"", "recording is empty"},
+		{"not json\n", "invalid recording header: invalid character 'o' in literal null (expecting 'u')"},
+		{`{"version":2,"request":{}}` + "\n", "unsupported recording version: 2"},
+	}
+
+	for _, tc := range testCases {
+		_, err := NewReplayer(strings.NewReader(tc.recording))
+		if err == nil || err.Error() != tc.err {
+			t.Errorf("Expected error %q, got %v", tc.err, err)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package tap
+
+import (
+	"encoding/json"
+	"net/url"
+	"time"
+
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	"github.com/linkerd/linkerd2/pkg/protohttp"
+)
+
+// SessionsPath is the tap APIServer path listing the recent tap sessions.
+const SessionsPath = "/apis/tap.linkerd.io/v1alpha1/sessions"
+
+// Session is the audit record of a tap session: who tapped which resource,
+// with which match, when, and how many events they were sent. Denied
+// sessions are attempts which were refused, e.g. by RBAC, with the reason in
+// Error. The ID names the tap replica which served the session.
+type Session struct {
+	ID        string     `json:"id"`
+	User      string     `json:"user"`
+	Groups    []string   `json:"groups,omitempty"`
+	Namespace string     `json:"namespace"`
+	Resource  string     `json:"resource"`
+	Name      string     `json:"name,omitempty"`
+	Match     string     `json:"match,omitempty"`
+	StartedAt time.Time  `json:"startedAt"`
+	EndedAt   *time.Time `json:"endedAt,omitempty"`
+	Events    uint64     `json:"events"`
+	Denied    bool       `json:"denied,omitempty"`
+	Error     string     `json:"error,omitempty"`
+}
+
+// Target returns the tapped resource formatted as "resource/name", or just
+// the resource when all of its kind in the namespace were tapped.
+func (s *Session) Target() string {
+	if s.Name == "" {
+		return s.Resource
+	}
+	return s.Resource + "/" + s.Name
+}
+
+// Sessions returns the tap sessions of all the tap replicas, most recent
+// first.
+func Sessions(k8sAPI *k8s.KubernetesAPI, timeout time.Duration) ([]Session, error) {
+	client, err := k8sAPI.NewClient()
+	if err != nil {
+		return nil, err
+	}
+	client.Timeout = timeout
+
+	url, err := url.Parse(k8sAPI.Host)
+	if err != nil {
+		return nil, err
+	}
+	url.Path = SessionsPath
+
+	httpRsp, err := client.Get(url.String())
+	if err != nil {
+		return nil, err
+	}
+	defer httpRsp.Body.Close()
+
+	if err := protohttp.CheckIfResponseHasError(httpRsp); err != nil {
+		return nil, err
+	}
+
+	var sessions []Session
+	if err := json.NewDecoder(httpRsp.Body).Decode(&sessions); err != nil {
+		return nil, err
+	}
+	return sessions, nil
+}
@@ -1,9 +1,12 @@
 package tls
 
 import (
+	"crypto"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/elliptic"
 	"crypto/rand"
+	"crypto/rsa"
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"fmt"
@@ -13,7 +16,7 @@ import (
 
 type (
 	// CA provides a certificate authority for TLS-enabled installs.
-	// Issuing certificates concurrently is not supported.
+	// Certificates may be issued concurrently.
 	CA struct {
 		// Cred contains the CA's credentials.
 		Cred Cred
@@ -25,16 +28,6 @@ type (
 		// assume that the CA's validity period is the same as issued certificates'
 		// validity.
 		Validity Validity
-
-		// nextSerialNumber is the serial number of the next certificate to issue.
-		// Serial numbers must not be reused.
-		//
-		// It is assumed there is only one instance of CA and it is assumed that a
-		// given CA object isn't requested to issue certificates concurrently.
-		//
-		// For now we do not attempt to meet CABForum requirements (e.g. regarding
-		// randomness).
-		nextSerialNumber uint64
 	}
 
 	// Validity configures the expiry times of issued certificates.
@@ -77,11 +70,17 @@ const (
 	// verifier; since both are trying to account for clock skew, there is
 	// somewhat of an over-correction.
 	DefaultClockSkewAllowance = 10 * time.Second
+
+	// serialNumberBits is the size of the random serial numbers of issued
+	// certificates. RFC 5280 limits serial numbers to 20 octets, and CABForum
+	// requires at least 64 bits of randomness; 128 bits make collisions across
+	// CA instances and restarts practically impossible.
+	serialNumberBits = 128
 )
 
 // NewCA initializes a new CA with default settings.
 func NewCA(cred Cred, validity Validity) *CA {
-	return &CA{cred, validity, uint64(1)}
+	return &CA{cred, validity}
 }
 
 func init() {
@@ -89,14 +88,24 @@ func init() {
 	var _ Issuer = &CA{}
 }
 
-// CreateRootCA configures a new root CA with the given settings
+// CreateRootCA configures a new root CA with the given settings. The key may
+// be an ECDSA, RSA or Ed25519 private key.
 func CreateRootCA(
 	name string,
-	key *ecdsa.PrivateKey,
+	key crypto.Signer,
 	validity Validity,
 ) (*CA, error) {
+	k, err := newGenericPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
 	// Configure the root certificate.
-	t := createTemplate(1, &key.PublicKey, validity)
+	t, err := createTemplate(key.Public(), validity)
+	if err != nil {
+		return nil, err
+	}
+	t.SignatureAlgorithm = signatureAlgorithm(key.Public())
 	t.Subject = pkix.Name{CommonName: name}
 	t.IsCA = true
 	t.MaxPathLen = -1
@@ -114,10 +123,8 @@ func CreateRootCA(
 	}
 
 	// The Crt has an empty TrustChain because it's at the root.
-	cred := validCredOrPanic(key, Crt{Certificate: c})
-	ca := NewCA(cred, validity)
-	ca.nextSerialNumber++ // Because we've already created the root cert.
-	return ca, nil
+	cred := validCredOrPanic(k, Crt{Certificate: c})
+	return NewCA(cred, validity), nil
 }
 
 // GenerateKey creates a new P-256 ECDSA private key from the default random
@@ -144,7 +151,10 @@ func (ca *CA) GenerateCA(name string, validity Validity, maxPathLen int) (*CA, e
 		return nil, err
 	}
 
-	t := ca.createTemplate(&key.PublicKey)
+	t, err := createTemplate(&key.PublicKey, ca.Validity)
+	if err != nil {
+		return nil, err
+	}
 	t.Subject = pkix.Name{CommonName: name}
 	t.IsCA = true
 	t.MaxPathLen = maxPathLen
@@ -156,7 +166,7 @@ func (ca *CA) GenerateCA(name string, validity Validity, maxPathLen int) (*CA, e
 		return nil, err
 	}
 
-	return NewCA(validCredOrPanic(key, crt), ca.Validity), nil
+	return NewCA(validCredOrPanic(privateKeyEC{key}, crt), ca.Validity), nil
 }
 
 // GenerateEndEntityCred creates a new certificate that is valid for the
@@ -177,7 +187,7 @@ func (ca *CA) GenerateEndEntityCred(dnsName string) (*Cred, error) {
 		return nil, err
 	}
 
-	c := validCredOrPanic(key, crt)
+	c := validCredOrPanic(privateKeyEC{key}, crt)
 	return &c, nil
 }
 
@@ -189,7 +199,10 @@ func (ca *CA) IssueEndEntityCrt(csr *x509.CertificateRequest) (Crt, error) {
 		return Crt{}, fmt.Errorf("CSR must contain an ECDSA public key: %+v", csr.PublicKey)
 	}
 
-	t := ca.createTemplate(pubkey)
+	t, err := createTemplate(pubkey, ca.Validity)
+	if err != nil {
+		return Crt{}, err
+	}
 	t.Issuer = ca.Cred.Crt.Certificate.Subject
 	t.Subject = csr.Subject
 	t.Extensions = csr.Extensions
@@ -202,50 +215,70 @@ func (ca *CA) IssueEndEntityCrt(csr *x509.CertificateRequest) (Crt, error) {
 	return ca.Cred.SignCrt(t)
 }
 
-// createTemplate returns a certificate t for a non-CA certificate with
-// no subject name, no subjectAltNames. The t can then be modified into
-// a (root) CA t or an end-entity t by the caller.
-func (ca *CA) createTemplate(pubkey *ecdsa.PublicKey) *x509.Certificate {
-	c := createTemplate(ca.nextSerialNumber, pubkey, ca.Validity)
-	ca.nextSerialNumber++
-	return c
-}
-
 // createTemplate returns a certificate t for a non-CA certificate with
 // no subject name, no subjectAltNames. The t can then be modified into
 // a (root) CA t or an end-entity t by the caller.
 func createTemplate(
-	serialNumber uint64,
-	k *ecdsa.PublicKey,
+	k crypto.PublicKey,
 	v Validity,
-) *x509.Certificate {
-	// ECDSA is used instead of RSA because ECDSA key generation is
-	// straightforward and fast whereas RSA key generation is extremely slow
-	// and error-prone.
-	//
-	// CA certificates are signed with the same algorithm as end-entity
-	// certificates because they are relatively short-lived, because using one
-	// algorithm minimizes exposure to implementation flaws, and to speed up
-	// signature verification time.
-	//
-	// SHA-256 is used because any larger digest would be truncated to 256 bits
-	// anyway since a P-256 scalar is only 256 bits long.
-	const SignatureAlgorithm = x509.ECDSAWithSHA256
+) (*x509.Certificate, error) {
+	serialNumber, err := generateSerialNumber()
+	if err != nil {
+		return nil, err
+	}
 
 	notBefore, notAfter := v.Window(time.Now())
 
 	return &x509.Certificate{
-		SerialNumber:       big.NewInt(int64(serialNumber)),
-		SignatureAlgorithm: SignatureAlgorithm,
-		NotBefore:          notBefore,
-		NotAfter:           notAfter,
-		PublicKey:          k,
-		KeyUsage:           x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature,
+		SerialNumber: serialNumber,
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+		PublicKey:    k,
+		KeyUsage:     x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature,
 		ExtKeyUsage: []x509.ExtKeyUsage{
 			x509.ExtKeyUsageServerAuth,
 			x509.ExtKeyUsageClientAuth,
 		},
+	}, nil
+}
+
+// signatureAlgorithm returns the algorithm used to sign certificates with the
+// private key matching the given public key.
+//
+// Keys generated by the CA are P-256 ECDSA keys, because ECDSA key generation
+// is straightforward and fast whereas RSA key generation is extremely slow
+// and error-prone. Issuers and trust anchors provided by the user may however
+// use RSA or Ed25519 keys, which the proxies support as well.
+//
+// SHA-256 is used with P-256 keys because any larger digest would be
+// truncated to 256 bits anyway since a P-256 scalar is only 256 bits long.
+func signatureAlgorithm(pub crypto.PublicKey) x509.SignatureAlgorithm {
+	switch k := pub.(type) {
+	case *ecdsa.PublicKey:
+		if k.Curve == elliptic.P384() {
+			return x509.ECDSAWithSHA384
+		}
+		return x509.ECDSAWithSHA256
+	case *rsa.PublicKey:
+		return x509.SHA256WithRSA
+	case ed25519.PublicKey:
+		return x509.PureEd25519
+	default:
+		return x509.UnknownSignatureAlgorithm
+	}
+}
+
+// generateSerialNumber returns a random, positive serial number. Serial
+// numbers must not be reused, and random ones don't require coordination
+// between concurrent issuances or CA instances.
+func generateSerialNumber() (*big.Int, error) {
+	limit := new(big.Int).Lsh(big.NewInt(1), serialNumberBits)
+	serialNumber, err := rand.Int(rand.Reader, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate serial number: %s", err)
 	}
+	// Zero is not a valid serial number.
+	return serialNumber.Add(serialNumber, big.NewInt(1)), nil
 }
 
 // Window returns the time window for which a certificate should be valid.
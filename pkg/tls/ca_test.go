@@ -0,0 +1,116 @@
+package tls
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"sync"
+	"testing"
+)
+
+func TestIssueEndEntityCrtConcurrently(t *testing.T) {
+	ca, err := GenerateRootCAWithDefaults("identity.linkerd.cluster.local")
+	if err != nil {
+		t.Fatalf("Failed to generate root CA: %s", err)
+	}
+	key, err := GenerateKey()
+	if err != nil {
+		t.Fatalf("Failed to generate key: %s", err)
+	}
+	csr := &x509.CertificateRequest{
+		Subject:   pkix.Name{CommonName: "foo.ns.serviceaccount.identity.linkerd.cluster.local"},
+		DNSNames:  []string{"foo.ns.serviceaccount.identity.linkerd.cluster.local"},
+		PublicKey: &key.PublicKey,
+	}
+
+	const issuances = 100
+	crts := make([]Crt, issuances)
+	errs := make([]error, issuances)
+	var wg sync.WaitGroup
+	for i := 0; i < issuances; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			crts[i], errs[i] = ca.IssueEndEntityCrt(csr)
+		}(i)
+	}
+	wg.Wait()
+
+	serialNumbers := map[string]struct{}{
+		ca.Cred.Crt.Certificate.SerialNumber.String(): {},
+	}
+	for i, crt := range crts {
+		if errs[i] != nil {
+			t.Fatalf("Failed to issue certificate: %s", errs[i])
+		}
+		serialNumber := crt.Certificate.SerialNumber
+		if serialNumber.Sign() <= 0 {
+			t.Fatalf("Expected a positive serial number, got %s", serialNumber)
+		}
+		if len(serialNumber.Bytes()) > 20 {
+			t.Fatalf("Expected a serial number of at most 20 octets, got %d", len(serialNumber.Bytes()))
+		}
+		if _, ok := serialNumbers[serialNumber.String()]; ok {
+			t.Fatalf("Serial number %s was issued twice", serialNumber)
+		}
+		serialNumbers[serialNumber.String()] = struct{}{}
+	}
+}
+
+func TestCreateRootCAWithKeyAlgorithms(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 3072)
+	if err != nil {
+		t.Fatalf("Failed to generate RSA key: %s", err)
+	}
+	_, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate Ed25519 key: %s", err)
+	}
+	ecKey, err := GenerateKey()
+	if err != nil {
+		t.Fatalf("Failed to generate key: %s", err)
+	}
+
+	for _, tt := range []struct {
+		description       string
+		key               crypto.Signer
+		expectedAlgorithm x509.SignatureAlgorithm
+	}{
+		{"ECDSA", ecKey, x509.ECDSAWithSHA256},
+		{"RSA", rsaKey, x509.SHA256WithRSA},
+		{"Ed25519", ed25519Key, x509.PureEd25519},
+	} {
+		tt := tt // pin
+		t.Run(tt.description, func(t *testing.T) {
+			root, err := CreateRootCA("identity.linkerd.cluster.local", tt.key, Validity{})
+			if err != nil {
+				t.Fatalf("Failed to create root CA: %s", err)
+			}
+
+			// The credentials must survive a round trip through PEM, e.g. when
+			// stored in the issuer Secret.
+			cred, err := ValidateAndCreateCreds(root.Cred.Crt.EncodeCertificatePEM(), root.Cred.EncodePrivateKeyPEM())
+			if err != nil {
+				t.Fatalf("Failed to read back credentials: %s", err)
+			}
+			if _, err := cred.EncodePrivateKeyP8(); err != nil {
+				t.Fatalf("Failed to encode key as PKCS#8: %s", err)
+			}
+
+			ca := NewCA(*cred, Validity{})
+			endEntity, err := ca.GenerateEndEntityCred("foo.ns.serviceaccount.identity.linkerd.cluster.local")
+			if err != nil {
+				t.Fatalf("Failed to issue certificate: %s", err)
+			}
+			if alg := endEntity.Crt.Certificate.SignatureAlgorithm; alg != tt.expectedAlgorithm {
+				t.Fatalf("Expected certificate signed with %s, got %s", tt.expectedAlgorithm, alg)
+			}
+			if err := endEntity.Crt.Verify(root.Cred.Crt.CertPool(), "foo.ns.serviceaccount.identity.linkerd.cluster.local"); err != nil {
+				t.Fatalf("Failed to verify certificate: %s", err)
+			}
+		})
+	}
+}
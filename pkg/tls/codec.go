@@ -67,6 +67,12 @@ func DecodePEMKey(txt string) (GenericPrivateKey, error) {
 			return nil, err
 		}
 		return privateKeyRSA{k}, nil
+	case "PRIVATE KEY":
+		k, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		return newGenericPrivateKey(k)
 	default:
 		return nil, fmt.Errorf("unsupported block type: '%s'", block.Type)
 	}
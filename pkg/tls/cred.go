@@ -2,7 +2,9 @@ package tls
 
 import (
 	"bytes"
+	"crypto"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
@@ -23,10 +25,18 @@ type (
 		*rsa.PrivateKey
 	}
 
-	// GenericPrivateKey represents either an EC or an RSA private key
+	// privateKeyEd25519 wraps an Ed25519 private key
+	privateKeyEd25519 struct {
+		ed25519.PrivateKey
+	}
+
+	// GenericPrivateKey represents either an EC, an RSA or an Ed25519 private
+	// key
 	GenericPrivateKey interface {
+		crypto.Signer
 		matchesCertificate(*x509.Certificate) bool
-		marshal() ([]byte, error)
+		marshal() (*pem.Block, error)
+		privateKey() crypto.PrivateKey
 	}
 
 	// Cred is a container for a certificate, trust chain, and private key.
@@ -50,8 +60,16 @@ func (k privateKeyEC) matchesCertificate(c *x509.Certificate) bool {
 	return ok && pub.X.Cmp(k.X) == 0 && pub.Y.Cmp(k.Y) == 0
 }
 
-func (k privateKeyEC) marshal() ([]byte, error) {
-	return x509.MarshalECPrivateKey(k.PrivateKey)
+func (k privateKeyEC) marshal() (*pem.Block, error) {
+	der, err := x509.MarshalECPrivateKey(k.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	return &pem.Block{Type: "EC PRIVATE KEY", Bytes: der}, nil
+}
+
+func (k privateKeyEC) privateKey() crypto.PrivateKey {
+	return k.PrivateKey
 }
 
 func (k privateKeyRSA) matchesCertificate(c *x509.Certificate) bool {
@@ -59,13 +77,47 @@ func (k privateKeyRSA) matchesCertificate(c *x509.Certificate) bool {
 	return ok && pub.N.Cmp(k.N) == 0 && pub.E == k.E
 }
 
-func (k privateKeyRSA) marshal() ([]byte, error) {
-	return x509.MarshalPKCS1PrivateKey(k.PrivateKey), nil
+func (k privateKeyRSA) marshal() (*pem.Block, error) {
+	return &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(k.PrivateKey)}, nil
+}
+
+func (k privateKeyRSA) privateKey() crypto.PrivateKey {
+	return k.PrivateKey
+}
+
+func (k privateKeyEd25519) matchesCertificate(c *x509.Certificate) bool {
+	pub, ok := c.PublicKey.(ed25519.PublicKey)
+	return ok && bytes.Equal(pub, k.PrivateKey.Public().(ed25519.PublicKey))
+}
+
+func (k privateKeyEd25519) marshal() (*pem.Block, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(k.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	return &pem.Block{Type: "PRIVATE KEY", Bytes: der}, nil
+}
+
+func (k privateKeyEd25519) privateKey() crypto.PrivateKey {
+	return k.PrivateKey
+}
+
+// newGenericPrivateKey wraps an ECDSA, RSA or Ed25519 private key.
+func newGenericPrivateKey(key crypto.PrivateKey) (GenericPrivateKey, error) {
+	switch k := key.(type) {
+	case *ecdsa.PrivateKey:
+		return privateKeyEC{k}, nil
+	case *rsa.PrivateKey:
+		return privateKeyRSA{k}, nil
+	case ed25519.PrivateKey:
+		return privateKeyEd25519{k}, nil
+	default:
+		return nil, fmt.Errorf("unsupported private key type: %T", key)
+	}
 }
 
 // validCredOrPanic creates a  Cred, panicking if the key does not match the certificate.
-func validCredOrPanic(ecKey *ecdsa.PrivateKey, crt Crt) Cred {
-	k := privateKeyEC{ecKey}
+func validCredOrPanic(k GenericPrivateKey, crt Crt) Cred {
 	if !k.matchesCertificate(crt.Certificate) {
 		panic("Cert's public key does not match private key")
 	}
@@ -130,18 +182,19 @@ func (cred *Cred) EncodePrivateKeyPEM() string {
 		panic(fmt.Sprintf("Invalid private key: %s", err))
 	}
 
-	return string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: b}))
+	return string(pem.EncodeToMemory(b))
 }
 
 // EncodePrivateKeyP8 encodes the provided key to the PKCS#8 binary form.
 func (cred *Cred) EncodePrivateKeyP8() ([]byte, error) {
-	return x509.MarshalPKCS8PrivateKey(cred.PrivateKey)
+	return x509.MarshalPKCS8PrivateKey(cred.PrivateKey.privateKey())
 }
 
 // SignCrt uses this Cred to sign a new certificate.
 //
 // This may fail if the Cred contains an end-entity certificate.
 func (cred *Cred) SignCrt(template *x509.Certificate) (Crt, error) {
+	template.SignatureAlgorithm = signatureAlgorithm(cred.PrivateKey.Public())
 	crtb, err := x509.CreateCertificate(
 		rand.Reader,
 		template,
@@ -143,7 +143,7 @@ class ServiceMesh extends React.Component {
   }
 
   extractNsStatuses(nsData) {
-    let podsByNs = _get(nsData, ["ok", "statTables", 0, "podGroup", "rows"], []);
+    let podsByNs = _get(nsData, ["ok", "namespaces", "podGroup", "rows"], []);
     let dataPlaneNamepaces = podsByNs.map(ns => {
       let meshedPods = parseInt(ns.meshedPodCount, 10);
       let totalPods = parseInt(ns.runningPodCount, 10);
@@ -170,7 +170,7 @@ class ServiceMesh extends React.Component {
 
     this.api.setCurrentRequests([
       this.api.fetchPods(this.props.controllerNamespace),
-      this.api.fetchMetrics(this.api.urlsForResourceNoStats("namespace"))
+      this.api.fetchMetrics(`${this.api.urlsForResourceNoStats("all")}&rollups=true`)
     ]);
 
     this.serverPromise = Promise.all(this.api.getCurrentPromises())
@@ -147,7 +147,7 @@ describe('ServiceMesh', () => {
 
     it("displays a message if >1 resource has not been added to the mesh", () => {
       let nsAllResourcesAdded = _cloneDeep(nsFixtures);
-      nsAllResourcesAdded.ok.statTables[0].podGroup.rows.push({
+      nsAllResourcesAdded.ok.namespaces.podGroup.rows.push({
         "resource": {
           "namespace": "",
           "type": "namespace",
@@ -172,7 +172,7 @@ describe('ServiceMesh', () => {
 
     it("displays a message if 1 resource has not added to servicemesh", () => {
       let nsOneResourceNotAdded = _cloneDeep(nsFixtures);
-      _each(nsOneResourceNotAdded.ok.statTables[0].podGroup.rows, row => {
+      _each(nsOneResourceNotAdded.ok.namespaces.podGroup.rows, row => {
         // set all namespaces to have fully meshed pod counts, except one
         if (row.resource.name !== "default") {
           row.meshedPodCount = "10";
@@ -192,7 +192,7 @@ describe('ServiceMesh', () => {
 
     it("displays a message if all resources have been added to servicemesh", () => {
       let nsAllResourcesAdded = _cloneDeep(nsFixtures);
-      _each(nsAllResourcesAdded.ok.statTables[0].podGroup.rows, row => {
+      _each(nsAllResourcesAdded.ok.namespaces.podGroup.rows, row => {
         row.meshedPodCount = "10";
         row.runningPodCount = "10";
       });
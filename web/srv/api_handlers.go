@@ -123,13 +123,21 @@ func (h *handler) handleAPIServices(w http.ResponseWriter, req *http.Request, p
 func (h *handler) handleAPIStat(w http.ResponseWriter, req *http.Request, p httprouter.Params) {
 	trueStr := fmt.Sprintf("%t", true)
 
+	quantiles, err := util.ParseLatencyQuantiles(req.FormValue("latency_quantiles"))
+	if err != nil {
+		renderJSONError(w, err, http.StatusBadRequest)
+		return
+	}
+
 	requestParams := util.StatsSummaryRequestParams{
 		StatsBaseRequestParams: util.StatsBaseRequestParams{
-			TimeWindow:    req.FormValue("window"),
-			ResourceName:  req.FormValue("resource_name"),
-			ResourceType:  req.FormValue("resource_type"),
-			Namespace:     req.FormValue("namespace"),
-			AllNamespaces: req.FormValue("all_namespaces") == trueStr,
+			TimeWindow:       req.FormValue("window"),
+			ResourceName:     req.FormValue("resource_name"),
+			ResourceType:     req.FormValue("resource_type"),
+			Namespace:        req.FormValue("namespace"),
+			AllNamespaces:    req.FormValue("all_namespaces") == trueStr,
+			LatencyQuantiles: quantiles,
+			LatencyHistogram: req.FormValue("latency_histogram") == trueStr,
 		},
 		ToName:        req.FormValue("to_name"),
 		ToType:        req.FormValue("to_type"),
@@ -139,6 +147,7 @@ func (h *handler) handleAPIStat(w http.ResponseWriter, req *http.Request, p http
 		FromNamespace: req.FormValue("from_namespace"),
 		SkipStats:     req.FormValue("skip_stats") == trueStr,
 		TCPStats:      req.FormValue("tcp_stats") == trueStr,
+		Rollups:       req.FormValue("rollups") == trueStr,
 	}
 
 	// default to returning deployment stats
@@ -161,12 +170,20 @@ func (h *handler) handleAPIStat(w http.ResponseWriter, req *http.Request, p http
 }
 
 func (h *handler) handleAPITopRoutes(w http.ResponseWriter, req *http.Request, p httprouter.Params) {
+	quantiles, err := util.ParseLatencyQuantiles(req.FormValue("latency_quantiles"))
+	if err != nil {
+		renderJSONError(w, err, http.StatusBadRequest)
+		return
+	}
+
 	requestParams := util.TopRoutesRequestParams{
 		StatsBaseRequestParams: util.StatsBaseRequestParams{
-			TimeWindow:   req.FormValue("window"),
-			ResourceName: req.FormValue("resource_name"),
-			ResourceType: req.FormValue("resource_type"),
-			Namespace:    req.FormValue("namespace"),
+			TimeWindow:       req.FormValue("window"),
+			ResourceName:     req.FormValue("resource_name"),
+			ResourceType:     req.FormValue("resource_type"),
+			Namespace:        req.FormValue("namespace"),
+			LatencyQuantiles: quantiles,
+			LatencyHistogram: req.FormValue("latency_histogram") == fmt.Sprintf("%t", true),
 		},
 		ToName:      req.FormValue("to_name"),
 		ToType:      req.FormValue("to_type"),